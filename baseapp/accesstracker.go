@@ -0,0 +1,134 @@
+package baseapp
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/line/lfb-sdk/store/cachekv"
+	"github.com/line/lfb-sdk/store/tracekv"
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+// keyRange is a [start, end) domain that was iterated over. A nil bound is
+// unbounded on that side.
+type keyRange struct {
+	start, end []byte
+}
+
+func (r keyRange) contains(key []byte) bool {
+	if r.start != nil && bytes.Compare(key, r.start) < 0 {
+		return false
+	}
+	if r.end != nil && bytes.Compare(key, r.end) >= 0 {
+		return false
+	}
+	return true
+}
+
+// pendingWrite is a buffered Set or Delete of an accessTrackingStore.
+type pendingWrite struct {
+	key, value []byte
+	deleted    bool
+}
+
+// accessTrackingStore wraps the KVStore of a single store key and records the
+// keys read, the domains iterated and the keys written through it. Writes are
+// buffered until flush is called, so that a speculative execution can be
+// validated against other executions before it touches the parent store.
+//
+// It is used by a single lane and is not safe for concurrent use.
+type accessTrackingStore struct {
+	parent sdk.KVStore
+
+	reads  map[string]struct{}
+	ranges []keyRange
+	writes map[string]struct{}
+	buffer []pendingWrite
+}
+
+var _ sdk.KVStore = (*accessTrackingStore)(nil)
+
+func newAccessTrackingStore(parent sdk.KVStore) *accessTrackingStore {
+	return &accessTrackingStore{
+		parent: parent,
+		reads:  make(map[string]struct{}),
+		writes: make(map[string]struct{}),
+	}
+}
+
+// GetStoreType implements Store.
+func (s *accessTrackingStore) GetStoreType() sdk.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// Get implements KVStore.
+func (s *accessTrackingStore) Get(key []byte) []byte {
+	s.reads[string(key)] = struct{}{}
+	return s.parent.Get(key)
+}
+
+// Has implements KVStore.
+func (s *accessTrackingStore) Has(key []byte) bool {
+	s.reads[string(key)] = struct{}{}
+	return s.parent.Has(key)
+}
+
+// Set implements KVStore. The write is buffered until flush.
+func (s *accessTrackingStore) Set(key, value []byte) {
+	s.writes[string(key)] = struct{}{}
+	s.buffer = append(s.buffer, pendingWrite{key: key, value: value})
+}
+
+// Delete implements KVStore. The delete is buffered until flush.
+func (s *accessTrackingStore) Delete(key []byte) {
+	s.writes[string(key)] = struct{}{}
+	s.buffer = append(s.buffer, pendingWrite{key: key, deleted: true})
+}
+
+// Iterator implements KVStore.
+func (s *accessTrackingStore) Iterator(start, end []byte) sdk.Iterator {
+	s.ranges = append(s.ranges, keyRange{start: start, end: end})
+	return s.parent.Iterator(start, end)
+}
+
+// ReverseIterator implements KVStore.
+func (s *accessTrackingStore) ReverseIterator(start, end []byte) sdk.Iterator {
+	s.ranges = append(s.ranges, keyRange{start: start, end: end})
+	return s.parent.ReverseIterator(start, end)
+}
+
+// CacheWrap implements CacheWrapper.
+func (s *accessTrackingStore) CacheWrap() sdk.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements CacheWrapper.
+func (s *accessTrackingStore) CacheWrapWithTrace(w io.Writer, tc sdk.TraceContext) sdk.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// observes returns true if the key was read, or falls into an iterated domain.
+func (s *accessTrackingStore) observes(key string) bool {
+	if _, ok := s.reads[key]; ok {
+		return true
+	}
+	for _, r := range s.ranges {
+		if r.contains([]byte(key)) {
+			return true
+		}
+	}
+	return false
+}
+
+// flush applies the buffered writes to the parent store in the order they
+// were made.
+func (s *accessTrackingStore) flush() {
+	for _, w := range s.buffer {
+		if w.deleted {
+			s.parent.Delete(w.key)
+		} else {
+			s.parent.Set(w.key, w.value)
+		}
+	}
+	s.buffer = nil
+}
@@ -0,0 +1,361 @@
+package baseapp
+
+import (
+	"bytes"
+
+	store "github.com/line/lfb-sdk/store/types"
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+// Accumulator adds up the values of keys that transactions only add to, such
+// as the balances of the fee collector, so that the lanes of a block executed
+// in parallel do not conflict on them.
+//
+// A transaction may only access such a key through Context.KVStore, by reading
+// it and then writing back a larger value, where the increase does not depend
+// on the value read. A nil value is a key that is not set.
+type Accumulator interface {
+	// Sub returns the amount by which value exceeds base, or false if value
+	// is smaller than base.
+	Sub(value, base []byte) ([]byte, bool)
+
+	// Add returns value increased by an amount returned by Sub.
+	Add(value, amount []byte) []byte
+}
+
+// LimitedAccumulator is an Accumulator of usages that transactions check
+// against a limit before adding to them, such as the gas used by a Msg type
+// in a block. A transaction that would exceed the limit fails instead, so the
+// lanes only do not conflict on such a key as long as its sums stay within
+// the limit. The usages are bookkeeping: they are accessed through
+// Context.MultiStore without consuming gas, rather than through
+// Context.KVStore.
+type LimitedAccumulator interface {
+	Accumulator
+
+	// WithinLimit returns true if value does not exceed the limit of the key
+	// in the state of ctx.
+	WithinLimit(ctx sdk.Context, key, value []byte) bool
+}
+
+// accumulator is an Accumulator registered for the keys with a prefix in the
+// store of a store key.
+type accumulator struct {
+	Accumulator
+	storeKey sdk.StoreKey
+	prefix   []byte
+}
+
+// storeKeyPair is a key in the store of a store key.
+type storeKeyPair struct {
+	store sdk.StoreKey
+	key   string
+}
+
+// accumulation is the access of a transaction to a key of an accumulator. It
+// holds the values the transaction read and wrote, alternately, and the value
+// of the key in the lane after the transaction.
+type accumulation struct {
+	*accumulator
+	key    []byte
+	values [][]byte
+	after  []byte
+}
+
+// accumulationRecorder records the accesses of the transaction being executed
+// in a lane to the keys of the accumulators.
+type accumulationRecorder struct {
+	accumulators []*accumulator
+
+	keys   map[storeKeyPair]*accumulation
+	order  []*accumulation
+	failed bool
+}
+
+func newAccumulationRecorder(accumulators []*accumulator) *accumulationRecorder {
+	return &accumulationRecorder{
+		accumulators: accumulators,
+		keys:         make(map[storeKeyPair]*accumulation),
+	}
+}
+
+// find returns the accumulator of a key, or nil if there is none.
+func (r *accumulationRecorder) find(storeKey sdk.StoreKey, key []byte) *accumulator {
+	for _, acc := range r.accumulators {
+		if acc.storeKey == storeKey && bytes.HasPrefix(key, acc.prefix) {
+			return acc
+		}
+	}
+	return nil
+}
+
+// overlaps returns true if the domain [start, end) contains keys of an
+// accumulator.
+func (r *accumulationRecorder) overlaps(storeKey sdk.StoreKey, start, end []byte) bool {
+	for _, acc := range r.accumulators {
+		if acc.storeKey != storeKey {
+			continue
+		}
+		prefixEnd := sdk.PrefixEndBytes(acc.prefix)
+		if (end == nil || bytes.Compare(end, acc.prefix) > 0) &&
+			(start == nil || prefixEnd == nil || bytes.Compare(start, prefixEnd) < 0) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *accumulationRecorder) accumulation(acc *accumulator, key []byte) *accumulation {
+	p := storeKeyPair{acc.storeKey, string(key)}
+	a, ok := r.keys[p]
+	if !ok {
+		a = &accumulation{accumulator: acc, key: key}
+		r.keys[p] = a
+		r.order = append(r.order, a)
+	}
+	return a
+}
+
+// read records a read of a key of an accumulator. It must be followed by a
+// write before the key is read again.
+func (r *accumulationRecorder) read(acc *accumulator, key, value []byte) {
+	a := r.accumulation(acc, key)
+	if len(a.values)%2 == 1 {
+		r.failed = true
+	}
+	a.values = append(a.values, value)
+}
+
+// write records a write of a key of an accumulator. It must follow a read of
+// a smaller value.
+func (r *accumulationRecorder) write(acc *accumulator, key, value []byte) {
+	a := r.accumulation(acc, key)
+	if len(a.values)%2 == 0 {
+		r.failed = true
+	} else if _, ok := acc.Sub(value, a.values[len(a.values)-1]); !ok {
+		r.failed = true
+	}
+	a.values = append(a.values, value)
+}
+
+// done returns the accumulations of the transaction, with the values their
+// keys have in ms after it, and whether the transaction accessed the keys of
+// the accumulators other than by adding to them. It resets the recorder for
+// the next transaction.
+func (r *accumulationRecorder) done(ms sdk.MultiStore) ([]*accumulation, bool) {
+	accs, failed := r.order, r.failed
+	for _, a := range accs {
+		if len(a.values)%2 == 1 {
+			failed = true
+		}
+		a.after = ms.GetKVStore(a.storeKey).Get(a.key)
+	}
+
+	r.keys = make(map[storeKeyPair]*accumulation)
+	r.order = nil
+	r.failed = false
+
+	return accs, failed
+}
+
+// accumulatingMultiStore is the MultiStore of the transactions executed in a
+// lane. It wraps the KVStores of the accumulators, and those of its branches,
+// so that every access to their keys is recorded.
+type accumulatingMultiStore struct {
+	cacheMultiStore
+	recorder *accumulationRecorder
+}
+
+// cacheMultiStore lets accumulatingMultiStore embed a CacheMultiStore, whose
+// field name would clash with the CacheMultiStore method.
+type cacheMultiStore = sdk.CacheMultiStore
+
+// GetKVStore implements MultiStore.
+func (ms accumulatingMultiStore) GetKVStore(key sdk.StoreKey) sdk.KVStore {
+	s := ms.cacheMultiStore.GetKVStore(key)
+	for _, acc := range ms.recorder.accumulators {
+		if acc.storeKey == key {
+			return accumulatingStore{KVStore: s, storeKey: key, recorder: ms.recorder}
+		}
+	}
+	return s
+}
+
+// GetStore implements MultiStore.
+func (ms accumulatingMultiStore) GetStore(key sdk.StoreKey) sdk.Store {
+	return ms.GetKVStore(key)
+}
+
+// CacheMultiStore implements MultiStore.
+func (ms accumulatingMultiStore) CacheMultiStore() sdk.CacheMultiStore {
+	return accumulatingMultiStore{cacheMultiStore: ms.cacheMultiStore.CacheMultiStore(), recorder: ms.recorder}
+}
+
+// CacheWrap implements CacheWrapper.
+func (ms accumulatingMultiStore) CacheWrap() sdk.CacheWrap {
+	return ms.CacheMultiStore().(sdk.CacheWrap)
+}
+
+// SetTracingContext implements MultiStore.
+func (ms accumulatingMultiStore) SetTracingContext(tc sdk.TraceContext) sdk.MultiStore {
+	ms.cacheMultiStore.SetTracingContext(tc)
+	return ms
+}
+
+// accumulatingStore records the accesses to the keys of the accumulators of
+// its store key. Accesses that cannot be merged with those of other lanes,
+// checking for a key or iterating over keys, make the recording fail.
+type accumulatingStore struct {
+	sdk.KVStore
+	storeKey sdk.StoreKey
+	recorder *accumulationRecorder
+}
+
+// Get implements KVStore.
+func (s accumulatingStore) Get(key []byte) []byte {
+	value := s.KVStore.Get(key)
+	if acc := s.recorder.find(s.storeKey, key); acc != nil {
+		s.recorder.read(acc, key, value)
+	}
+	return value
+}
+
+// Has implements KVStore.
+func (s accumulatingStore) Has(key []byte) bool {
+	if s.recorder.find(s.storeKey, key) != nil {
+		s.recorder.failed = true
+	}
+	return s.KVStore.Has(key)
+}
+
+// Set implements KVStore.
+func (s accumulatingStore) Set(key, value []byte) {
+	if acc := s.recorder.find(s.storeKey, key); acc != nil {
+		s.recorder.write(acc, key, value)
+	}
+	s.KVStore.Set(key, value)
+}
+
+// Delete implements KVStore.
+func (s accumulatingStore) Delete(key []byte) {
+	if s.recorder.find(s.storeKey, key) != nil {
+		s.recorder.failed = true
+	}
+	s.KVStore.Delete(key)
+}
+
+// Iterator implements KVStore.
+func (s accumulatingStore) Iterator(start, end []byte) sdk.Iterator {
+	if s.recorder.overlaps(s.storeKey, start, end) {
+		s.recorder.failed = true
+	}
+	return s.KVStore.Iterator(start, end)
+}
+
+// ReverseIterator implements KVStore.
+func (s accumulatingStore) ReverseIterator(start, end []byte) sdk.Iterator {
+	if s.recorder.overlaps(s.storeKey, start, end) {
+		s.recorder.failed = true
+	}
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+// accumulatedSums are the values the keys of the accumulators have after a
+// block in serial execution, by key in the order the keys were first accessed.
+type accumulatedSums struct {
+	values map[storeKeyPair][]byte
+	keys   []storeKeyPair
+}
+
+// contains returns true if the key was accumulated.
+func (s accumulatedSums) contains(p storeKeyPair) bool {
+	_, ok := s.values[p]
+	return ok
+}
+
+// sumAccumulations replays, in block order, the accumulations of the
+// transactions on the values their keys have in serial execution. It rebases
+// the state changes of each transaction on them and corrects its gas for the
+// different lengths of the values read and written, or checks the values
+// written against the limits of a LimitedAccumulator. If a transaction accessed
+// the keys of the accumulators other than by adding to them, nothing is
+// accumulated and the lanes conflict on the keys as on any other. It returns
+// false if the block cannot match serial execution.
+func (app *BaseApp) sumAccumulations(txs []*laneTx) (accumulatedSums, bool) {
+	sums := accumulatedSums{values: make(map[storeKeyPair][]byte)}
+	for _, ltx := range txs {
+		if ltx.accumulationFailed {
+			return sums, true
+		}
+	}
+
+	gasConfig := store.KVGasConfig()
+	// the limits are those of the state before the block, which no lane
+	// changed without conflicting with the lanes reading them
+	limitCtx := app.deliverState.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	for _, ltx := range txs {
+		if len(ltx.accumulations) == 0 {
+			continue
+		}
+
+		type offset struct {
+			acc    *accumulator
+			amount []byte
+		}
+
+		var gasDelta int64
+		offsets := make(map[storeKeyPair]offset, len(ltx.accumulations))
+		for _, a := range ltx.accumulations {
+			p := storeKeyPair{a.storeKey, string(a.key)}
+			sum, ok := sums.values[p]
+			if !ok {
+				sum = app.deliverState.ms.GetKVStore(a.storeKey).Get(a.key)
+				sums.keys = append(sums.keys, p)
+			}
+
+			// the lane ran the tx on a value of the key lacking the additions
+			// of the other lanes before it, which make up the offset
+			amount, ok := a.Sub(sum, a.values[0])
+			if !ok {
+				return sums, false
+			}
+			limited, isLimited := a.Accumulator.(LimitedAccumulator)
+			for i, v := range a.values {
+				if isLimited {
+					// in serial execution, the tx would fail on the limit
+					// instead of adding to the key
+					if i%2 == 1 && !limited.WithinLimit(limitCtx, a.key, a.Add(v, amount)) {
+						return sums, false
+					}
+					continue
+				}
+
+				cost := gasConfig.ReadCostPerByte
+				if i%2 == 1 {
+					cost = gasConfig.WriteCostPerByte
+				}
+				gasDelta += int64(cost) * int64(len(a.Add(v, amount))-len(v))
+			}
+
+			sums.values[p] = a.Add(a.after, amount)
+			offsets[p] = offset{acc: a.accumulator, amount: amount}
+		}
+
+		for i, c := range ltx.changes {
+			if o, ok := offsets[storeKeyPair{c.storeKey, string(c.key)}]; ok {
+				ltx.changes[i].value = o.acc.Add(c.value, o.amount)
+			}
+		}
+
+		if gasDelta != 0 {
+			gasUsed := int64(ltx.gInfo.GasUsed) + gasDelta
+			if gasUsed < 0 || uint64(gasUsed) > ltx.gInfo.GasWanted {
+				return sums, false
+			}
+			ltx.gInfo.GasUsed = uint64(gasUsed)
+			ltx.gasUsed = uint64(int64(ltx.gasUsed) + gasDelta)
+		}
+	}
+
+	return sums, true
+}
@@ -56,6 +56,7 @@ type BaseApp struct { // nolint: maligned
 	addrPeerFilter sdk.PeerFilter   // filter peers by address and port
 	idPeerFilter   sdk.PeerFilter   // filter peers by node ID
 	fauxMerkleMode bool             // if true, IAVL MountStores uses MountStoresDB for simulation speed.
+	storeKeys      []sdk.StoreKey   // keys of all stores mounted with MountStore
 
 	// manages snapshots, i.e. dumps of app state at certain intervals
 	snapshotManager    *snapshots.Manager
@@ -74,6 +75,16 @@ type BaseApp struct { // nolint: maligned
 	checkAccountWGs *AccountWGs
 	chCheckTx       chan *RequestCheckTxAsync
 
+	// number of lanes executed concurrently by DeliverTxBatch; parallel
+	// execution is disabled if it is less than two
+	deliverTxWorkers int
+	// keys that the lanes of DeliverTxBatch add to without conflicting
+	accumulators []*accumulator
+
+	// abciListeners for hooking into the ABCI message processing of the BaseApp
+	// and exposing the requests and responses to external consumers
+	abciListeners []ABCIListener
+
 	// an inter-block write-through cache provided to the context during deliverState
 	interBlockCache sdk.MultiStorePersistentCache
 
@@ -88,6 +99,14 @@ type BaseApp struct { // nolint: maligned
 	// transaction. This is mainly used for DoS and spam prevention.
 	minGasPrices sdk.DecCoins
 
+	// dynamicMinGasPrices, if set, adjusts the minimum gas prices of CheckTx to
+	// the fullness of the recent blocks by minGasPricesMultiplier
+	dynamicMinGasPrices    *DynamicMinGasPrices
+	minGasPricesMultiplier sdk.Dec
+
+	// weights of the tx priority by Msg type URL
+	msgPriorityWeights map[string]sdk.Dec
+
 	// initialHeight is the initial height at which we start the baseapp
 	initialHeight int64
 
@@ -183,6 +202,14 @@ func (app *BaseApp) Logger() log.Logger {
 // MsgServiceRouter returns the MsgServiceRouter of a BaseApp.
 func (app *BaseApp) MsgServiceRouter() *MsgServiceRouter { return app.msgServiceRouter }
 
+// CommitMultiStore returns the root multi-store of a BaseApp. The store must
+// not be written outside of the ABCI methods of the BaseApp.
+func (app *BaseApp) CommitMultiStore() sdk.CommitMultiStore { return app.cms }
+
+// SnapshotManager returns the snapshot manager of a BaseApp, or nil if no
+// snapshot store is set. Apps use it to register snapshot extensions.
+func (app *BaseApp) SnapshotManager() *snapshots.Manager { return app.snapshotManager }
+
 // MountStores mounts all IAVL or DB stores to the provided keys in the BaseApp
 // multistore.
 func (app *BaseApp) MountStores(keys ...sdk.StoreKey) {
@@ -217,6 +244,14 @@ func (app *BaseApp) MountKVStores(keys map[string]*sdk.KVStoreKey) {
 	}
 }
 
+// MountTransientStores mounts all transient stores to the provided keys in
+// the BaseApp multistore.
+func (app *BaseApp) MountTransientStores(keys map[string]*sdk.TransientStoreKey) {
+	for _, key := range keys {
+		app.MountStore(key, sdk.StoreTypeTransient)
+	}
+}
+
 // MountMemoryStores mounts all in-memory KVStores with the BaseApp's internal
 // commit multi-store.
 func (app *BaseApp) MountMemoryStores(keys map[string]*sdk.MemoryStoreKey) {
@@ -229,6 +264,7 @@ func (app *BaseApp) MountMemoryStores(keys map[string]*sdk.MemoryStoreKey) {
 // using the default DB.
 func (app *BaseApp) MountStore(key sdk.StoreKey, typ sdk.StoreType) {
 	app.cms.MountStoreWithDB(key, typ, nil)
+	app.storeKeys = append(app.storeKeys, key)
 }
 
 // LoadLatestVersion loads the latest application version. It will panic if
@@ -314,6 +350,10 @@ func (app *BaseApp) setInterBlockCache(cache sdk.MultiStorePersistentCache) {
 	app.interBlockCache = cache
 }
 
+func (app *BaseApp) setDeliverTxWorkers(workers int) {
+	app.deliverTxWorkers = workers
+}
+
 func (app *BaseApp) setTrace(trace bool) {
 	app.trace = trace
 }
@@ -349,14 +389,15 @@ func (app *BaseApp) IsSealed() bool { return app.sealed }
 // setCheckState sets the BaseApp's checkState with a branched multi-store
 // (i.e. a CacheMultiStore) and a new Context with the same multi-store branch,
 // provided header, and minimum gas prices set. It is set on InitChain and reset
-// on Commit.
+// on Commit. The minimum gas prices are adjusted to the recent blocks if
+// dynamic minimum gas prices are enabled.
 func (app *BaseApp) setCheckState(header ostproto.Header) {
 	ms := app.cms.CacheMultiStore()
 	app.checkStateMtx.Lock()
 	defer app.checkStateMtx.Unlock()
 
 	ctx := sdk.NewContext(ms, header, true, app.logger).
-		WithMinGasPrices(app.minGasPrices).
+		WithMinGasPrices(app.checkMinGasPrices()).
 		WithVoteInfos(app.voteInfos)
 
 	app.checkState = &state{
@@ -561,7 +602,9 @@ func (app *BaseApp) preCheckTx(txBytes []byte) (tx sdk.Tx, err error) {
 	return tx, err
 }
 
-func (app *BaseApp) checkTx(txBytes []byte, tx sdk.Tx, recheck bool) (gInfo sdk.GasInfo, err error) {
+// checkTx runs the AnteHandler on the tx in CheckTx mode. It returns the gas
+// info and the priority of the tx set by the AnteHandler, weighted by Msg type.
+func (app *BaseApp) checkTx(txBytes []byte, tx sdk.Tx, recheck bool) (gInfo sdk.GasInfo, priority int64, err error) {
 	ctx := app.getCheckContextForTx(txBytes, recheck)
 	gasCtx := &ctx
 
@@ -577,9 +620,10 @@ func (app *BaseApp) checkTx(txBytes []byte, tx sdk.Tx, recheck bool) (gInfo sdk.
 	anteCtx, err = app.anteTx(ctx, txBytes, tx, false)
 	if !anteCtx.IsZero() {
 		gasCtx = &anteCtx
+		priority = app.txPriority(anteCtx.Priority(), tx.GetMsgs())
 	}
 
-	return gInfo, err
+	return gInfo, priority, err
 }
 
 func (app *BaseApp) anteTx(ctx sdk.Context, txBytes []byte, tx sdk.Tx, simulate bool) (sdk.Context, error) {
@@ -614,18 +658,23 @@ func (app *BaseApp) anteTx(ctx sdk.Context, txBytes []byte, tx sdk.Tx, simulate
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(txBytes []byte, tx sdk.Tx, simulate bool) (gInfo sdk.GasInfo, result *sdk.Result, err error) {
-	ctx := app.getRunContextForTx(txBytes, simulate)
+	return app.runTxWithContext(app.getRunContextForTx(txBytes, simulate), txBytes, tx, simulate)
+}
+
+// runTxWithContext processes a transaction like runTx, using the given context
+// instead of the one of the current execution mode.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, txBytes []byte, tx sdk.Tx, simulate bool) (gInfo sdk.GasInfo, result *sdk.Result, err error) {
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
 	if !simulate && ctx.BlockGasMeter().IsOutOfGas() {
-		gInfo = sdk.GasInfo{GasUsed: ctx.BlockGasMeter().GasConsumed()}
+		gInfo = sdk.GasInfo{GasUsed: blockGasConsumed(ctx.BlockGasMeter())}
 		return gInfo, nil, sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
 	}
 
 	var startingGas uint64
 	if !simulate {
-		startingGas = ctx.BlockGasMeter().GasConsumed()
+		startingGas = blockGasConsumed(ctx.BlockGasMeter())
 	}
 
 	defer func() {
@@ -648,7 +697,7 @@ func (app *BaseApp) runTx(txBytes []byte, tx sdk.Tx, simulate bool) (gInfo sdk.G
 				ctx.GasMeter().GasConsumedToLimit(), "block gas meter",
 			)
 
-			if ctx.BlockGasMeter().GasConsumed() < startingGas {
+			if blockGasConsumed(ctx.BlockGasMeter()) < startingGas {
 				panic(sdk.ErrorGasOverflow{Descriptor: "tx gas summation"})
 			}
 		}
@@ -0,0 +1,180 @@
+package baseapp
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	abci "github.com/line/ostracon/abci/types"
+
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+// DynamicMinGasPrices defines how the minimum gas prices of CheckTx follow the
+// fullness of the recent blocks. After each block, the configured minimum gas
+// prices are multiplied by a factor which is raised when the block used more
+// gas than TargetBlockFullness of the maximum block gas, and lowered otherwise,
+// by at most MaxChangeRate. The factor stays between one and MaxMultiplier, so
+// the configured minimum gas prices are a floor. Blocks are not adjusted for
+// if the maximum block gas is unlimited.
+type DynamicMinGasPrices struct {
+	// TargetBlockFullness is the ratio of the maximum block gas used by a block
+	// which keeps the minimum gas prices unchanged, e.g. 0.5.
+	TargetBlockFullness sdk.Dec
+	// MaxChangeRate is the maximum relative change of the minimum gas prices
+	// after a block, e.g. 0.125.
+	MaxChangeRate sdk.Dec
+	// MaxMultiplier is the maximum ratio of the minimum gas prices to the
+	// configured ones.
+	MaxMultiplier sdk.Dec
+}
+
+// Validate checks the bounds of the dynamic minimum gas prices configuration.
+func (d DynamicMinGasPrices) Validate() error {
+	switch {
+	case d.TargetBlockFullness.IsNil() || !d.TargetBlockFullness.IsPositive() || d.TargetBlockFullness.GT(sdk.OneDec()):
+		return fmt.Errorf("target block fullness must be in (0, 1]: %s", d.TargetBlockFullness)
+	case d.MaxChangeRate.IsNil() || d.MaxChangeRate.IsNegative() || d.MaxChangeRate.GT(sdk.OneDec()):
+		return fmt.Errorf("max change rate must be in [0, 1]: %s", d.MaxChangeRate)
+	case d.MaxMultiplier.IsNil() || d.MaxMultiplier.LT(sdk.OneDec()):
+		return fmt.Errorf("max multiplier must be at least 1: %s", d.MaxMultiplier)
+	}
+
+	return nil
+}
+
+// nextMultiplier returns the minimum gas prices multiplier following a block
+// which used gasUsed out of maxGas.
+func (d DynamicMinGasPrices) nextMultiplier(multiplier sdk.Dec, gasUsed, maxGas uint64) sdk.Dec {
+	if maxGas == 0 {
+		return multiplier
+	}
+
+	fullness := sdk.NewDecFromInt(sdk.NewIntFromUint64(gasUsed)).QuoInt(sdk.NewIntFromUint64(maxGas))
+	// the deviation from the target is relative to the target, and capped to one
+	// so that the change of a block is at most MaxChangeRate
+	deviation := fullness.Sub(d.TargetBlockFullness).Quo(d.TargetBlockFullness)
+	if deviation.GT(sdk.OneDec()) {
+		deviation = sdk.OneDec()
+	}
+
+	next := multiplier.Mul(sdk.OneDec().Add(d.MaxChangeRate.Mul(deviation)))
+	switch {
+	case next.LT(sdk.OneDec()):
+		return sdk.OneDec()
+	case next.GT(d.MaxMultiplier):
+		return d.MaxMultiplier
+	default:
+		return next
+	}
+}
+
+func (app *BaseApp) setDynamicMinGasPrices(d DynamicMinGasPrices) {
+	app.dynamicMinGasPrices = &d
+	app.minGasPricesMultiplier = sdk.OneDec()
+}
+
+// checkMinGasPrices returns the minimum gas prices of CheckTx, i.e. the
+// configured ones adjusted to the recent blocks if dynamic minimum gas prices
+// are enabled.
+func (app *BaseApp) checkMinGasPrices() sdk.DecCoins {
+	if app.dynamicMinGasPrices == nil || app.minGasPricesMultiplier.Equal(sdk.OneDec()) {
+		return app.minGasPrices
+	}
+
+	return app.minGasPrices.MulDec(app.minGasPricesMultiplier)
+}
+
+// adjustMinGasPrices updates the minimum gas prices multiplier according to
+// the gas used by the block of the given deliver context. It is called on
+// Commit, before the check state is reset.
+func (app *BaseApp) adjustMinGasPrices(ctx sdk.Context) {
+	// the block gas meter isn't set if no block was executed since InitChain
+	if app.dynamicMinGasPrices == nil || ctx.BlockGasMeter() == nil {
+		return
+	}
+
+	gasUsed, maxGas := blockGasConsumed(ctx.BlockGasMeter()), app.getMaximumBlockGas(ctx)
+	next := app.dynamicMinGasPrices.nextMultiplier(app.minGasPricesMultiplier, gasUsed, maxGas)
+	if !next.Equal(app.minGasPricesMultiplier) {
+		app.logger.Debug("adjusted minimum gas prices", "multiplier", next, "gas_used", gasUsed, "max_gas", maxGas)
+	}
+	app.minGasPricesMultiplier = next
+}
+
+// ParseMsgPriorityWeights parses weights of the form "{msgTypeURL}={weight}",
+// e.g. "/lfb.bank.v1beta1.MsgSend=2", where the weight is a non-negative decimal.
+func ParseMsgPriorityWeights(weights []string) (map[string]sdk.Dec, error) {
+	parsed := make(map[string]sdk.Dec, len(weights))
+	for _, w := range weights {
+		kv := strings.SplitN(w, "=", 2)
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" {
+			return nil, fmt.Errorf("invalid msg priority weight %q, expected {msgTypeURL}={weight}", w)
+		}
+
+		weight, err := sdk.NewDecFromStr(strings.TrimSpace(kv[1]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid msg priority weight %q: %w", w, err)
+		}
+		if weight.IsNegative() {
+			return nil, fmt.Errorf("invalid msg priority weight %q: weight must not be negative", w)
+		}
+
+		parsed[strings.TrimSpace(kv[0])] = weight
+	}
+
+	return parsed, nil
+}
+
+func (app *BaseApp) setMsgPriorityWeights(weights map[string]sdk.Dec) {
+	app.msgPriorityWeights = weights
+}
+
+// txPriority weighs the priority computed by the AnteHandler with the weights
+// of the Msg types of the tx. Msg types without a weight have a weight of one,
+// and a tx has the lowest weight of its Msgs, so that low priority Msgs can't
+// be included in a tx along with a high priority one to pass ahead of others.
+func (app *BaseApp) txPriority(priority int64, msgs []sdk.Msg) int64 {
+	if len(app.msgPriorityWeights) == 0 || len(msgs) == 0 {
+		return priority
+	}
+
+	weight := sdk.OneDec()
+	for i, msg := range msgs {
+		w, ok := app.msgPriorityWeights[sdk.MsgTypeURL(msg)]
+		if !ok {
+			w = sdk.OneDec()
+		}
+		if i == 0 || w.LT(weight) {
+			weight = w
+		}
+	}
+
+	weighted := weight.MulInt64(priority).TruncateInt()
+	if !weighted.IsInt64() {
+		return math.MaxInt64
+	}
+
+	return weighted.Int64()
+}
+
+// responseCheckTx returns the response of a tx passing CheckTx. The priority
+// of the tx is returned in an event, as ResponseCheckTx has no field for it.
+// The event is left out if the priority is zero. The Ostracon mempool does not
+// read the event, so the priority does not change the order of the txs in the
+// mempool or in blocks; it is only exposed to clients and indexers.
+func responseCheckTx(gInfo sdk.GasInfo, priority int64) abci.ResponseCheckTx {
+	res := abci.ResponseCheckTx{
+		GasWanted: int64(gInfo.GasWanted), // TODO: Should type accept unsigned ints?
+		GasUsed:   int64(gInfo.GasUsed),   // TODO: Should type accept unsigned ints?
+	}
+
+	if priority != 0 {
+		res.Events = sdk.Events{
+			sdk.NewEvent(sdk.EventTypeTx, sdk.NewAttribute(sdk.AttributeKeyPriority, strconv.FormatInt(priority, 10))),
+		}.ToABCIEvents()
+	}
+
+	return res
+}
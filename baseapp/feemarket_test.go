@@ -0,0 +1,160 @@
+package baseapp
+
+import (
+	"testing"
+
+	abci "github.com/line/ostracon/abci/types"
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/codec"
+	"github.com/line/lfb-sdk/testutil/testdata"
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+func TestDynamicMinGasPricesValidate(t *testing.T) {
+	valid := DynamicMinGasPrices{
+		TargetBlockFullness: sdk.NewDecWithPrec(5, 1),
+		MaxChangeRate:       sdk.NewDecWithPrec(125, 3),
+		MaxMultiplier:       sdk.NewDec(10),
+	}
+	require.NoError(t, valid.Validate())
+
+	invalid := []func(d *DynamicMinGasPrices){
+		func(d *DynamicMinGasPrices) { d.TargetBlockFullness = sdk.Dec{} },
+		func(d *DynamicMinGasPrices) { d.TargetBlockFullness = sdk.ZeroDec() },
+		func(d *DynamicMinGasPrices) { d.TargetBlockFullness = sdk.NewDecWithPrec(11, 1) },
+		func(d *DynamicMinGasPrices) { d.MaxChangeRate = sdk.NewDec(-1) },
+		func(d *DynamicMinGasPrices) { d.MaxChangeRate = sdk.NewDec(2) },
+		func(d *DynamicMinGasPrices) { d.MaxMultiplier = sdk.NewDecWithPrec(9, 1) },
+	}
+	for i, malleate := range invalid {
+		d := valid
+		malleate(&d)
+		require.Error(t, d.Validate(), "case %d", i)
+	}
+}
+
+func TestDynamicMinGasPricesNextMultiplier(t *testing.T) {
+	d := DynamicMinGasPrices{
+		TargetBlockFullness: sdk.NewDecWithPrec(5, 1),
+		MaxChangeRate:       sdk.NewDecWithPrec(125, 3),
+		MaxMultiplier:       sdk.NewDec(2),
+	}
+
+	testCases := []struct {
+		name       string
+		d          DynamicMinGasPrices
+		multiplier sdk.Dec
+		gasUsed    uint64
+		maxGas     uint64
+		expected   sdk.Dec
+	}{
+		{"full block", d, sdk.OneDec(), 100, 100, sdk.MustNewDecFromStr("1.125")},
+		{"target fullness", d, sdk.MustNewDecFromStr("1.5"), 50, 100, sdk.MustNewDecFromStr("1.5")},
+		{"empty block", d, sdk.MustNewDecFromStr("1.5"), 0, 100, sdk.MustNewDecFromStr("1.3125")},
+		{"quarter full block", d, sdk.MustNewDecFromStr("1.5"), 25, 100, sdk.MustNewDecFromStr("1.40625")},
+		{"unlimited block gas", d, sdk.MustNewDecFromStr("1.5"), 100, 0, sdk.MustNewDecFromStr("1.5")},
+		{"floor", d, sdk.MustNewDecFromStr("1.1"), 0, 100, sdk.OneDec()},
+		{"ceiling", d, sdk.MustNewDecFromStr("1.9"), 100, 100, sdk.NewDec(2)},
+		{
+			"change capped to max change rate",
+			DynamicMinGasPrices{
+				TargetBlockFullness: sdk.NewDecWithPrec(25, 2),
+				MaxChangeRate:       d.MaxChangeRate,
+				MaxMultiplier:       d.MaxMultiplier,
+			},
+			sdk.OneDec(), 100, 100, sdk.MustNewDecFromStr("1.125"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.d.nextMultiplier(tc.multiplier, tc.gasUsed, tc.maxGas))
+		})
+	}
+}
+
+func TestParseMsgPriorityWeights(t *testing.T) {
+	weights, err := ParseMsgPriorityWeights([]string{"/testdata.TestMsg=2", " /lfb.bank.v1beta1.MsgSend = 0.5 "})
+	require.NoError(t, err)
+	require.Equal(t, map[string]sdk.Dec{
+		"/testdata.TestMsg":         sdk.NewDec(2),
+		"/lfb.bank.v1beta1.MsgSend": sdk.NewDecWithPrec(5, 1),
+	}, weights)
+
+	for _, invalid := range []string{"/testdata.TestMsg", "=2", "/testdata.TestMsg=two", "/testdata.TestMsg=-1"} {
+		_, err := ParseMsgPriorityWeights([]string{invalid})
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestTxPriority(t *testing.T) {
+	app := setupBaseApp(t)
+	require.Equal(t, int64(100), app.txPriority(100, []sdk.Msg{testdata.NewTestMsg()}))
+
+	app = setupBaseApp(t, SetMsgPriorityWeights([]string{"/testdata.TestMsg=2.5", "/testdata.MsgCreateDog=0.5"}))
+	require.Equal(t, int64(250), app.txPriority(100, []sdk.Msg{testdata.NewTestMsg()}))
+	require.Equal(t, int64(50), app.txPriority(100, []sdk.Msg{testdata.NewTestMsg(), sdk.ServiceMsg{Request: &testdata.MsgCreateDog{}}}))
+	// Msgs without a weight have a weight of one
+	require.Equal(t, int64(100), app.txPriority(100, []sdk.Msg{testdata.NewTestMsg(), msgCounter{}}))
+	require.Equal(t, int64(9223372036854775807), app.txPriority(9223372036854775807, []sdk.Msg{testdata.NewTestMsg()}))
+}
+
+func TestCheckTxPriorityAndDynamicMinGasPrices(t *testing.T) {
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return ctx.WithPriority(tx.(txTest).Counter), nil
+		})
+	}
+	app := setupBaseApp(t, anteOpt,
+		SetMinGasPrices("0.1stake"),
+		SetDynamicMinGasPrices(&DynamicMinGasPrices{
+			TargetBlockFullness: sdk.NewDecWithPrec(5, 1),
+			MaxChangeRate:       sdk.NewDecWithPrec(125, 3),
+			MaxMultiplier:       sdk.NewDec(10),
+		}),
+	)
+	app.InitChain(abci.RequestInitChain{
+		ConsensusParams: &abci.ConsensusParams{
+			Block: &abci.BlockParams{MaxGas: 100},
+		},
+	})
+
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	txBytes, err := cdc.MarshalBinaryBare(newTxCounter(42, 0))
+	require.NoError(t, err)
+	res := app.CheckTxSync(abci.RequestCheckTx{Tx: txBytes})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, []abci.Event{{
+		Type:       sdk.EventTypeTx,
+		Attributes: []abci.EventAttribute{{Key: []byte(sdk.AttributeKeyPriority), Value: []byte("42")}},
+	}}, res.Events)
+
+	// the async reactor returns the same response
+	done := make(chan abci.ResponseCheckTx, 1)
+	app.CheckTxAsync(abci.RequestCheckTx{Tx: txBytes}, func(res abci.ResponseCheckTx) { done <- res })
+	require.Equal(t, res, <-done)
+
+	// a full block raises the minimum gas prices of the next CheckTx
+	header := ostproto.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.deliverState.ctx.BlockGasMeter().ConsumeGas(100, "test")
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+	app.BeginRecheckTx(abci.RequestBeginRecheckTx{Header: header})
+	app.EndRecheckTx(abci.RequestEndRecheckTx{})
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.MustNewDecFromStr("0.1125"))), app.checkState.ctx.MinGasPrices())
+
+	// an empty block lowers them, down to the configured ones
+	header = ostproto.Header{Height: 2}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+	app.BeginRecheckTx(abci.RequestBeginRecheckTx{Header: header})
+	app.EndRecheckTx(abci.RequestEndRecheckTx{})
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.MustNewDecFromStr("0.1"))), app.checkState.ctx.MinGasPrices())
+}
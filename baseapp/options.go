@@ -32,6 +32,32 @@ func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.setMinGasPrices(gasPrices) }
 }
 
+// SetDynamicMinGasPrices returns an option that adjusts the minimum gas prices
+// of CheckTx to the fullness of the recent blocks. See DynamicMinGasPrices.
+// A nil configuration keeps the minimum gas prices static.
+func SetDynamicMinGasPrices(d *DynamicMinGasPrices) func(*BaseApp) {
+	if d == nil {
+		return func(*BaseApp) {}
+	}
+	if err := d.Validate(); err != nil {
+		panic(fmt.Sprintf("invalid dynamic minimum gas prices: %v", err))
+	}
+
+	return func(bap *BaseApp) { bap.setDynamicMinGasPrices(*d) }
+}
+
+// SetMsgPriorityWeights returns an option that sets the weights the priority of
+// a tx in CheckTx is multiplied by according to the type of its Msgs, in the
+// form "{msgTypeURL}={weight}".
+func SetMsgPriorityWeights(weights []string) func(*BaseApp) {
+	parsed, err := ParseMsgPriorityWeights(weights)
+	if err != nil {
+		panic(fmt.Sprintf("invalid msg priority weights: %v", err))
+	}
+
+	return func(bap *BaseApp) { bap.setMsgPriorityWeights(parsed) }
+}
+
 // SetHaltHeight returns a BaseApp option function that sets the halt block height.
 func SetHaltHeight(blockHeight uint64) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.setHaltHeight(blockHeight) }
@@ -49,6 +75,13 @@ func SetMinRetainBlocks(minRetainBlocks uint64) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.setMinRetainBlocks(minRetainBlocks) }
 }
 
+// SetParallelDeliverTx returns a BaseApp option function that sets the number
+// of lanes DeliverTxBatch executes concurrently. A value below two keeps block
+// execution serial.
+func SetParallelDeliverTx(workers int) func(*BaseApp) {
+	return func(app *BaseApp) { app.setDeliverTxWorkers(workers) }
+}
+
 // SetTrace will turn on or off trace flag
 func SetTrace(trace bool) func(*BaseApp) {
 	return func(app *BaseApp) { app.setTrace(trace) }
@@ -165,6 +198,17 @@ func (app *BaseApp) SetAnteHandler(ah sdk.AnteHandler) {
 	app.anteHandler = ah
 }
 
+// SetAccumulator registers an Accumulator for the keys with the given prefix
+// in the store of storeKey, so that the lanes of a block executed in parallel
+// do not conflict on them.
+func (app *BaseApp) SetAccumulator(storeKey sdk.StoreKey, prefix []byte, acc Accumulator) {
+	if app.sealed {
+		panic("SetAccumulator() on sealed BaseApp")
+	}
+
+	app.accumulators = append(app.accumulators, &accumulator{Accumulator: acc, storeKey: storeKey, prefix: prefix})
+}
+
 func (app *BaseApp) SetAddrPeerFilter(pf sdk.PeerFilter) {
 	if app.sealed {
 		panic("SetAddrPeerFilter() on sealed BaseApp")
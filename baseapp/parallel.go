@@ -0,0 +1,464 @@
+package baseapp
+
+import (
+	"sync"
+	"time"
+
+	abci "github.com/line/ostracon/abci/types"
+	"github.com/line/tm-db/v2/memdb"
+
+	"github.com/line/lfb-sdk/store/cachemulti"
+	"github.com/line/lfb-sdk/store/dbadapter"
+	store "github.com/line/lfb-sdk/store/types"
+	"github.com/line/lfb-sdk/telemetry"
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+)
+
+// DeliverTxBatch executes the transactions of a block in the given order and
+// returns their responses. It is equivalent to calling DeliverTx for each
+// request in turn.
+//
+// If parallel execution is enabled with SetParallelDeliverTx, the transactions
+// are split into lanes by signer, using the same signer sets as AccountWGs,
+// and the lanes are executed concurrently against their own branch of the
+// deliver state. Each lane records the keys it reads, iterates and writes.
+// The branches are only merged if no lane wrote a key that another lane
+// touched and the block gas accounting matches serial execution. Otherwise
+// all speculative results are discarded and the block is re-executed
+// serially, so the resulting state and app hash never differ from serial
+// execution.
+//
+// Keys that transactions only add to, such as the balances of the fee
+// collector, can be registered with SetAccumulator. The lanes do not conflict
+// on them: their additions are summed up in block order at merge time and the
+// gas of each transaction is corrected as if it had run on the sums. The
+// usages of a LimitedAccumulator, such as the gas used by a Msg type in a
+// block, are summed up likewise, but the block is re-executed serially if a
+// sum exceeds its limit.
+//
+// Parallel execution requires an AnteHandler that sets a gas meter for each
+// transaction before it consumes any gas. Without an AnteHandler the block is
+// always executed serially, and if a transaction consumes gas on the gas meter
+// of the deliver state, which serial execution shares between transactions,
+// the block is re-executed serially.
+func (app *BaseApp) DeliverTxBatch(reqs []abci.RequestDeliverTx) []abci.ResponseDeliverTx {
+	if app.deliverTxWorkers > 1 && app.anteHandler != nil && len(reqs) > 1 {
+		if res, ok := app.deliverTxsParallel(reqs); ok {
+			return res
+		}
+
+		telemetry.IncrCounter(1, "tx", "parallel", "fallback")
+		app.logger.Info("conflict detected in parallel execution; re-executing block serially",
+			"height", app.deliverState.ctx.BlockHeight(), "txs", len(reqs))
+	}
+
+	res := make([]abci.ResponseDeliverTx, len(reqs))
+	for i, req := range reqs {
+		res[i] = app.DeliverTx(req)
+	}
+
+	return res
+}
+
+// laneTx is a transaction scheduled in a lane together with the outcome of
+// its speculative execution.
+type laneTx struct {
+	index   int
+	txBytes []byte
+	tx      sdk.Tx
+	err     error // decoding error
+
+	gInfo  sdk.GasInfo
+	result *sdk.Result
+	runErr error
+
+	// gasStart is the block gas consumed before the tx as seen by its lane and
+	// gasUsed is the block gas it consumed itself.
+	gasStart uint64
+	gasUsed  uint64
+	// gasObserved is set if the tx read the block gas consumed, which makes
+	// its result depend on the gas used by the transactions before it.
+	gasObserved bool
+	// sharedGasUsed is set if the tx consumed gas on the gas meter of the
+	// deliver state instead of a gas meter of its own.
+	sharedGasUsed bool
+	// changes are the writes of the tx to the listened stores, in the order
+	// they were made.
+	changes []stateChange
+	// accumulations are the accesses of the tx to the keys of the
+	// accumulators. accumulationFailed is set if it accessed them other than
+	// by adding to them.
+	accumulations      []*accumulation
+	accumulationFailed bool
+}
+
+// lane is a sequence of transactions sharing signers, executed in order on a
+// single branch of the deliver state.
+type lane struct {
+	txs    []*laneTx
+	stores map[sdk.StoreKey]*accessTrackingStore
+}
+
+func (app *BaseApp) deliverTxsParallel(reqs []abci.RequestDeliverTx) ([]abci.ResponseDeliverTx, bool) {
+	defer telemetry.MeasureSince(time.Now(), "abci", "deliver_tx_batch")
+
+	lanes, txs := app.scheduleLanes(reqs)
+	txGasStart := app.deliverState.ctx.GasMeter().GasConsumed()
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, app.deliverTxWorkers)
+	for _, l := range lanes {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(l *lane) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			app.runLane(l, txGasStart)
+		}(l)
+	}
+	wg.Wait()
+
+	if usesSharedGasMeter(txs) {
+		return nil, false
+	}
+	sums, ok := app.sumAccumulations(txs)
+	if !ok || hasLaneConflict(lanes, sums) || !replayBlockGas(app.deliverState.ctx.BlockGasMeter(), txs) {
+		return nil, false
+	}
+
+	ms := app.deliverState.ms
+	for _, l := range lanes {
+		for _, key := range app.storeKeys {
+			if !ms.ListeningEnabled(key) {
+				l.stores[key].flush()
+			}
+		}
+	}
+	// the sums overwrite the values the lanes accumulated on their own; those
+	// of the listened stores are in the rebased state changes
+	for _, p := range sums.keys {
+		if !ms.ListeningEnabled(p.store) {
+			ms.GetKVStore(p.store).Set([]byte(p.key), sums.values[p])
+		}
+	}
+
+	// the writes to the listened stores are replayed tx by tx in block order,
+	// so that the listeners observe them as in serial execution
+	blockGasMeter := app.deliverState.ctx.BlockGasMeter()
+	res := make([]abci.ResponseDeliverTx, len(txs))
+	for i, ltx := range txs {
+		if ltx.err != nil {
+			res[i] = app.deliverTxResponse(sdk.GasInfo{}, nil, ltx.err)
+		} else {
+			for _, c := range ltx.changes {
+				if c.deleted {
+					ms.GetKVStore(c.storeKey).Delete(c.key)
+				} else {
+					ms.GetKVStore(c.storeKey).Set(c.key, c.value)
+				}
+			}
+			blockGasMeter.ConsumeGas(ltx.gasUsed, "block gas meter")
+			res[i] = app.deliverTxResponse(ltx.gInfo, ltx.result, ltx.runErr)
+		}
+		app.listenDeliverTx(reqs[i], res[i])
+	}
+
+	app.logger.Debug("executed block in parallel",
+		"height", app.deliverState.ctx.BlockHeight(), "txs", len(reqs), "lanes", len(lanes))
+
+	return res, true
+}
+
+// scheduleLanes decodes the requests and groups the transactions into lanes
+// so that transactions with a common signer end up in the same lane, in block
+// order. Transactions without signers, or that fail to decode, get a lane of
+// their own.
+func (app *BaseApp) scheduleLanes(reqs []abci.RequestDeliverTx) ([]*lane, []*laneTx) {
+	txs := make([]*laneTx, len(reqs))
+	parent := make([]int, len(reqs))
+	owner := make(map[string]int)
+
+	var find func(i int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	for i, req := range reqs {
+		parent[i] = i
+		tx, err := app.txDecoder(req.Tx)
+		txs[i] = &laneTx{index: i, txBytes: req.Tx, tx: tx, err: err}
+		if err != nil {
+			continue
+		}
+
+		for _, signer := range getUniqSigners(tx) {
+			if j, ok := owner[signer]; ok {
+				if a, b := find(i), find(j); a != b {
+					if a < b {
+						parent[b] = a
+					} else {
+						parent[a] = b
+					}
+				}
+				continue
+			}
+			owner[signer] = i
+		}
+	}
+
+	var lanes []*lane
+	byRoot := make(map[int]*lane)
+	for i, ltx := range txs {
+		root := find(i)
+		l := byRoot[root]
+		if l == nil {
+			l = &lane{}
+			byRoot[root] = l
+			lanes = append(lanes, l)
+		}
+		l.txs = append(l.txs, ltx)
+	}
+
+	return lanes, txs
+}
+
+// runLane executes the transactions of a lane in order on a branch of the
+// deliver state whose stores track every access. The writes stay buffered in
+// the tracking stores until they are flushed. Each transaction starts with a
+// gas meter of its own at txGasStart, the gas consumed on the gas meter of the
+// deliver state, and with an event manager of its own. The writes of each
+// transaction to the listened stores of the deliver state are recorded in
+// its changes, and its accesses to the keys of the accumulators in its
+// accumulations.
+func (app *BaseApp) runLane(l *lane, txGasStart uint64) {
+	l.stores = make(map[sdk.StoreKey]*accessTrackingStore, len(app.storeKeys))
+	parents := make(map[sdk.StoreKey]sdk.CacheWrapper, len(app.storeKeys))
+	recorder := &stateChangeRecorder{}
+	var listeners map[sdk.StoreKey][]store.WriteListener
+	for _, key := range app.storeKeys {
+		s := newAccessTrackingStore(app.deliverState.ms.GetKVStore(key))
+		l.stores[key] = s
+		parents[key] = s
+
+		if app.deliverState.ms.ListeningEnabled(key) {
+			if listeners == nil {
+				listeners = make(map[sdk.StoreKey][]store.WriteListener)
+			}
+			listeners[key] = []store.WriteListener{recorder}
+		}
+	}
+
+	// the root db of a branch is never written through the MultiStore
+	// interface, so an empty one is enough
+	ms := cachemulti.NewFromKVStore(dbadapter.Store{DB: memdb.NewDB()}, parents, nil, nil, nil, listeners)
+	txMs := sdk.CacheMultiStore(ms)
+	accRecorder := newAccumulationRecorder(app.accumulators)
+	if len(app.accumulators) > 0 {
+		txMs = accumulatingMultiStore{cacheMultiStore: ms, recorder: accRecorder}
+	}
+
+	var laneGas uint64
+	for _, ltx := range l.txs {
+		if ltx.err != nil {
+			continue
+		}
+
+		meter := newLaneGasMeter(laneGas)
+		txMeter := newSharedGasMeter(txGasStart)
+		ctx := app.getContextForTx(app.deliverState, ltx.txBytes).
+			WithMultiStore(txMs).
+			WithBlockGasMeter(meter).
+			WithGasMeter(txMeter).
+			WithEventManager(sdk.NewEventManager())
+
+		ltx.gInfo, ltx.result, ltx.runErr = app.runTxWithContext(ctx, ltx.txBytes, ltx.tx, false)
+		ltx.gasStart = laneGas
+		ltx.gasUsed = blockGasConsumed(meter) - laneGas
+		ltx.gasObserved = meter.observed
+		ltx.sharedGasUsed = txMeter.used
+		laneGas += ltx.gasUsed
+
+		ms.FlushWriteListeners()
+		ltx.changes, recorder.changes = recorder.changes, nil
+		ltx.accumulations, ltx.accumulationFailed = accRecorder.done(ms)
+	}
+
+	ms.Write()
+}
+
+// stateChange is a write of a transaction to a listened store.
+type stateChange struct {
+	storeKey sdk.StoreKey
+	pendingWrite
+}
+
+// stateChangeRecorder is a WriteListener collecting the writes of the
+// transaction being executed in a lane to the listened stores.
+type stateChangeRecorder struct {
+	changes []stateChange
+}
+
+// OnWrite implements WriteListener.
+func (r *stateChangeRecorder) OnWrite(storeKey sdk.StoreKey, key []byte, value []byte, delete bool) error {
+	r.changes = append(r.changes, stateChange{
+		storeKey:     storeKey,
+		pendingWrite: pendingWrite{key: key, value: value, deleted: delete},
+	})
+	return nil
+}
+
+// usesSharedGasMeter returns true if a transaction consumed gas on the gas
+// meter of the deliver state. In serial execution that gas would be seen by
+// the transactions after it.
+func usesSharedGasMeter(txs []*laneTx) bool {
+	for _, ltx := range txs {
+		if ltx.sharedGasUsed {
+			return true
+		}
+	}
+	return false
+}
+
+// hasLaneConflict returns true if a key written by one lane was read, iterated
+// over or written by another lane. The accumulated keys never conflict.
+func hasLaneConflict(lanes []*lane, sums accumulatedSums) bool {
+	writer := make(map[storeKeyPair]int)
+	for i, l := range lanes {
+		for sk, s := range l.stores {
+			for key := range s.writes {
+				p := storeKeyPair{sk, key}
+				if sums.contains(p) {
+					continue
+				}
+				if _, ok := writer[p]; ok {
+					return true
+				}
+				writer[p] = i
+			}
+		}
+	}
+
+	for p, i := range writer {
+		for j, l := range lanes {
+			if i != j && l.stores[p.store].observes(p.key) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// replayBlockGas checks, in block order, that the block gas meter would have
+// evolved as in serial execution: no transaction runs out of block gas and no
+// transaction read a block gas consumption that differs from the serial one.
+func replayBlockGas(meter sdk.GasMeter, txs []*laneTx) bool {
+	consumed, limit := meter.GasConsumed(), meter.Limit()
+	for _, ltx := range txs {
+		if ltx.err != nil {
+			continue
+		}
+		if limit > 0 && consumed >= limit {
+			return false
+		}
+		if ltx.gasObserved && ltx.gasStart != consumed {
+			return false
+		}
+
+		consumed += ltx.gasUsed
+		if limit > 0 && consumed > limit {
+			return false
+		}
+	}
+
+	return true
+}
+
+// laneGasMeter is the block gas meter of a transaction executed in a lane. It
+// starts at the gas consumed by the preceding transactions of the same lane
+// and remembers whether the transaction read the consumed amount.
+type laneGasMeter struct {
+	sdk.GasMeter
+	observed bool
+}
+
+func newLaneGasMeter(start uint64) *laneGasMeter {
+	meter := sdk.NewInfiniteGasMeter()
+	meter.ConsumeGas(start, "lane gas offset")
+	return &laneGasMeter{GasMeter: meter}
+}
+
+// GasConsumed implements GasMeter.
+func (m *laneGasMeter) GasConsumed() sdk.Gas {
+	m.observed = true
+	return m.GasMeter.GasConsumed()
+}
+
+// GasConsumedToLimit implements GasMeter.
+func (m *laneGasMeter) GasConsumedToLimit() sdk.Gas {
+	m.observed = true
+	return m.GasMeter.GasConsumedToLimit()
+}
+
+// sharedGasMeter stands in for the gas meter of the deliver state, which
+// serial execution shares between transactions, until the AnteHandler sets the
+// gas meter of the transaction. It remembers whether gas was consumed on it.
+type sharedGasMeter struct {
+	sdk.GasMeter
+	used bool
+}
+
+func newSharedGasMeter(start uint64) *sharedGasMeter {
+	meter := sdk.NewInfiniteGasMeter()
+	meter.ConsumeGas(start, "shared gas offset")
+	return &sharedGasMeter{GasMeter: meter}
+}
+
+// ConsumeGas implements GasMeter.
+func (m *sharedGasMeter) ConsumeGas(amount sdk.Gas, descriptor string) {
+	if amount > 0 {
+		m.used = true
+	}
+	m.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+// blockGasConsumed returns the gas consumed by a block gas meter without
+// marking a laneGasMeter as observed.
+func blockGasConsumed(meter sdk.GasMeter) uint64 {
+	if m, ok := meter.(*laneGasMeter); ok {
+		return m.GasMeter.GasConsumed()
+	}
+	return meter.GasConsumed()
+}
+
+// deliverTxResponse records the tx telemetry and converts the outcome of
+// runTx into a ResponseDeliverTx.
+func (app *BaseApp) deliverTxResponse(gInfo sdk.GasInfo, result *sdk.Result, err error) abci.ResponseDeliverTx {
+	resultStr := "successful"
+	if err != nil {
+		resultStr = "failed"
+	}
+
+	telemetry.IncrCounter(1, "tx", "count")
+	telemetry.IncrCounter(1, "tx", resultStr)
+	telemetry.SetGauge(float32(gInfo.GasUsed), "tx", "gas", "used")
+	telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
+
+	if err != nil {
+		return sdkerrors.ResponseDeliverTx(err, gInfo.GasWanted, gInfo.GasUsed, app.trace)
+	}
+
+	return abci.ResponseDeliverTx{
+		GasWanted: int64(gInfo.GasWanted), // TODO: Should type accept unsigned ints?
+		GasUsed:   int64(gInfo.GasUsed),   // TODO: Should type accept unsigned ints?
+		Log:       result.Log,
+		Data:      result.Data,
+		Events:    sdk.MarkEventsToIndex(result.Events, app.indexEvents),
+	}
+}
@@ -0,0 +1,360 @@
+package baseapp
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"testing"
+
+	abci "github.com/line/ostracon/abci/types"
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/codec"
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+)
+
+func setupKeyValueApp(t *testing.T, options ...func(*BaseApp)) *BaseApp {
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgKeyValue, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			kv := msg.(*msgKeyValue)
+			if bytes.HasPrefix(kv.Key, limitedPrefix) {
+				// the usages are bookkeeping, accessed without gas
+				store := ctx.MultiStore().GetKVStore(capKey2)
+				used := limitedAccumulator{}.Add(store.Get(kv.Key), []byte(strconv.Itoa(len(kv.Value))))
+				if !(limitedAccumulator{}).WithinLimit(ctx, kv.Key, used) {
+					return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s exceeds its limit", kv.Key)
+				}
+				store.Set(kv.Key, used)
+				return &sdk.Result{}, nil
+			}
+
+			store := ctx.KVStore(capKey2)
+			// read the previous value so that overlapping txs conflict on reads too
+			prev := store.Get(kv.Key)
+			if bytes.HasPrefix(kv.Key, sumPrefix) {
+				store.Set(kv.Key, decimalAccumulator{}.Add(prev, []byte(strconv.Itoa(len(kv.Value)))))
+			} else {
+				store.Set(kv.Key, append(prev, kv.Value...))
+			}
+			return &sdk.Result{}, nil
+		}))
+	}
+
+	// every tx gets its own gas meter, as SetUpContextDecorator does, unless
+	// the options set another AnteHandler
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), nil
+		})
+	}
+
+	app := setupBaseApp(t, append([]func(*BaseApp){anteOpt, routerOpt}, options...)...)
+	app.InitChain(abci.RequestInitChain{})
+	return app
+}
+
+// sumPrefix is the prefix of the keys to which the txs of setupKeyValueApp add
+// the length of their value.
+var sumPrefix = []byte("sum/")
+
+// decimalAccumulator adds up decimal numbers.
+type decimalAccumulator struct{}
+
+func (decimalAccumulator) Sub(value, base []byte) ([]byte, bool) {
+	v, _ := strconv.Atoi(string(value))
+	b, _ := strconv.Atoi(string(base))
+	if v < b {
+		return nil, false
+	}
+	return []byte(strconv.Itoa(v - b)), true
+}
+
+func (decimalAccumulator) Add(value, amount []byte) []byte {
+	v, _ := strconv.Atoi(string(value))
+	a, _ := strconv.Atoi(string(amount))
+	return []byte(strconv.Itoa(v + a))
+}
+
+// limitedPrefix is the prefix of the keys to which the txs of
+// setupKeyValueApp add the length of their value, up to usageLimit.
+var limitedPrefix = []byte("limited/")
+
+const usageLimit = 5
+
+// limitedAccumulator adds up decimal numbers up to usageLimit.
+type limitedAccumulator struct {
+	decimalAccumulator
+}
+
+func (limitedAccumulator) WithinLimit(_ sdk.Context, _, value []byte) bool {
+	v, _ := strconv.Atoi(string(value))
+	return v <= usageLimit
+}
+
+func keyValueTxs(t *testing.T, keys ...string) []abci.RequestDeliverTx {
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	reqs := make([]abci.RequestDeliverTx, 0, len(keys))
+	for i, key := range keys {
+		tx := txTest{Msgs: []sdk.Msg{msgKeyValue{Key: []byte(key), Value: []byte(fmt.Sprintf("v%d", i))}}}
+		txBytes, err := cdc.MarshalBinaryBare(tx)
+		require.NoError(t, err)
+		reqs = append(reqs, abci.RequestDeliverTx{Tx: txBytes})
+	}
+	return reqs
+}
+
+func runBatch(app *BaseApp, height int64, reqs []abci.RequestDeliverTx) ([]abci.ResponseDeliverTx, []byte) {
+	app.BeginBlock(abci.RequestBeginBlock{Header: ostproto.Header{Height: height}})
+	res := app.DeliverTxBatch(reqs)
+	app.EndBlock(abci.RequestEndBlock{Height: height})
+	return res, app.Commit().Data
+}
+
+func TestDeliverTxBatchMatchesSerial(t *testing.T) {
+	testCases := []struct {
+		name string
+		keys []string
+	}{
+		{"independent", []string{"a", "b", "c", "d", "e", "f"}},
+		{"conflicting", []string{"a", "b", "a", "c", "b", "a"}},
+		{"single", []string{"a"}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			serial := setupKeyValueApp(t)
+			parallel := setupKeyValueApp(t, SetParallelDeliverTx(4))
+
+			reqs := append(keyValueTxs(t, tc.keys...), abci.RequestDeliverTx{Tx: []byte("invalid")})
+
+			serialRes, serialHash := runBatch(serial, 1, reqs)
+			parallelRes, parallelHash := runBatch(parallel, 1, reqs)
+
+			require.Equal(t, serialRes, parallelRes)
+			require.Equal(t, serialHash, parallelHash)
+			require.False(t, serialRes[len(serialRes)-1].IsOK())
+		})
+	}
+}
+
+func TestDeliverTxsParallel(t *testing.T) {
+	app := setupKeyValueApp(t, SetParallelDeliverTx(2))
+	app.BeginBlock(abci.RequestBeginBlock{Header: ostproto.Header{Height: 1}})
+
+	// both txs read and write "a"
+	_, ok := app.deliverTxsParallel(keyValueTxs(t, "a", "b", "a"))
+	require.False(t, ok)
+
+	res, ok := app.deliverTxsParallel(keyValueTxs(t, "a", "b", "c"))
+	require.True(t, ok)
+	for _, r := range res {
+		require.True(t, r.IsOK(), r.String())
+	}
+
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+
+	store := app.cms.GetCommitKVStore(capKey2)
+	require.Equal(t, []byte("v0"), store.Get([]byte("a")))
+	require.Equal(t, []byte("v1"), store.Get([]byte("b")))
+	require.Equal(t, []byte("v2"), store.Get([]byte("c")))
+}
+
+func TestDeliverTxsParallelSharedGasMeter(t *testing.T) {
+	// the AnteHandler does not set a gas meter, so the txs consume gas on the
+	// gas meter of the deliver state
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return ctx, nil
+		})
+	}
+	serial := setupKeyValueApp(t, anteOpt)
+	parallel := setupKeyValueApp(t, anteOpt, SetParallelDeliverTx(2))
+
+	reqs := keyValueTxs(t, "a", "b", "c")
+
+	parallel.BeginBlock(abci.RequestBeginBlock{Header: ostproto.Header{Height: 1}})
+	_, ok := parallel.deliverTxsParallel(reqs)
+	require.False(t, ok)
+	parallelRes := parallel.DeliverTxBatch(reqs)
+	parallel.EndBlock(abci.RequestEndBlock{Height: 1})
+	parallelHash := parallel.Commit().Data
+
+	serialRes, serialHash := runBatch(serial, 1, reqs)
+	require.Equal(t, serialRes, parallelRes)
+	require.Equal(t, serialHash, parallelHash)
+}
+
+func TestDeliverTxsParallelAccumulator(t *testing.T) {
+	accOpt := func(bapp *BaseApp) {
+		bapp.SetAccumulator(capKey2, sumPrefix, decimalAccumulator{})
+	}
+	serial := setupKeyValueApp(t, accOpt)
+	parallel := setupKeyValueApp(t, accOpt, SetParallelDeliverTx(4))
+
+	// the txs run on infinite gas meters, so the gas of a tx cannot be
+	// corrected and the sum must keep its length
+	runBatch(serial, 1, keyValueTxs(t, "sum/a"))
+	runBatch(parallel, 1, keyValueTxs(t, "sum/a"))
+
+	reqs := keyValueTxs(t, "sum/a", "b", "sum/a", "c", "sum/a")
+	parallel.BeginBlock(abci.RequestBeginBlock{Header: ostproto.Header{Height: 2}})
+	parallelRes, ok := parallel.deliverTxsParallel(reqs)
+	require.True(t, ok)
+	parallel.EndBlock(abci.RequestEndBlock{Height: 2})
+	parallelHash := parallel.Commit().Data
+
+	serialRes, serialHash := runBatch(serial, 2, reqs)
+	require.Equal(t, serialRes, parallelRes)
+	require.Equal(t, serialHash, parallelHash)
+	require.Equal(t, []byte("8"), parallel.cms.GetCommitKVStore(capKey2).Get([]byte("sum/a")))
+}
+
+func TestDeliverTxsParallelLimitedAccumulator(t *testing.T) {
+	accOpt := func(bapp *BaseApp) {
+		bapp.SetAccumulator(capKey2, limitedPrefix, limitedAccumulator{})
+	}
+
+	testCases := []struct {
+		name     string
+		keys     []string
+		parallel bool
+	}{
+		// the usages of the lanes add up within the limit
+		{"within limit", []string{"limited/a", "b", "limited/a", "c"}, true},
+		// the third tx fails on the limit in serial execution, not in its lane
+		{"limit exceeded", []string{"limited/a", "limited/a", "b", "limited/a"}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			serial := setupKeyValueApp(t, accOpt)
+			parallel := setupKeyValueApp(t, accOpt, SetParallelDeliverTx(4))
+			reqs := keyValueTxs(t, tc.keys...)
+
+			parallel.BeginBlock(abci.RequestBeginBlock{Header: ostproto.Header{Height: 1}})
+			parallelRes, ok := parallel.deliverTxsParallel(reqs)
+			require.Equal(t, tc.parallel, ok)
+			if !ok {
+				parallelRes = parallel.DeliverTxBatch(reqs)
+			}
+			parallel.EndBlock(abci.RequestEndBlock{Height: 1})
+			parallelHash := parallel.Commit().Data
+
+			serialRes, serialHash := runBatch(serial, 1, reqs)
+			require.Equal(t, serialRes, parallelRes)
+			require.Equal(t, serialHash, parallelHash)
+			require.Equal(t, tc.parallel, serialRes[len(serialRes)-1].IsOK())
+		})
+	}
+}
+
+func TestAccumulationRecorder(t *testing.T) {
+	acc := &accumulator{Accumulator: decimalAccumulator{}, storeKey: capKey2, prefix: sumPrefix}
+	key := []byte("sum/a")
+
+	testCases := []struct {
+		name   string
+		record func(r *accumulationRecorder)
+		failed bool
+	}{
+		{"add", func(r *accumulationRecorder) {
+			r.read(acc, key, []byte("1"))
+			r.write(acc, key, []byte("3"))
+		}, false},
+		{"read twice", func(r *accumulationRecorder) {
+			r.read(acc, key, []byte("1"))
+			r.read(acc, key, []byte("1"))
+			r.write(acc, key, []byte("3"))
+		}, true},
+		{"read only", func(r *accumulationRecorder) {
+			r.read(acc, key, []byte("1"))
+		}, true},
+		{"blind write", func(r *accumulationRecorder) {
+			r.write(acc, key, []byte("3"))
+		}, true},
+		{"subtract", func(r *accumulationRecorder) {
+			r.read(acc, key, []byte("3"))
+			r.write(acc, key, []byte("1"))
+		}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app := setupKeyValueApp(t)
+			app.BeginBlock(abci.RequestBeginBlock{Header: ostproto.Header{Height: 1}})
+
+			r := newAccumulationRecorder([]*accumulator{acc})
+			tc.record(r)
+			accs, failed := r.done(app.deliverState.ms)
+			require.Equal(t, tc.failed, failed)
+			require.Len(t, accs, 1)
+		})
+	}
+
+	r := newAccumulationRecorder([]*accumulator{acc})
+	require.True(t, r.overlaps(capKey2, nil, nil))
+	require.True(t, r.overlaps(capKey2, []byte("sum"), []byte("sum0")))
+	require.False(t, r.overlaps(capKey2, []byte("a"), []byte("sum/")))
+	require.False(t, r.overlaps(capKey1, nil, nil))
+}
+
+func TestScheduleLanes(t *testing.T) {
+	privs := newTestPrivKeys(4)
+	txs := []sdk.Tx{
+		newTestTx(privs[0:1]),
+		newTestTx(privs[1:2]),
+		newTestTx(privs[0:1]),
+		newTestTx(privs[2:4]),
+		newTestTx(privs[1:3]),
+	}
+
+	app := setupBaseApp(t)
+	app.txDecoder = func(txBytes []byte) (sdk.Tx, error) {
+		return txs[txBytes[0]], nil
+	}
+
+	reqs := make([]abci.RequestDeliverTx, len(txs))
+	for i := range txs {
+		reqs[i] = abci.RequestDeliverTx{Tx: []byte{byte(i)}}
+	}
+
+	// tx 4 links the lanes of signers 1 and 2, so everything but tx 0 and 2 is
+	// in the second lane
+	lanes, scheduled := app.scheduleLanes(reqs)
+	require.Len(t, scheduled, len(txs))
+	require.Len(t, lanes, 2)
+	require.Equal(t, []int{0, 2}, laneIndexes(lanes[0]))
+	require.Equal(t, []int{1, 3, 4}, laneIndexes(lanes[1]))
+}
+
+func laneIndexes(l *lane) []int {
+	indexes := make([]int, len(l.txs))
+	for i, ltx := range l.txs {
+		indexes[i] = ltx.index
+	}
+	return indexes
+}
+
+func TestReplayBlockGas(t *testing.T) {
+	txs := []*laneTx{
+		{gasStart: 0, gasUsed: 10},
+		{gasStart: 0, gasUsed: 20},
+		{gasStart: 10, gasUsed: 5, gasObserved: true},
+	}
+
+	// the third tx observed 10 but runs after 30 gas was consumed
+	require.False(t, replayBlockGas(sdk.NewInfiniteGasMeter(), txs))
+
+	txs[2].gasObserved = false
+	require.True(t, replayBlockGas(sdk.NewInfiniteGasMeter(), txs))
+	require.True(t, replayBlockGas(sdk.NewGasMeter(35), txs))
+	require.False(t, replayBlockGas(sdk.NewGasMeter(34), txs))
+}
@@ -0,0 +1,108 @@
+package baseapp
+
+import (
+	abci "github.com/line/ostracon/abci/types"
+
+	store "github.com/line/lfb-sdk/store/types"
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+// ABCIListener interface used to hook into the ABCI message processing of the BaseApp
+type ABCIListener interface {
+	// ListenBeginBlock updates the streaming service with the latest BeginBlock messages
+	ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error
+	// ListenDeliverTx updates the streaming service with the latest DeliverTx messages
+	ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error
+	// ListenEndBlock updates the streaming service with the latest EndBlock messages
+	ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error
+	// ListenCommit updates the streaming service with the latest Commit message.
+	// The state changes of BeginBlock, of each DeliverTx and of EndBlock are
+	// passed to the WriteListeners of the service, in the order they were
+	// made, right before the corresponding hook is called.
+	ListenCommit(ctx sdk.Context, res abci.ResponseCommit) error
+}
+
+// StreamingService interface for registering WriteListeners with the BaseApp
+// and updating the service with the ABCI messages using the hooks
+type StreamingService interface {
+	// Listeners returns the streaming service's listeners for the BaseApp to register
+	Listeners() map[store.StoreKey][]store.WriteListener
+	// ABCIListener interface for hooking into the ABCI messages from inside the BaseApp
+	ABCIListener
+}
+
+// SetStreamingService is used to set a streaming service into the BaseApp
+// hooks and load the listeners into the multistore.
+func (app *BaseApp) SetStreamingService(s StreamingService) {
+	if app.sealed {
+		panic("SetStreamingService() on sealed BaseApp")
+	}
+
+	// add the listeners for each StoreKey, logging their errors like the
+	// errors of the ABCI hooks
+	for key, lis := range s.Listeners() {
+		logged := make([]store.WriteListener, len(lis))
+		for i, l := range lis {
+			logged[i] = loggingWriteListener{WriteListener: l, app: app}
+		}
+		app.cms.AddListeners(key, logged)
+	}
+	// register the StreamingService within the BaseApp
+	// BaseApp will pass BeginBlock, DeliverTx, EndBlock and Commit requests
+	// and responses to the streaming services to update their ABCI context
+	app.abciListeners = append(app.abciListeners, s)
+}
+
+// loggingWriteListener logs the errors of a WriteListener instead of
+// returning them to the store, which would have to abort the commit.
+type loggingWriteListener struct {
+	store.WriteListener
+	app *BaseApp
+}
+
+// OnWrite implements store.WriteListener.
+func (l loggingWriteListener) OnWrite(storeKey store.StoreKey, key []byte, value []byte, delete bool) error {
+	if err := l.WriteListener.OnWrite(storeKey, key, value, delete); err != nil {
+		l.app.logger.Error("state listening hook failed", "store", storeKey.Name(), "err", err)
+	}
+	return nil
+}
+
+// listenBeginBlock passes the state changes of BeginBlock, and of InitChain
+// before the first block, to the WriteListeners and then calls the
+// ListenBeginBlock hooks. The state changes of DeliverTx and EndBlock are
+// passed the same way, right before their hooks.
+func (app *BaseApp) listenBeginBlock(req abci.RequestBeginBlock, res abci.ResponseBeginBlock) {
+	app.deliverState.ms.FlushWriteListeners()
+	for _, l := range app.abciListeners {
+		if err := l.ListenBeginBlock(app.deliverState.ctx, req, res); err != nil {
+			app.logger.Error("BeginBlock listening hook failed", "height", req.Header.Height, "err", err)
+		}
+	}
+}
+
+func (app *BaseApp) listenDeliverTx(req abci.RequestDeliverTx, res abci.ResponseDeliverTx) {
+	app.deliverState.ms.FlushWriteListeners()
+	for _, l := range app.abciListeners {
+		if err := l.ListenDeliverTx(app.deliverState.ctx, req, res); err != nil {
+			app.logger.Error("DeliverTx listening hook failed", "err", err)
+		}
+	}
+}
+
+func (app *BaseApp) listenEndBlock(req abci.RequestEndBlock, res abci.ResponseEndBlock) {
+	app.deliverState.ms.FlushWriteListeners()
+	for _, l := range app.abciListeners {
+		if err := l.ListenEndBlock(app.deliverState.ctx, req, res); err != nil {
+			app.logger.Error("EndBlock listening hook failed", "height", req.Height, "err", err)
+		}
+	}
+}
+
+func (app *BaseApp) listenCommit(ctx sdk.Context, res abci.ResponseCommit) {
+	for _, l := range app.abciListeners {
+		if err := l.ListenCommit(ctx, res); err != nil {
+			app.logger.Error("Commit listening hook failed", "height", ctx.BlockHeight(), "err", err)
+		}
+	}
+}
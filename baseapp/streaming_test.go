@@ -0,0 +1,159 @@
+package baseapp
+
+import (
+	"errors"
+	"testing"
+
+	abci "github.com/line/ostracon/abci/types"
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "github.com/line/lfb-sdk/store/types"
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+type mockWriteListener struct {
+	pairs []storetypes.StoreKVPair
+	err   error
+}
+
+func (l *mockWriteListener) OnWrite(storeKey storetypes.StoreKey, key []byte, value []byte, delete bool) error {
+	l.pairs = append(l.pairs, storetypes.StoreKVPair{StoreKey: storeKey.Name(), Key: key, Value: value, Delete: delete})
+	return l.err
+}
+
+// mockStreamingService collects the state changes passed to its listener
+// before each ABCI hook.
+type mockStreamingService struct {
+	listener *mockWriteListener
+
+	beginBlockChanges []storetypes.StoreKVPair
+	deliverTxs        []abci.ResponseDeliverTx
+	deliverTxChanges  [][]storetypes.StoreKVPair
+	endBlockChanges   []storetypes.StoreKVPair
+	commits           []abci.ResponseCommit
+}
+
+var _ StreamingService = (*mockStreamingService)(nil)
+
+func (s *mockStreamingService) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return map[storetypes.StoreKey][]storetypes.WriteListener{capKey2: {s.listener}}
+}
+
+// popChanges returns the state changes passed to the listener since the last call.
+func (s *mockStreamingService) popChanges() []storetypes.StoreKVPair {
+	pairs := s.listener.pairs
+	s.listener.pairs = nil
+	return pairs
+}
+
+func (s *mockStreamingService) ListenBeginBlock(sdk.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	s.beginBlockChanges = s.popChanges()
+	return nil
+}
+
+func (s *mockStreamingService) ListenDeliverTx(_ sdk.Context, _ abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	s.deliverTxs = append(s.deliverTxs, res)
+	s.deliverTxChanges = append(s.deliverTxChanges, s.popChanges())
+	return nil
+}
+
+func (s *mockStreamingService) ListenEndBlock(sdk.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	s.endBlockChanges = s.popChanges()
+	return nil
+}
+
+func (s *mockStreamingService) ListenCommit(_ sdk.Context, res abci.ResponseCommit) error {
+	s.commits = append(s.commits, res)
+	return nil
+}
+
+func TestStreamingService(t *testing.T) {
+	testCases := []struct {
+		name    string
+		workers int
+	}{
+		{"serial", 0},
+		{"parallel", 2},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &mockStreamingService{listener: &mockWriteListener{}}
+			app := setupKeyValueApp(t, SetParallelDeliverTx(tc.workers), func(app *BaseApp) {
+				app.SetStreamingService(svc)
+			})
+			require.True(t, app.cms.ListeningEnabled(capKey2))
+			require.False(t, app.cms.ListeningEnabled(capKey1))
+
+			app.BeginBlock(abci.RequestBeginBlock{Header: ostproto.Header{Height: 1}})
+			reqs := append(keyValueTxs(t, "c", "a", "b"), abci.RequestDeliverTx{Tx: []byte("invalid")})
+			res := app.DeliverTxBatch(reqs)
+			app.EndBlock(abci.RequestEndBlock{Height: 1})
+			commit := app.Commit()
+
+			require.Equal(t, res, svc.deliverTxs)
+			require.Equal(t, []abci.ResponseCommit{commit}, svc.commits)
+
+			// the state changes of each tx are passed right before its hook
+			require.Empty(t, svc.beginBlockChanges)
+			require.Equal(t, [][]storetypes.StoreKVPair{
+				{{StoreKey: capKey2.Name(), Key: []byte("c"), Value: []byte("v0")}},
+				{{StoreKey: capKey2.Name(), Key: []byte("a"), Value: []byte("v1")}},
+				{{StoreKey: capKey2.Name(), Key: []byte("b"), Value: []byte("v2")}},
+				nil,
+			}, svc.deliverTxChanges)
+			require.Empty(t, svc.endBlockChanges)
+			require.Empty(t, svc.listener.pairs)
+		})
+	}
+}
+
+func TestStreamingServiceWriteOrder(t *testing.T) {
+	svc := &mockStreamingService{listener: &mockWriteListener{}}
+	app := setupKeyValueApp(t, func(app *BaseApp) {
+		app.SetStreamingService(svc)
+		app.SetBeginBlocker(func(ctx sdk.Context, _ abci.RequestBeginBlock) abci.ResponseBeginBlock {
+			ctx.KVStore(capKey2).Set([]byte("z"), []byte("begin"))
+			ctx.KVStore(capKey2).Delete([]byte("y"))
+			return abci.ResponseBeginBlock{}
+		})
+		app.SetEndBlocker(func(ctx sdk.Context, _ abci.RequestEndBlock) abci.ResponseEndBlock {
+			ctx.KVStore(capKey2).Set([]byte("x"), []byte("end"))
+			return abci.ResponseEndBlock{}
+		})
+	})
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: ostproto.Header{Height: 1}})
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+
+	// the writes are passed in the order they were made, not sorted by key
+	require.Equal(t, []storetypes.StoreKVPair{
+		{StoreKey: capKey2.Name(), Key: []byte("z"), Value: []byte("begin")},
+		{StoreKey: capKey2.Name(), Key: []byte("y"), Delete: true},
+	}, svc.beginBlockChanges)
+	require.Equal(t, []storetypes.StoreKVPair{
+		{StoreKey: capKey2.Name(), Key: []byte("x"), Value: []byte("end")},
+	}, svc.endBlockChanges)
+}
+
+func TestStreamingServiceListenerError(t *testing.T) {
+	svc := &mockStreamingService{listener: &mockWriteListener{err: errors.New("disk full")}}
+	app := setupKeyValueApp(t, func(app *BaseApp) {
+		app.SetStreamingService(svc)
+	})
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: ostproto.Header{Height: 1}})
+	app.DeliverTxBatch(keyValueTxs(t, "a", "b"))
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+
+	// the failing listener does not abort the commit
+	require.NotPanics(t, func() { app.Commit() })
+	require.Len(t, svc.deliverTxChanges, 2)
+	require.Len(t, svc.deliverTxChanges[0], 1)
+	require.Len(t, svc.deliverTxChanges[1], 1)
+	require.Equal(t, []byte("v0"), app.cms.GetCommitKVStore(capKey2).Get([]byte("a")))
+	require.Equal(t, []byte("v1"), app.cms.GetCommitKVStore(capKey2).Get([]byte("b")))
+}
@@ -15,7 +15,8 @@ func (app *BaseApp) Check(txEncoder sdk.TxEncoder, tx sdk.Tx) (sdk.GasInfo, erro
 	if err != nil {
 		return sdk.GasInfo{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s", err)
 	}
-	return app.checkTx(txBytes, tx, false)
+	gInfo, _, err := app.checkTx(txBytes, tx, false)
+	return gInfo, err
 }
 
 func (app *BaseApp) Simulate(txBytes []byte) (sdk.GasInfo, *sdk.Result, error) {
@@ -116,7 +116,29 @@ func ReadPersistentCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Cont
 		clientCtx = clientCtx.WithChainID(chainID)
 	}
 
-	if clientCtx.Keyring == nil || flagSet.Changed(flags.FlagKeyringBackend) {
+	remoteSignerChanged := false
+	for _, flag := range []string{
+		flags.FlagRemoteSignerAddr, flags.FlagRemoteSignerTLSCA, flags.FlagRemoteSignerTLSCert,
+		flags.FlagRemoteSignerTLSKey, flags.FlagRemoteSignerInsecure,
+	} {
+		remoteSignerChanged = remoteSignerChanged || flagSet.Changed(flag)
+	}
+	if remoteSignerChanged {
+		remoteSignerAddr, _ := flagSet.GetString(flags.FlagRemoteSignerAddr)
+		tlsCA, _ := flagSet.GetString(flags.FlagRemoteSignerTLSCA)
+		tlsCert, _ := flagSet.GetString(flags.FlagRemoteSignerTLSCert)
+		tlsKey, _ := flagSet.GetString(flags.FlagRemoteSignerTLSKey)
+		insecure, _ := flagSet.GetBool(flags.FlagRemoteSignerInsecure)
+		clientCtx = clientCtx.WithKeyringOptions(append(clientCtx.KeyringOptions, func(options *keyring.Options) {
+			options.RemoteSignerAddr = remoteSignerAddr
+			options.RemoteSignerTLSCA = tlsCA
+			options.RemoteSignerTLSCert = tlsCert
+			options.RemoteSignerTLSKey = tlsKey
+			options.RemoteSignerInsecure = insecure
+		})...)
+	}
+
+	if clientCtx.Keyring == nil || flagSet.Changed(flags.FlagKeyringBackend) || remoteSignerChanged {
 		keyringBackend, _ := flagSet.GetString(flags.FlagKeyringBackend)
 
 		if keyringBackend != "" {
@@ -221,6 +243,19 @@ func readTxCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, err
 		clientCtx = clientCtx.WithSignModeStr(signModeStr)
 	}
 
+	if clientCtx.FeeGranter == nil || flagSet.Changed(flags.FlagFeeAccount) {
+		granter, _ := flagSet.GetString(flags.FlagFeeAccount)
+
+		if granter != "" {
+			granterAcc, err := sdk.AccAddressFromBech32(granter)
+			if err != nil {
+				return clientCtx, err
+			}
+
+			clientCtx = clientCtx.WithFeeGranterAddress(granterAcc)
+		}
+	}
+
 	if clientCtx.From == "" || flagSet.Changed(flags.FlagFrom) {
 		from, _ := flagSet.GetString(flags.FlagFrom)
 		fromAddr, fromName, keyType, err := GetFromFields(clientCtx.Keyring, from, clientCtx.GenerateOnly)
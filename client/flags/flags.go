@@ -6,6 +6,7 @@ import (
 
 	ostcli "github.com/line/ostracon/libs/cli"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	"github.com/line/lfb-sdk/crypto/keyring"
 )
@@ -39,37 +40,43 @@ const (
 
 // List of CLI flags
 const (
-	FlagHome             = ostcli.HomeFlag
-	FlagKeyringDir       = "keyring-dir"
-	FlagUseLedger        = "ledger"
-	FlagChainID          = "chain-id"
-	FlagNode             = "node"
-	FlagHeight           = "height"
-	FlagGasAdjustment    = "gas-adjustment"
-	FlagFrom             = "from"
-	FlagName             = "name"
-	FlagSigBlockHeight   = "sig-block-height"
-	FlagSequence         = "sequence"
-	FlagMemo             = "memo"
-	FlagFees             = "fees"
-	FlagGas              = "gas"
-	FlagGasPrices        = "gas-prices"
-	FlagBroadcastMode    = "broadcast-mode"
-	FlagDryRun           = "dry-run"
-	FlagGenerateOnly     = "generate-only"
-	FlagOffline          = "offline"
-	FlagOutputDocument   = "output-document" // inspired by wget -O
-	FlagSkipConfirmation = "yes"
-	FlagProve            = "prove"
-	FlagKeyringBackend   = "keyring-backend"
-	FlagPage             = "page"
-	FlagLimit            = "limit"
-	FlagSignMode         = "sign-mode"
-	FlagPageKey          = "page-key"
-	FlagOffset           = "offset"
-	FlagCountTotal       = "count-total"
-	FlagTimeoutHeight    = "timeout-height"
-	FlagKeyAlgorithm     = "algo"
+	FlagHome                 = ostcli.HomeFlag
+	FlagKeyringDir           = "keyring-dir"
+	FlagUseLedger            = "ledger"
+	FlagChainID              = "chain-id"
+	FlagNode                 = "node"
+	FlagHeight               = "height"
+	FlagGasAdjustment        = "gas-adjustment"
+	FlagFrom                 = "from"
+	FlagName                 = "name"
+	FlagSigBlockHeight       = "sig-block-height"
+	FlagSequence             = "sequence"
+	FlagMemo                 = "memo"
+	FlagFees                 = "fees"
+	FlagGas                  = "gas"
+	FlagGasPrices            = "gas-prices"
+	FlagBroadcastMode        = "broadcast-mode"
+	FlagDryRun               = "dry-run"
+	FlagGenerateOnly         = "generate-only"
+	FlagOffline              = "offline"
+	FlagOutputDocument       = "output-document" // inspired by wget -O
+	FlagSkipConfirmation     = "yes"
+	FlagProve                = "prove"
+	FlagKeyringBackend       = "keyring-backend"
+	FlagRemoteSignerAddr     = "remote-signer-addr"
+	FlagRemoteSignerTLSCA    = "remote-signer-tls-ca"
+	FlagRemoteSignerTLSCert  = "remote-signer-tls-cert"
+	FlagRemoteSignerTLSKey   = "remote-signer-tls-key"
+	FlagRemoteSignerInsecure = "remote-signer-insecure"
+	FlagPage                 = "page"
+	FlagLimit                = "limit"
+	FlagSignMode             = "sign-mode"
+	FlagPageKey              = "page-key"
+	FlagOffset               = "offset"
+	FlagCountTotal           = "count-total"
+	FlagTimeoutHeight        = "timeout-height"
+	FlagKeyAlgorithm         = "algo"
+	FlagFeeAccount           = "fee-account"
 
 	// Tendermint logging flags
 	FlagLogLevel  = "log_level"
@@ -109,9 +116,11 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 	cmd.Flags().Bool(FlagGenerateOnly, false, "Build an unsigned transaction and write it to STDOUT (when enabled, the local Keybase is not accessible)")
 	cmd.Flags().Bool(FlagOffline, false, "Offline mode (does not allow any online functionality")
 	cmd.Flags().BoolP(FlagSkipConfirmation, "y", false, "Skip tx broadcasting prompt confirmation")
-	cmd.Flags().String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	cmd.Flags().String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|remote)")
+	AddRemoteSignerFlags(cmd.Flags())
 	cmd.Flags().String(FlagSignMode, "", "Choose sign mode (direct|amino-json), this is an advanced feature")
 	cmd.Flags().Uint64(FlagTimeoutHeight, 0, "Set a block timeout height to prevent the tx from being committed past a certain height")
+	cmd.Flags().String(FlagFeeAccount, "", "Fee account pays fees for the transaction instead of deducting from the signer")
 
 	// --gas can accept integers and "auto"
 	cmd.Flags().String(FlagGas, "", fmt.Sprintf("gas limit to set per-transaction; set to %q to calculate sufficient gas automatically (default %d)", GasFlagAuto, DefaultGasLimit))
@@ -122,6 +131,16 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 	cmd.SetOut(cmd.OutOrStdout())
 }
 
+// AddRemoteSignerFlags adds the flags of the connection to the gRPC signer used by
+// the remote keyring backend.
+func AddRemoteSignerFlags(flagSet *pflag.FlagSet) {
+	flagSet.String(FlagRemoteSignerAddr, "", "<host>:<port> of the gRPC signer used by the remote keyring backend")
+	flagSet.String(FlagRemoteSignerTLSCA, "", "PEM file of the CA certificate verifying the remote signer, the system CAs are used if omitted")
+	flagSet.String(FlagRemoteSignerTLSCert, "", "PEM file of the client certificate presented to the remote signer")
+	flagSet.String(FlagRemoteSignerTLSKey, "", "PEM file of the key of the client certificate presented to the remote signer")
+	flagSet.Bool(FlagRemoteSignerInsecure, false, "Connect to the remote signer without TLS")
+}
+
 // AddPaginationFlagsToCmd adds common pagination flags to cmd
 func AddPaginationFlagsToCmd(cmd *cobra.Command, query string) {
 	cmd.Flags().Uint64(FlagPage, 1, fmt.Sprintf("pagination page of %s to query for. This sets offset to a multiple of limit", query))
@@ -17,3 +17,13 @@ func getBlock(clientCtx client.Context, height *int64) (*ctypes.ResultBlock, err
 
 	return node.Block(context.Background(), height)
 }
+
+func getBlockResults(ctx context.Context, clientCtx client.Context, height *int64) (*ctypes.ResultBlockResults, error) {
+	// get the node
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	return node.BlockResults(ctx, height)
+}
@@ -11,6 +11,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/line/lfb-sdk/codec/types"
 	query "github.com/line/lfb-sdk/types/query"
+	types2 "github.com/line/ostracon/abci/types"
 	p2p "github.com/line/ostracon/proto/ostracon/p2p"
 	types1 "github.com/line/ostracon/proto/ostracon/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -843,6 +844,155 @@ func (m *Module) GetSum() string {
 	return ""
 }
 
+// GetBlockResultsRequest is the request type for the Query/GetBlockResults RPC method.
+type GetBlockResultsRequest struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// pagination defines a pagination for the tx results of the block.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *GetBlockResultsRequest) Reset()         { *m = GetBlockResultsRequest{} }
+func (m *GetBlockResultsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockResultsRequest) ProtoMessage()    {}
+func (*GetBlockResultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52842f5d9c655164, []int{15}
+}
+func (m *GetBlockResultsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBlockResultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBlockResultsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBlockResultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockResultsRequest.Merge(m, src)
+}
+func (m *GetBlockResultsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBlockResultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockResultsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockResultsRequest proto.InternalMessageInfo
+
+func (m *GetBlockResultsRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetBlockResultsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// GetBlockResultsResponse is the response type for the Query/GetBlockResults RPC method.
+type GetBlockResultsResponse struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// txs_results is the list of tx results of the requested page.
+	TxsResults            []*types2.ResponseDeliverTx `protobuf:"bytes,2,rep,name=txs_results,json=txsResults,proto3" json:"txs_results,omitempty"`
+	BeginBlockEvents      []types2.Event              `protobuf:"bytes,3,rep,name=begin_block_events,json=beginBlockEvents,proto3" json:"begin_block_events"`
+	EndBlockEvents        []types2.Event              `protobuf:"bytes,4,rep,name=end_block_events,json=endBlockEvents,proto3" json:"end_block_events"`
+	ValidatorUpdates      []types2.ValidatorUpdate    `protobuf:"bytes,5,rep,name=validator_updates,json=validatorUpdates,proto3" json:"validator_updates"`
+	ConsensusParamUpdates *types2.ConsensusParams     `protobuf:"bytes,6,opt,name=consensus_param_updates,json=consensusParamUpdates,proto3" json:"consensus_param_updates,omitempty"`
+	// pagination defines a pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,7,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *GetBlockResultsResponse) Reset()         { *m = GetBlockResultsResponse{} }
+func (m *GetBlockResultsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBlockResultsResponse) ProtoMessage()    {}
+func (*GetBlockResultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52842f5d9c655164, []int{16}
+}
+func (m *GetBlockResultsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBlockResultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBlockResultsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBlockResultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockResultsResponse.Merge(m, src)
+}
+func (m *GetBlockResultsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBlockResultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockResultsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockResultsResponse proto.InternalMessageInfo
+
+func (m *GetBlockResultsResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetBlockResultsResponse) GetTxsResults() []*types2.ResponseDeliverTx {
+	if m != nil {
+		return m.TxsResults
+	}
+	return nil
+}
+
+func (m *GetBlockResultsResponse) GetBeginBlockEvents() []types2.Event {
+	if m != nil {
+		return m.BeginBlockEvents
+	}
+	return nil
+}
+
+func (m *GetBlockResultsResponse) GetEndBlockEvents() []types2.Event {
+	if m != nil {
+		return m.EndBlockEvents
+	}
+	return nil
+}
+
+func (m *GetBlockResultsResponse) GetValidatorUpdates() []types2.ValidatorUpdate {
+	if m != nil {
+		return m.ValidatorUpdates
+	}
+	return nil
+}
+
+func (m *GetBlockResultsResponse) GetConsensusParamUpdates() *types2.ConsensusParams {
+	if m != nil {
+		return m.ConsensusParamUpdates
+	}
+	return nil
+}
+
+func (m *GetBlockResultsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GetValidatorSetByHeightRequest)(nil), "lfb.base.ostracon.v1beta1.GetValidatorSetByHeightRequest")
 	proto.RegisterType((*GetValidatorSetByHeightResponse)(nil), "lfb.base.ostracon.v1beta1.GetValidatorSetByHeightResponse")
@@ -859,6 +1009,8 @@ func init() {
 	proto.RegisterType((*GetNodeInfoResponse)(nil), "lfb.base.ostracon.v1beta1.GetNodeInfoResponse")
 	proto.RegisterType((*VersionInfo)(nil), "lfb.base.ostracon.v1beta1.VersionInfo")
 	proto.RegisterType((*Module)(nil), "lfb.base.ostracon.v1beta1.Module")
+	proto.RegisterType((*GetBlockResultsRequest)(nil), "lfb.base.ostracon.v1beta1.GetBlockResultsRequest")
+	proto.RegisterType((*GetBlockResultsResponse)(nil), "lfb.base.ostracon.v1beta1.GetBlockResultsResponse")
 }
 
 func init() {
@@ -866,73 +1018,87 @@ func init() {
 }
 
 var fileDescriptor_52842f5d9c655164 = []byte{
-	// 1053 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4f, 0x6f, 0xdc, 0x44,
-	0x14, 0x8f, 0xb3, 0x6d, 0x36, 0x79, 0x8b, 0x20, 0x99, 0xa4, 0x8d, 0xb3, 0x6a, 0x96, 0xd4, 0x84,
-	0x12, 0xa5, 0x89, 0xdd, 0xdd, 0x1c, 0x4a, 0x39, 0x41, 0x1a, 0x29, 0x8d, 0x80, 0x2a, 0x72, 0x10,
-	0x48, 0x5c, 0x56, 0xf6, 0x7a, 0xd6, 0x19, 0xc5, 0xeb, 0x99, 0x7a, 0xc6, 0x41, 0xab, 0xaa, 0x17,
-	0x3e, 0x01, 0x12, 0xe2, 0xc2, 0x19, 0x89, 0x1b, 0x9c, 0x39, 0x73, 0x41, 0x42, 0x42, 0x91, 0xb8,
-	0x70, 0x44, 0x09, 0x5f, 0x81, 0x3b, 0xf2, 0xcc, 0xd8, 0xeb, 0x6d, 0x12, 0x93, 0xf4, 0x80, 0xc4,
-	0x6d, 0xe6, 0xfd, 0xfd, 0xfd, 0x9e, 0xdf, 0x7b, 0x1e, 0x78, 0x3b, 0xea, 0xfb, 0x8e, 0xef, 0x71,
-	0xec, 0x50, 0x2e, 0x12, 0xaf, 0x47, 0x63, 0xe7, 0xb8, 0xed, 0x63, 0xe1, 0xb5, 0x9d, 0x67, 0x29,
-	0x4e, 0x86, 0x36, 0x4b, 0xa8, 0xa0, 0x68, 0x29, 0xea, 0xfb, 0x76, 0x66, 0x66, 0xe7, 0x66, 0xb6,
-	0x36, 0x6b, 0x2e, 0x84, 0x34, 0xa4, 0xd2, 0xca, 0xc9, 0x4e, 0xca, 0xa1, 0xb9, 0x14, 0x52, 0x1a,
-	0x46, 0xd8, 0x91, 0x37, 0x3f, 0xed, 0x3b, 0x5e, 0xac, 0x63, 0x35, 0xef, 0x68, 0x95, 0xc7, 0x88,
-	0xe3, 0xc5, 0x31, 0x15, 0x9e, 0x20, 0x34, 0xe6, 0x5a, 0x6b, 0x16, 0x38, 0x58, 0x87, 0x39, 0x62,
-	0xc8, 0x70, 0xae, 0x69, 0x16, 0x1a, 0x29, 0x75, 0xfc, 0x88, 0xf6, 0x8e, 0x2e, 0xd1, 0x95, 0xfd,
-	0xde, 0x29, 0x28, 0x4a, 0x46, 0x05, 0x3f, 0xe6, 0x85, 0x24, 0x96, 0xb9, 0x95, 0xa1, 0xf5, 0x02,
-	0x5a, 0xbb, 0x58, 0x7c, 0xea, 0x45, 0x24, 0xf0, 0x04, 0x4d, 0x0e, 0xb0, 0xd8, 0x1e, 0x3e, 0xc1,
-	0x24, 0x3c, 0x14, 0x2e, 0x7e, 0x96, 0x62, 0x2e, 0xd0, 0x6d, 0x98, 0x3a, 0x94, 0x02, 0xd3, 0x58,
-	0x31, 0xd6, 0x6a, 0xae, 0xbe, 0xa1, 0xc7, 0x00, 0xa3, 0x68, 0xe6, 0xe4, 0x8a, 0xb1, 0xd6, 0xe8,
-	0xbc, 0x65, 0x17, 0x35, 0x53, 0x95, 0xd4, 0x79, 0xed, 0x7d, 0x2f, 0xc4, 0x3a, 0xa0, 0x5b, 0x72,
-	0xb3, 0x4e, 0x0c, 0x78, 0xf3, 0xd2, 0xfc, 0x9c, 0xd1, 0x98, 0x63, 0x74, 0x17, 0x5e, 0x93, 0xb4,
-	0xbb, 0x63, 0x30, 0x1a, 0x52, 0xa6, 0x4c, 0xd1, 0x0e, 0xc0, 0x71, 0x1e, 0x82, 0x9b, 0x93, 0x2b,
-	0xb5, 0xb5, 0x46, 0x67, 0xd5, 0xbe, 0xf4, 0xfb, 0xd9, 0x45, 0x3e, 0xb7, 0xe4, 0x97, 0x45, 0x29,
-	0x31, 0xaa, 0x49, 0x46, 0xab, 0xd5, 0x8c, 0x14, 0xc4, 0x31, 0x4a, 0x3d, 0xb8, 0xb3, 0x8b, 0xc5,
-	0x47, 0x9e, 0xc0, 0x7c, 0x8c, 0x57, 0x5e, 0xcf, 0xf1, 0xba, 0x19, 0xaf, 0x56, 0xb7, 0xdf, 0x0c,
-	0x58, 0xbe, 0x24, 0xcb, 0xff, 0xb3, 0x6a, 0xdf, 0x19, 0x30, 0x53, 0xc4, 0x47, 0x26, 0xd4, 0xbd,
-	0x20, 0x48, 0x30, 0xe7, 0x12, 0xf7, 0x8c, 0x9b, 0x5f, 0xd1, 0x26, 0xd4, 0x59, 0xea, 0x77, 0x8f,
-	0xf0, 0x50, 0xb7, 0xdc, 0x82, 0xad, 0x46, 0xcb, 0xce, 0xa7, 0xce, 0xfe, 0x20, 0x1e, 0xba, 0x53,
-	0x2c, 0xf5, 0x3f, 0xc4, 0xc3, 0xac, 0x0a, 0xc7, 0x54, 0x90, 0x38, 0xec, 0x32, 0xfa, 0x05, 0x4e,
-	0x24, 0xbc, 0x9a, 0xdb, 0x50, 0xb2, 0xfd, 0x4c, 0x84, 0xee, 0xc3, 0x1c, 0x4b, 0x28, 0xa3, 0x1c,
-	0x27, 0x5d, 0x96, 0x10, 0x9a, 0x10, 0x31, 0x34, 0x6f, 0x48, 0xbb, 0xd9, 0x5c, 0xb1, 0xaf, 0xe5,
-	0x56, 0x1b, 0x16, 0x77, 0xb1, 0xd8, 0xce, 0x8a, 0x78, 0xc5, 0x39, 0xb1, 0x9e, 0x83, 0x79, 0xde,
-	0x45, 0x7f, 0xa4, 0x0e, 0x4c, 0xab, 0x8f, 0x44, 0x02, 0xdd, 0x09, 0x8b, 0xa3, 0xb2, 0xab, 0x79,
-	0x96, 0x8e, 0x7b, 0x3b, 0x6e, 0x5d, 0x1a, 0xee, 0x05, 0xe8, 0x3e, 0xdc, 0x94, 0x47, 0xcd, 0xff,
-	0xd6, 0x85, 0x0e, 0xae, 0xb2, 0xb1, 0x16, 0xe1, 0x56, 0xd1, 0x26, 0x4a, 0xa1, 0xd0, 0x5a, 0x43,
-	0xb8, 0xfd, 0xb2, 0xe2, 0xbf, 0xc2, 0x34, 0x0f, 0x73, 0xbb, 0x58, 0x1c, 0x0c, 0xe3, 0x1e, 0x89,
-	0xc3, 0x1c, 0x8f, 0x0d, 0xa8, 0x2c, 0xd4, 0x58, 0x4c, 0xa8, 0x73, 0x25, 0x92, 0x50, 0xa6, 0xdd,
-	0xfc, 0x6a, 0x2d, 0x48, 0xfb, 0xa7, 0x34, 0xc0, 0x7b, 0x71, 0x9f, 0xe6, 0x51, 0x7e, 0x32, 0x60,
-	0x7e, 0x4c, 0xac, 0xe3, 0xec, 0xc1, 0x5c, 0x80, 0xfb, 0x5e, 0x1a, 0x89, 0x6e, 0x4c, 0x03, 0xdc,
-	0x25, 0x71, 0x9f, 0x6a, 0x72, 0xcb, 0x23, 0xac, 0xac, 0xc3, 0xec, 0x1d, 0x65, 0x56, 0x44, 0x78,
-	0x23, 0x18, 0x17, 0xa0, 0xcf, 0x60, 0xde, 0x63, 0x2c, 0x22, 0x3d, 0xd9, 0xb7, 0xdd, 0x63, 0x9c,
-	0xf0, 0xd1, 0xfe, 0xbb, 0x57, 0x35, 0x3d, 0xca, 0x52, 0x46, 0x45, 0xa5, 0x10, 0x5a, 0x6e, 0xfd,
-	0x6d, 0x40, 0xa3, 0x64, 0x83, 0x10, 0xdc, 0x88, 0xbd, 0x01, 0xd6, 0x03, 0x20, 0xcf, 0x68, 0x09,
-	0xa6, 0x3d, 0xc6, 0xba, 0x52, 0x3e, 0xa9, 0x07, 0x83, 0xb1, 0xa7, 0x99, 0xca, 0x84, 0x7a, 0x8e,
-	0xa5, 0xa6, 0x34, 0xfa, 0x8a, 0x96, 0x01, 0x42, 0x22, 0xba, 0x3d, 0x3a, 0x18, 0x10, 0x21, 0x3b,
-	0x7b, 0xc6, 0x9d, 0x09, 0x89, 0x78, 0x2c, 0x05, 0x99, 0xda, 0x4f, 0x49, 0x14, 0x74, 0x85, 0x17,
-	0x72, 0xf3, 0xa6, 0x52, 0x4b, 0xc9, 0x27, 0x5e, 0xc8, 0xa5, 0x37, 0x2d, 0x68, 0x4e, 0x69, 0x6f,
-	0xaa, 0x91, 0xa2, 0xf7, 0x73, 0xef, 0x00, 0x33, 0x6e, 0xd6, 0xe5, 0x0e, 0xb9, 0x5b, 0x51, 0x85,
-	0x8f, 0x69, 0x90, 0x46, 0x58, 0x27, 0xd8, 0xc1, 0x8c, 0x5b, 0x4f, 0x60, 0x4a, 0x09, 0x33, 0xc6,
-	0xcc, 0x13, 0x87, 0x39, 0xe3, 0xec, 0x5c, 0xa6, 0x35, 0x39, 0x4e, 0x6b, 0x16, 0x6a, 0x3c, 0x1d,
-	0x68, 0xb2, 0xd9, 0xb1, 0xf3, 0xc3, 0x34, 0xd4, 0x0f, 0x70, 0x72, 0x4c, 0x7a, 0x18, 0x7d, 0x6b,
-	0x40, 0xa3, 0xd4, 0x09, 0x68, 0xb3, 0x02, 0xd3, 0xf9, 0x46, 0x6a, 0xda, 0x57, 0x35, 0x57, 0x0d,
-	0x66, 0x6d, 0x7c, 0xf9, 0xfb, 0x5f, 0x5f, 0x4f, 0xde, 0x43, 0xab, 0xce, 0xe5, 0x6f, 0x8b, 0xa2,
-	0xf3, 0xd0, 0x37, 0x06, 0xc0, 0xa8, 0xdb, 0xd1, 0x46, 0x75, 0xb2, 0xf1, 0x49, 0x69, 0x6e, 0x5e,
-	0xd1, 0x5a, 0x23, 0x5b, 0x97, 0xc8, 0x56, 0x91, 0x55, 0x81, 0x4c, 0x0f, 0x15, 0xfa, 0xde, 0x80,
-	0xd7, 0xc7, 0xb7, 0x02, 0x7a, 0x50, 0x9d, 0xed, 0xfc, 0x66, 0x69, 0xb6, 0xaf, 0xe1, 0xa1, 0x31,
-	0x3e, 0x90, 0x18, 0xd7, 0xd1, 0x5a, 0x05, 0x46, 0xb9, 0x3b, 0xb8, 0x13, 0x49, 0x77, 0xf4, 0xa3,
-	0x01, 0xb3, 0x2f, 0x6f, 0x55, 0xd4, 0xa9, 0xce, 0x7c, 0xd1, 0xd6, 0x6e, 0x6e, 0x5d, 0xcb, 0x47,
-	0xe3, 0xed, 0x48, 0xbc, 0x1b, 0x68, 0xfd, 0xdf, 0xf1, 0x3e, 0x57, 0x7f, 0x81, 0x17, 0xe8, 0x67,
-	0xa3, 0xb4, 0x8a, 0xcb, 0x7f, 0x6c, 0xf4, 0xf0, 0x2a, 0x05, 0xbb, 0xe0, 0x25, 0xd1, 0x7c, 0xf7,
-	0xfa, 0x8e, 0x9a, 0xc0, 0x43, 0x49, 0xa0, 0x8d, 0x9c, 0x0a, 0x02, 0xa3, 0x5f, 0x3c, 0x16, 0x45,
-	0xdd, 0x7f, 0x35, 0xe4, 0x0f, 0xf0, 0xa2, 0xf7, 0x1a, 0x7a, 0x54, 0x0d, 0xa7, 0xe2, 0x8d, 0xd9,
-	0x7c, 0xef, 0x55, 0x5c, 0x35, 0x97, 0x47, 0x92, 0xcb, 0x16, 0x6a, 0x5f, 0x99, 0x4b, 0xfe, 0x4d,
-	0xb6, 0x77, 0x7f, 0x39, 0x6d, 0x19, 0x27, 0xa7, 0x2d, 0xe3, 0xcf, 0xd3, 0x96, 0xf1, 0xd5, 0x59,
-	0x6b, 0xe2, 0xe4, 0xac, 0x35, 0xf1, 0xc7, 0x59, 0x6b, 0xe2, 0xf3, 0xcd, 0x90, 0x88, 0xc3, 0xd4,
-	0xb7, 0x7b, 0x74, 0xe0, 0x44, 0x24, 0xc6, 0x59, 0xec, 0x4d, 0x1e, 0x1c, 0x39, 0xbd, 0x88, 0xe0,
-	0x58, 0x38, 0x61, 0xc2, 0x7a, 0x8e, 0x18, 0x70, 0xb5, 0x6d, 0xfc, 0x29, 0xf9, 0xf8, 0xd8, 0xfa,
-	0x27, 0x00, 0x00, 0xff, 0xff, 0xc7, 0x84, 0xfb, 0xd9, 0x5a, 0x0c, 0x00, 0x00,
+	// 1267 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcf, 0x6f, 0xd4, 0xc6,
+	0x17, 0x8f, 0xb3, 0x21, 0x4b, 0xde, 0x7e, 0x05, 0xc9, 0x10, 0x88, 0x59, 0xc1, 0x12, 0xfc, 0xa5,
+	0x34, 0x02, 0x62, 0xb3, 0xcb, 0x81, 0xd2, 0x53, 0x09, 0xa9, 0x42, 0xd4, 0x16, 0xa5, 0x86, 0x52,
+	0xa9, 0x17, 0x6b, 0x6c, 0x4f, 0x1c, 0x0b, 0xaf, 0x67, 0xf0, 0x8c, 0xb7, 0xac, 0x10, 0x97, 0xfe,
+	0x05, 0x95, 0xaa, 0x5e, 0x7a, 0xae, 0xd4, 0x63, 0x0f, 0x3d, 0xf5, 0xdc, 0x0b, 0x52, 0xa5, 0x0a,
+	0xa9, 0x97, 0x9e, 0xaa, 0x0a, 0xf8, 0x17, 0xb8, 0x57, 0x1e, 0x8f, 0xbd, 0x76, 0x92, 0x5d, 0x92,
+	0x1c, 0x90, 0x7a, 0xf3, 0xbc, 0x1f, 0x9f, 0xf7, 0x79, 0x6f, 0xe6, 0xbd, 0x19, 0xc3, 0x7b, 0xd1,
+	0xb6, 0x6b, 0xb9, 0x98, 0x13, 0x8b, 0x72, 0x91, 0x60, 0x8f, 0xc6, 0xd6, 0xa0, 0xeb, 0x12, 0x81,
+	0xbb, 0xd6, 0xe3, 0x94, 0x24, 0x43, 0x93, 0x25, 0x54, 0x50, 0x74, 0x36, 0xda, 0x76, 0xcd, 0xcc,
+	0xcc, 0x2c, 0xcc, 0x4c, 0x65, 0xd6, 0x5e, 0x0c, 0x68, 0x40, 0xa5, 0x95, 0x95, 0x7d, 0xe5, 0x0e,
+	0xed, 0xb3, 0x01, 0xa5, 0x41, 0x44, 0x2c, 0xb9, 0x72, 0xd3, 0x6d, 0x0b, 0xc7, 0x0a, 0xab, 0x7d,
+	0x4e, 0xa9, 0x30, 0x0b, 0x2d, 0x1c, 0xc7, 0x54, 0x60, 0x11, 0xd2, 0x98, 0x17, 0x8e, 0x25, 0x0f,
+	0xec, 0x7a, 0xa1, 0x25, 0x86, 0x8c, 0x14, 0x2a, 0xbd, 0x54, 0xb1, 0x1e, 0xab, 0x69, 0xda, 0xa5,
+	0x46, 0x4a, 0x2d, 0x37, 0xa2, 0xde, 0xa3, 0x31, 0xba, 0xaa, 0xdf, 0xfb, 0x65, 0xf6, 0x32, 0xd9,
+	0x32, 0x75, 0x86, 0x83, 0x30, 0x96, 0xb4, 0x72, 0x43, 0xe3, 0x19, 0x74, 0x36, 0x88, 0x78, 0x88,
+	0xa3, 0xd0, 0xc7, 0x82, 0x26, 0xf7, 0x89, 0x58, 0x1b, 0xde, 0x25, 0x61, 0xb0, 0x23, 0x6c, 0xf2,
+	0x38, 0x25, 0x5c, 0xa0, 0x33, 0x30, 0xbb, 0x23, 0x05, 0xba, 0xb6, 0xac, 0xad, 0x34, 0x6c, 0xb5,
+	0x42, 0x77, 0x00, 0x46, 0x68, 0xfa, 0xf4, 0xb2, 0xb6, 0xd2, 0xea, 0xfd, 0xdf, 0x2c, 0xcb, 0x99,
+	0x17, 0x59, 0xc5, 0x35, 0xb7, 0x70, 0x40, 0x14, 0xa0, 0x5d, 0x71, 0x33, 0x5e, 0x68, 0x70, 0x61,
+	0x6c, 0x7c, 0xce, 0x68, 0xcc, 0x09, 0xba, 0x08, 0xff, 0x93, 0x69, 0x3b, 0x35, 0x1a, 0x2d, 0x29,
+	0xcb, 0x4d, 0xd1, 0x3a, 0xc0, 0xa0, 0x80, 0xe0, 0xfa, 0xf4, 0x72, 0x63, 0xa5, 0xd5, 0xbb, 0x64,
+	0x8e, 0xdd, 0x5a, 0xb3, 0x8c, 0x67, 0x57, 0xfc, 0x32, 0x94, 0x4a, 0x46, 0x8d, 0x65, 0xad, 0x8e,
+	0xb2, 0x5f, 0x46, 0x39, 0xc5, 0x5a, 0x4a, 0x1e, 0x9c, 0xdb, 0x20, 0xe2, 0x53, 0x2c, 0x08, 0xaf,
+	0xe5, 0x55, 0xd4, 0xb3, 0x5e, 0x37, 0xed, 0x68, 0x75, 0xfb, 0x43, 0x83, 0xf3, 0x63, 0xa2, 0xfc,
+	0x37, 0xab, 0xf6, 0xa3, 0x06, 0x73, 0x25, 0x3e, 0xd2, 0xa1, 0x89, 0x7d, 0x3f, 0x21, 0x9c, 0x4b,
+	0xde, 0x73, 0x76, 0xb1, 0x44, 0xab, 0xd0, 0x64, 0xa9, 0xeb, 0x3c, 0x22, 0x43, 0x75, 0xe4, 0x16,
+	0xcd, 0xbc, 0xeb, 0xcc, 0xa2, 0x21, 0xcd, 0xdb, 0xf1, 0xd0, 0x9e, 0x65, 0xa9, 0xfb, 0x09, 0x19,
+	0x66, 0x55, 0x18, 0x50, 0x11, 0xc6, 0x81, 0xc3, 0xe8, 0xd7, 0x24, 0x91, 0xf4, 0x1a, 0x76, 0x2b,
+	0x97, 0x6d, 0x65, 0x22, 0x74, 0x15, 0x16, 0x58, 0x42, 0x19, 0xe5, 0x24, 0x71, 0x58, 0x12, 0xd2,
+	0x24, 0x14, 0x43, 0x7d, 0x46, 0xda, 0xcd, 0x17, 0x8a, 0x2d, 0x25, 0x37, 0xba, 0xb0, 0xb4, 0x41,
+	0xc4, 0x5a, 0x56, 0xc4, 0x03, 0xf6, 0x89, 0xf1, 0x14, 0xf4, 0xbd, 0x2e, 0x6a, 0x93, 0x7a, 0x70,
+	0x3c, 0xdf, 0xa4, 0xd0, 0x57, 0x27, 0x61, 0x69, 0x54, 0xf6, 0xbc, 0x9f, 0xa5, 0xe3, 0xe6, 0xba,
+	0xdd, 0x94, 0x86, 0x9b, 0x3e, 0xba, 0x0a, 0xc7, 0xe4, 0xa7, 0xca, 0xff, 0xf4, 0xbe, 0x0e, 0x76,
+	0x6e, 0x63, 0x2c, 0xc1, 0xe9, 0xf2, 0x98, 0xe4, 0x8a, 0x9c, 0xad, 0x31, 0x84, 0x33, 0xbb, 0x15,
+	0xef, 0x8a, 0xd3, 0x29, 0x58, 0xd8, 0x20, 0xe2, 0xfe, 0x30, 0xf6, 0xc2, 0x38, 0x28, 0xf8, 0x98,
+	0x80, 0xaa, 0x42, 0xc5, 0x45, 0x87, 0x26, 0xcf, 0x45, 0x92, 0xca, 0x71, 0xbb, 0x58, 0x1a, 0x8b,
+	0xd2, 0xfe, 0x1e, 0xf5, 0xc9, 0x66, 0xbc, 0x4d, 0x0b, 0x94, 0x5f, 0x35, 0x38, 0x55, 0x13, 0x2b,
+	0x9c, 0x4d, 0x58, 0xf0, 0xc9, 0x36, 0x4e, 0x23, 0xe1, 0xc4, 0xd4, 0x27, 0x4e, 0x18, 0x6f, 0x53,
+	0x95, 0xdc, 0xf9, 0x11, 0x57, 0xd6, 0x63, 0xe6, 0x7a, 0x6e, 0x56, 0x22, 0x9c, 0xf4, 0xeb, 0x02,
+	0xf4, 0x25, 0x9c, 0xc2, 0x8c, 0x45, 0xa1, 0x27, 0xcf, 0xad, 0x33, 0x20, 0x09, 0x1f, 0xcd, 0xbf,
+	0xcb, 0x93, 0xba, 0x27, 0xb7, 0x94, 0xa8, 0xa8, 0x02, 0xa1, 0xe4, 0xc6, 0x1b, 0x0d, 0x5a, 0x15,
+	0x1b, 0x84, 0x60, 0x26, 0xc6, 0x7d, 0xa2, 0x1a, 0x40, 0x7e, 0xa3, 0xb3, 0x70, 0x1c, 0x33, 0xe6,
+	0x48, 0xf9, 0xb4, 0x6a, 0x0c, 0xc6, 0xee, 0x65, 0x2a, 0x1d, 0x9a, 0x05, 0x97, 0x46, 0xae, 0x51,
+	0x4b, 0x74, 0x1e, 0x20, 0x08, 0x85, 0xe3, 0xd1, 0x7e, 0x3f, 0x14, 0xf2, 0x64, 0xcf, 0xd9, 0x73,
+	0x41, 0x28, 0xee, 0x48, 0x41, 0xa6, 0x76, 0xd3, 0x30, 0xf2, 0x1d, 0x81, 0x03, 0xae, 0x1f, 0xcb,
+	0xd5, 0x52, 0xf2, 0x00, 0x07, 0x5c, 0x7a, 0xd3, 0x32, 0xcd, 0x59, 0xe5, 0x4d, 0x15, 0x53, 0xf4,
+	0x51, 0xe1, 0xed, 0x13, 0xc6, 0xf5, 0xa6, 0x9c, 0x21, 0x17, 0x27, 0x54, 0xe1, 0x33, 0xea, 0xa7,
+	0x11, 0x51, 0x01, 0xd6, 0x09, 0xe3, 0xc6, 0x5d, 0x98, 0xcd, 0x85, 0x59, 0xc6, 0x0c, 0x8b, 0x9d,
+	0x22, 0xe3, 0xec, 0xbb, 0x9a, 0xd6, 0x74, 0x3d, 0xad, 0x79, 0x68, 0xf0, 0xb4, 0xaf, 0x92, 0xcd,
+	0x3e, 0x8d, 0x54, 0x9e, 0xe9, 0xe2, 0x34, 0xa7, 0x91, 0xe0, 0xef, 0xe4, 0x0e, 0x7b, 0xd3, 0x80,
+	0xa5, 0x3d, 0x71, 0xd5, 0xc1, 0x1b, 0x17, 0xf8, 0x36, 0xb4, 0xc4, 0x13, 0xee, 0x24, 0xb9, 0xb9,
+	0x9a, 0xbd, 0xcb, 0xa3, 0x72, 0x65, 0x4f, 0x04, 0xb3, 0x40, 0x59, 0x27, 0x51, 0x38, 0x20, 0xc9,
+	0x83, 0x27, 0x36, 0x88, 0x27, 0x5c, 0x85, 0x40, 0x77, 0x01, 0xb9, 0x24, 0x08, 0x63, 0x27, 0xef,
+	0x56, 0x32, 0x20, 0xb1, 0xe0, 0x7a, 0x43, 0x22, 0x2d, 0xee, 0x42, 0xfa, 0x38, 0x53, 0xae, 0xcd,
+	0x3c, 0xff, 0xfb, 0xc2, 0x94, 0x3d, 0x2f, 0xbd, 0x24, 0x5b, 0x29, 0xce, 0x26, 0xf8, 0x3c, 0x89,
+	0xfd, 0x3a, 0xce, 0xcc, 0x5b, 0x71, 0x4e, 0x90, 0xd8, 0xaf, 0xa2, 0x7c, 0x0e, 0x0b, 0xe5, 0xad,
+	0xe0, 0xa4, 0xcc, 0xc7, 0x82, 0x64, 0xc7, 0x29, 0x83, 0xe9, 0xec, 0x82, 0x29, 0x07, 0xfd, 0x17,
+	0xd2, 0xac, 0x20, 0x36, 0xa8, 0x8b, 0x39, 0x7a, 0x08, 0x4b, 0x5e, 0x56, 0x80, 0x98, 0xa7, 0xdc,
+	0x61, 0x38, 0xc1, 0xfd, 0x12, 0x78, 0x76, 0x59, 0xdb, 0x07, 0xf8, 0x4e, 0x61, 0xbd, 0x95, 0x19,
+	0x73, 0xfb, 0xb4, 0x57, 0x13, 0x14, 0xb8, 0xf5, 0x2b, 0xab, 0x79, 0xb4, 0x2b, 0xab, 0xf7, 0x7a,
+	0x0e, 0x9a, 0xf7, 0x49, 0x32, 0x08, 0x3d, 0x82, 0x7e, 0xd0, 0xa0, 0x55, 0x19, 0x3c, 0x68, 0x75,
+	0x42, 0x0b, 0xec, 0x9d, 0x5b, 0x6d, 0xf3, 0xa0, 0xe6, 0x39, 0x0d, 0xe3, 0xda, 0x37, 0x7f, 0xbe,
+	0xfe, 0x6e, 0xfa, 0x32, 0xba, 0x64, 0x8d, 0x7f, 0xe5, 0x96, 0x83, 0x0e, 0x7d, 0xaf, 0x01, 0x8c,
+	0x86, 0x2b, 0xba, 0x36, 0x39, 0x58, 0x7d, 0x30, 0xb7, 0x57, 0x0f, 0x68, 0xad, 0x98, 0x5d, 0x91,
+	0xcc, 0x2e, 0x21, 0x63, 0x02, 0x33, 0x35, 0xc3, 0xd1, 0x4f, 0x1a, 0x9c, 0xa8, 0x5f, 0x42, 0xe8,
+	0xfa, 0xe4, 0x68, 0x7b, 0x2f, 0xb2, 0x76, 0xf7, 0x10, 0x1e, 0x8a, 0xe3, 0x75, 0xc9, 0xf1, 0x0a,
+	0x5a, 0x99, 0xc0, 0x51, 0x36, 0x03, 0xb7, 0x22, 0xe9, 0x8e, 0x7e, 0xd6, 0x60, 0x7e, 0xf7, 0x25,
+	0x8e, 0x7a, 0x93, 0x23, 0xef, 0xf7, 0x48, 0x68, 0xdf, 0x38, 0x94, 0x8f, 0xe2, 0xdb, 0x93, 0x7c,
+	0xaf, 0xa1, 0x2b, 0x6f, 0xe7, 0xfb, 0x34, 0x9f, 0x2f, 0xcf, 0xd0, 0x2f, 0x1a, 0x9c, 0xdc, 0x35,
+	0x94, 0x50, 0xf7, 0x00, 0xc1, 0xeb, 0x83, 0xb3, 0xdd, 0x3b, 0x8c, 0x8b, 0xa2, 0x7b, 0x4b, 0xd2,
+	0xbd, 0x81, 0xba, 0x6f, 0xa3, 0x5b, 0x8c, 0xbf, 0x11, 0xeb, 0xdf, 0xb4, 0xca, 0x7b, 0xa5, 0xfa,
+	0xac, 0x45, 0x37, 0x0f, 0xb2, 0xcd, 0xfb, 0x3c, 0xb7, 0xdb, 0x1f, 0x1c, 0xde, 0x51, 0xe5, 0x71,
+	0x53, 0xe6, 0xd1, 0x45, 0xd6, 0x84, 0x3c, 0x46, 0xef, 0x60, 0x22, 0xca, 0xd3, 0xf2, 0xbb, 0x26,
+	0x2f, 0x84, 0xfd, 0x7e, 0x6a, 0xd0, 0xad, 0xc9, 0x74, 0x26, 0xfc, 0x88, 0xb5, 0x3f, 0x3c, 0x8a,
+	0xeb, 0x21, 0xf6, 0xa4, 0x9e, 0x4b, 0xb1, 0x27, 0x6b, 0x1b, 0xcf, 0x5f, 0x76, 0xb4, 0x17, 0x2f,
+	0x3b, 0xda, 0x3f, 0x2f, 0x3b, 0xda, 0xb7, 0xaf, 0x3a, 0x53, 0x2f, 0x5e, 0x75, 0xa6, 0xfe, 0x7a,
+	0xd5, 0x99, 0xfa, 0x6a, 0x35, 0x08, 0xc5, 0x4e, 0xea, 0x9a, 0x1e, 0xed, 0x5b, 0x51, 0x18, 0x93,
+	0x0c, 0x7b, 0x95, 0xfb, 0x8f, 0x2c, 0x2f, 0x0a, 0x49, 0x2c, 0xac, 0x20, 0x61, 0x9e, 0x25, 0xfa,
+	0x3c, 0x9f, 0x91, 0xee, 0xac, 0x7c, 0xa1, 0xdf, 0xf8, 0x77, 0x00, 0xf5, 0x33, 0x29, 0xec, 0x9a,
+	0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -955,6 +1121,8 @@ type ServiceClient interface {
 	GetLatestBlock(ctx context.Context, in *GetLatestBlockRequest, opts ...grpc.CallOption) (*GetLatestBlockResponse, error)
 	// GetBlockByHeight queries block for given height.
 	GetBlockByHeight(ctx context.Context, in *GetBlockByHeightRequest, opts ...grpc.CallOption) (*GetBlockByHeightResponse, error)
+	// GetBlockResults queries the results of the txs and the events of the block for given height.
+	GetBlockResults(ctx context.Context, in *GetBlockResultsRequest, opts ...grpc.CallOption) (*GetBlockResultsResponse, error)
 	// GetLatestValidatorSet queries latest validator-set.
 	GetLatestValidatorSet(ctx context.Context, in *GetLatestValidatorSetRequest, opts ...grpc.CallOption) (*GetLatestValidatorSetResponse, error)
 	// GetValidatorSetByHeight queries validator-set at a given height.
@@ -1005,6 +1173,15 @@ func (c *serviceClient) GetBlockByHeight(ctx context.Context, in *GetBlockByHeig
 	return out, nil
 }
 
+func (c *serviceClient) GetBlockResults(ctx context.Context, in *GetBlockResultsRequest, opts ...grpc.CallOption) (*GetBlockResultsResponse, error) {
+	out := new(GetBlockResultsResponse)
+	err := c.cc.Invoke(ctx, "/lfb.base.ostracon.v1beta1.Service/GetBlockResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serviceClient) GetLatestValidatorSet(ctx context.Context, in *GetLatestValidatorSetRequest, opts ...grpc.CallOption) (*GetLatestValidatorSetResponse, error) {
 	out := new(GetLatestValidatorSetResponse)
 	err := c.cc.Invoke(ctx, "/lfb.base.ostracon.v1beta1.Service/GetLatestValidatorSet", in, out, opts...)
@@ -1033,6 +1210,8 @@ type ServiceServer interface {
 	GetLatestBlock(context.Context, *GetLatestBlockRequest) (*GetLatestBlockResponse, error)
 	// GetBlockByHeight queries block for given height.
 	GetBlockByHeight(context.Context, *GetBlockByHeightRequest) (*GetBlockByHeightResponse, error)
+	// GetBlockResults queries the results of the txs and the events of the block for given height.
+	GetBlockResults(context.Context, *GetBlockResultsRequest) (*GetBlockResultsResponse, error)
 	// GetLatestValidatorSet queries latest validator-set.
 	GetLatestValidatorSet(context.Context, *GetLatestValidatorSetRequest) (*GetLatestValidatorSetResponse, error)
 	// GetValidatorSetByHeight queries validator-set at a given height.
@@ -1055,6 +1234,9 @@ func (*UnimplementedServiceServer) GetLatestBlock(ctx context.Context, req *GetL
 func (*UnimplementedServiceServer) GetBlockByHeight(ctx context.Context, req *GetBlockByHeightRequest) (*GetBlockByHeightResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockByHeight not implemented")
 }
+func (*UnimplementedServiceServer) GetBlockResults(ctx context.Context, req *GetBlockResultsRequest) (*GetBlockResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockResults not implemented")
+}
 func (*UnimplementedServiceServer) GetLatestValidatorSet(ctx context.Context, req *GetLatestValidatorSetRequest) (*GetLatestValidatorSetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLatestValidatorSet not implemented")
 }
@@ -1138,6 +1320,24 @@ func _Service_GetBlockByHeight_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_GetBlockResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetBlockResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lfb.base.ostracon.v1beta1.Service/GetBlockResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetBlockResults(ctx, req.(*GetBlockResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Service_GetLatestValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetLatestValidatorSetRequest)
 	if err := dec(in); err != nil {
@@ -1194,6 +1394,10 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlockByHeight",
 			Handler:    _Service_GetBlockByHeight_Handler,
 		},
+		{
+			MethodName: "GetBlockResults",
+			Handler:    _Service_GetBlockResults_Handler,
+		},
 		{
 			MethodName: "GetLatestValidatorSet",
 			Handler:    _Service_GetLatestValidatorSet_Handler,
@@ -1836,6 +2040,154 @@ func (m *Module) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GetBlockResultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockResultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockResultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockResultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockResultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockResultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ConsensusParamUpdates != nil {
+		{
+			size, err := m.ConsensusParamUpdates.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ValidatorUpdates) > 0 {
+		for iNdEx := len(m.ValidatorUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.EndBlockEvents) > 0 {
+		for iNdEx := len(m.EndBlockEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EndBlockEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.BeginBlockEvents) > 0 {
+		for iNdEx := len(m.BeginBlockEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BeginBlockEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TxsResults) > 0 {
+		for iNdEx := len(m.TxsResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxsResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2105,13 +2457,73 @@ func (m *Module) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *GetBlockResultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *GetValidatorSetByHeightRequest) Unmarshal(dAtA []byte) error {
+
+func (m *GetBlockResultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if len(m.TxsResults) > 0 {
+		for _, e := range m.TxsResults {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.BeginBlockEvents) > 0 {
+		for _, e := range m.BeginBlockEvents {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.EndBlockEvents) > 0 {
+		for _, e := range m.EndBlockEvents {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ValidatorUpdates) > 0 {
+		for _, e := range m.ValidatorUpdates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ConsensusParamUpdates != nil {
+		l = m.ConsensusParamUpdates.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetValidatorSetByHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3813,6 +4225,388 @@ func (m *Module) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetBlockResultsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockResultsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockResultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockResultsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockResultsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockResultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxsResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxsResults = append(m.TxsResults, &types2.ResponseDeliverTx{})
+			if err := m.TxsResults[len(m.TxsResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlockEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeginBlockEvents = append(m.BeginBlockEvents, types2.Event{})
+			if err := m.BeginBlockEvents[len(m.BeginBlockEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlockEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndBlockEvents = append(m.EndBlockEvents, types2.Event{})
+			if err := m.EndBlockEvents[len(m.EndBlockEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorUpdates = append(m.ValidatorUpdates, types2.ValidatorUpdate{})
+			if err := m.ValidatorUpdates[len(m.ValidatorUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusParamUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusParamUpdates == nil {
+				m.ConsensusParamUpdates = &types2.ConsensusParams{}
+			}
+			if err := m.ConsensusParamUpdates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
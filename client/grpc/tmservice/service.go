@@ -89,6 +89,38 @@ func (s queryServer) GetBlockByHeight(_ context.Context, req *GetBlockByHeightRe
 	}, nil
 }
 
+// GetBlockResults implements ServiceServer.GetBlockResults
+func (s queryServer) GetBlockResults(ctx context.Context, req *GetBlockResultsRequest) (*GetBlockResultsResponse, error) {
+	chainHeight, err := rpc.GetChainHeight(s.clientCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Height > chainHeight {
+		return nil, status.Error(codes.InvalidArgument, "requested block height is bigger then the chain length")
+	}
+
+	res, err := getBlockResults(ctx, s.clientCtx, &req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end, pageRes, err := qtypes.ParseSlicePagination(req.Pagination, len(res.TxsResults))
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetBlockResultsResponse{
+		Height:                res.Height,
+		TxsResults:            res.TxsResults[start:end],
+		BeginBlockEvents:      res.BeginBlockEvents,
+		EndBlockEvents:        res.EndBlockEvents,
+		ValidatorUpdates:      res.ValidatorUpdates,
+		ConsensusParamUpdates: res.ConsensusParamUpdates,
+		Pagination:            pageRes,
+	}, nil
+}
+
 // GetLatestValidatorSet implements ServiceServer.GetLatestValidatorSet
 func (s queryServer) GetLatestValidatorSet(ctx context.Context, req *GetLatestValidatorSetRequest) (*GetLatestValidatorSetResponse, error) {
 	page, limit, err := qtypes.ParsePagination(req.Pagination)
@@ -96,6 +96,23 @@ func (s IntegrationTestSuite) TestQueryBlockByHeight() {
 	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(restRes, &blockInfoRes))
 }
 
+func (s IntegrationTestSuite) TestQueryBlockResults() {
+	val := s.network.Validators[0]
+	res, err := s.queryClient.GetBlockResults(context.Background(), &tmservice.GetBlockResultsRequest{Height: 1})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), res.Height)
+	s.Require().Equal(uint64(len(res.TxsResults)), res.Pagination.Total)
+
+	_, err = s.queryClient.GetBlockResults(context.Background(), &tmservice.GetBlockResultsRequest{Height: 1000000})
+	s.Require().Error(err)
+
+	restRes, err := rest.GetRequest(fmt.Sprintf("%s/lfb/base/ostracon/v1beta1/block_results/%d", val.APIAddress, 1))
+	s.Require().NoError(err)
+	var blockResultsRes tmservice.GetBlockResultsResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(restRes, &blockResultsRes))
+	s.Require().Equal(int64(1), blockResultsRes.Height)
+}
+
 func (s IntegrationTestSuite) TestQueryLatestValidatorSet() {
 	val := s.network.Validators[0]
 
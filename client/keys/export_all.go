@@ -0,0 +1,51 @@
+package keys
+
+import (
+	"bufio"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/line/lfb-sdk/client"
+	"github.com/line/lfb-sdk/client/input"
+	"github.com/line/lfb-sdk/crypto/keyring"
+)
+
+// ExportAllKeysCommand exports all keys from the key store in a single bundle.
+func ExportAllKeysCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "export-all",
+		Short: "Export all keys",
+		Long: `Export all keys from the local keyring in a single ASCII-armored encrypted bundle.
+
+The bundle holds the private keys of local keys as well as the records of
+ledger, offline and multisig keys, and can be restored with import-all.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			buf := bufio.NewReader(cmd.InOrStdin())
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			exporter, ok := clientCtx.Keyring.(keyring.BundleExporter)
+			if !ok {
+				return fmt.Errorf("the keyring backend does not support exporting all keys")
+			}
+
+			encryptPassword, err := input.GetPassword("Enter passphrase to encrypt the exported keys:", buf)
+			if err != nil {
+				return err
+			}
+
+			armored, err := exporter.ExportAllArmor(encryptPassword)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(armored)
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,57 @@
+package keys
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+
+	"github.com/line/lfb-sdk/client"
+	"github.com/line/lfb-sdk/client/input"
+	"github.com/line/lfb-sdk/crypto/keyring"
+)
+
+// ImportAllKeysCommand imports all keys from a bundle file.
+func ImportAllKeysCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import-all <bundlefile>",
+		Short: "Import all keys of a bundle into the local keybase",
+		Long: `Import all keys of an ASCII armored encrypted bundle created by export-all into the local keybase.
+Keys whose name or address already exist in the keybase are skipped.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			buf := bufio.NewReader(cmd.InOrStdin())
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			importer, ok := clientCtx.Keyring.(keyring.BundleImporter)
+			if !ok {
+				return fmt.Errorf("the keyring backend does not support importing all keys")
+			}
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			passphrase, err := input.GetPassword("Enter passphrase to decrypt your keys:", buf)
+			if err != nil {
+				return err
+			}
+
+			skipped, err := importer.ImportAllArmor(string(bz), passphrase)
+			printSkippedKeys(cmd, skipped)
+
+			return err
+		},
+	}
+}
+
+func printSkippedKeys(cmd *cobra.Command, skipped []keyring.Info) {
+	for _, info := range skipped {
+		cmd.PrintErrf("Key %s (%s) skipped: name or address already exists\n", info.GetName(), info.GetAddress())
+	}
+}
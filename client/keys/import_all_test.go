@@ -0,0 +1,78 @@
+package keys
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/client"
+	"github.com/line/lfb-sdk/client/flags"
+	"github.com/line/lfb-sdk/crypto/hd"
+	"github.com/line/lfb-sdk/crypto/keyring"
+	"github.com/line/lfb-sdk/crypto/keys/secp256k1"
+	"github.com/line/lfb-sdk/testutil"
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+func Test_runExportImportAllCmd(t *testing.T) {
+	// populate the source keybase
+	srcHome := t.TempDir()
+	src, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, srcHome, nil)
+	require.NoError(t, err)
+	path := sdk.GetConfig().GetFullFundraiserPath()
+	local, err := src.NewAccount("keyname1", testutil.TestMnemonic, "", path, hd.Secp256k1)
+	require.NoError(t, err)
+	offline, err := src.SavePubKey("keyname2", secp256k1.GenPrivKey().PubKey(), hd.Secp256k1Type)
+	require.NoError(t, err)
+
+	// export all keys
+	exportCmd := ExportAllKeysCommand()
+	exportCmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+	mockIn, mockOut := testutil.ApplyMockIO(exportCmd)
+	mockIn.Reset("123456789\n")
+	exportCmd.SetArgs([]string{
+		fmt.Sprintf("--%s=%s", flags.FlagHome, srcHome),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+	})
+	clientCtx := client.Context{}.WithKeyring(src)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+	require.NoError(t, exportCmd.ExecuteContext(ctx))
+	bundleFile := testutil.WriteToNewTempFile(t, mockOut.String())
+
+	// import all keys into a new keybase
+	dstHome := t.TempDir()
+	dst, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, dstHome, nil)
+	require.NoError(t, err)
+
+	importCmd := ImportAllKeysCommand()
+	importCmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+	mockIn, mockOut = testutil.ApplyMockIO(importCmd)
+	importCmd.SetArgs([]string{
+		bundleFile.Name(),
+		fmt.Sprintf("--%s=%s", flags.FlagHome, dstHome),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+	})
+	clientCtx = client.Context{}.WithKeyring(dst)
+	ctx = context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	// wrong passphrase
+	mockIn.Reset("987654321\n")
+	require.Error(t, importCmd.ExecuteContext(ctx))
+
+	mockIn.Reset("123456789\n")
+	require.NoError(t, importCmd.ExecuteContext(ctx))
+	for _, exp := range []keyring.Info{local, offline} {
+		info, err := dst.Key(exp.GetName())
+		require.NoError(t, err)
+		require.Equal(t, exp.GetType(), info.GetType())
+		require.Equal(t, exp.GetAddress(), info.GetAddress())
+	}
+
+	// importing again skips the existing keys
+	mockIn.Reset("123456789\n")
+	require.NoError(t, importCmd.ExecuteContext(ctx))
+	require.Contains(t, mockOut.String(), "Key keyname1")
+	require.Contains(t, mockOut.String(), "Key keyname2")
+}
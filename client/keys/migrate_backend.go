@@ -0,0 +1,67 @@
+package keys
+
+import (
+	"bufio"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/line/lfb-sdk/client"
+	"github.com/line/lfb-sdk/crypto/keyring"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/version"
+)
+
+const (
+	flagMigrateFrom = "from"
+	flagMigrateTo   = "to"
+)
+
+// MigrateBackendCommand copies all keys from one keyring backend to another.
+func MigrateBackendCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-backend",
+		Short: "Copy all keys from one keyring backend to another",
+		Long: `Copy all keys, including the records of ledger, offline and multisig keys,
+from the keyring backend given by --from to the one given by --to. Keys whose
+name or address already exist in the destination keyring are skipped.
+The source keyring is left untouched.`,
+		Example: fmt.Sprintf("%s keys migrate-backend --from file --to os", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			buf := bufio.NewReader(cmd.InOrStdin())
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from, _ := cmd.Flags().GetString(flagMigrateFrom)
+			to, _ := cmd.Flags().GetString(flagMigrateTo)
+			if from == to {
+				return fmt.Errorf("source and destination backends must differ")
+			}
+
+			src, err := keyring.New(sdk.KeyringServiceName(), from, clientCtx.KeyringDir, buf, clientCtx.KeyringOptions...)
+			if err != nil {
+				return err
+			}
+
+			dst, err := keyring.New(sdk.KeyringServiceName(), to, clientCtx.KeyringDir, buf, clientCtx.KeyringOptions...)
+			if err != nil {
+				return err
+			}
+
+			skipped, err := keyring.MigrateKeyring(src, dst)
+			printSkippedKeys(cmd, skipped)
+
+			return err
+		},
+	}
+
+	cmd.Flags().String(flagMigrateFrom, "", "The keyring backend to copy keys from")
+	cmd.Flags().String(flagMigrateTo, "", "The keyring backend to copy keys to")
+	cmd.MarkFlagRequired(flagMigrateFrom)
+	cmd.MarkFlagRequired(flagMigrateTo)
+
+	return cmd
+}
@@ -0,0 +1,61 @@
+package keys
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/client"
+	"github.com/line/lfb-sdk/client/flags"
+	"github.com/line/lfb-sdk/crypto/hd"
+	"github.com/line/lfb-sdk/crypto/keyring"
+	"github.com/line/lfb-sdk/testutil"
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+func Test_runMigrateBackendCmd(t *testing.T) {
+	cmd := MigrateBackendCommand()
+	cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+	mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+
+	kbHome := t.TempDir()
+	src, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, mockIn)
+	require.NoError(t, err)
+	path := sdk.GetConfig().GetFullFundraiserPath()
+	info, err := src.NewAccount("keyname1", testutil.TestMnemonic, "", path, hd.Secp256k1)
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.WithKeyringDir(kbHome)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	// same backend
+	cmd.SetArgs([]string{
+		fmt.Sprintf("--%s=%s", flagMigrateFrom, keyring.BackendTest),
+		fmt.Sprintf("--%s=%s", flagMigrateTo, keyring.BackendTest),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+	})
+	require.Error(t, cmd.ExecuteContext(ctx))
+
+	// migrate from test to file backend
+	cmd.SetArgs([]string{
+		fmt.Sprintf("--%s=%s", flagMigrateFrom, keyring.BackendTest),
+		fmt.Sprintf("--%s=%s", flagMigrateTo, keyring.BackendFile),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+	})
+	mockIn.Reset("123456789\n123456789\n123456789\n123456789\n")
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	mockIn.Reset("123456789\n")
+	dst, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendFile, kbHome, mockIn)
+	require.NoError(t, err)
+	mockIn.Reset("123456789\n")
+	migrated, err := dst.Key("keyname1")
+	require.NoError(t, err)
+	require.Equal(t, info.GetAddress(), migrated.GetAddress())
+
+	// the source keyring still holds the key
+	_, err = src.Key("keyname1")
+	require.NoError(t, err)
+}
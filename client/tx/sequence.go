@@ -0,0 +1,324 @@
+package tx
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"sync"
+
+	"github.com/line/ostracon/crypto/tmhash"
+
+	"github.com/line/lfb-sdk/client"
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+)
+
+// DefaultSequenceRetries is the default number of times a transaction is signed
+// and broadcast again after it was rejected for a wrong sequence.
+const DefaultSequenceRetries = 3
+
+// expectedSequenceRegex matches the expected sequence in the log of a transaction
+// rejected with ErrWrongSequence.
+var expectedSequenceRegex = regexp.MustCompile(`expected (\d+), got \d+`)
+
+// SequenceManager signs and broadcasts transactions of a single account, from
+// any number of goroutines, allocating account sequences locally instead of
+// querying them for every transaction.
+//
+// The lock of the manager is only held to reserve a sequence, transactions are
+// signed and broadcast in sync mode concurrently. As they may reach CheckTx out
+// of order, a transaction rejected for a wrong sequence while transactions with
+// lower sequences are in flight is broadcast again once they are done. The
+// sequence is only consumed by transactions passing CheckTx. When a transaction
+// is rejected for a wrong sequence or sig block height for another reason, e.g.
+// because the account was used from elsewhere or an earlier transaction failed,
+// the sequence and sig block height are synced again and the transaction is
+// signed with a new sequence and broadcast again.
+type SequenceManager struct {
+	clientCtx  client.Context
+	maxRetries int
+
+	mtx    sync.Mutex
+	done   *sync.Cond // signaled when a transaction in flight is done
+	txf    Factory
+	synced bool
+	epoch  uint64 // incremented on every sync
+
+	inFlight map[uint64]int // the number of transactions in flight by sequence
+	finished uint64         // the number of transactions done so far
+}
+
+// reservation is a sequence reserved for a transaction.
+type reservation struct {
+	txf   Factory
+	epoch uint64
+}
+
+// NewSequenceManager creates a SequenceManager for the from account of the
+// given client context. The sequence and sig block height of txf are ignored,
+// they are synced through the account retriever before the first transaction.
+func NewSequenceManager(clientCtx client.Context, txf Factory) *SequenceManager {
+	m := &SequenceManager{
+		clientCtx:  clientCtx,
+		maxRetries: DefaultSequenceRetries,
+		txf:        txf,
+		inFlight:   make(map[uint64]int),
+	}
+	m.done = sync.NewCond(&m.mtx)
+
+	return m
+}
+
+// SetMaxRetries sets the number of times a transaction rejected for a wrong
+// sequence or sig block height is signed and broadcast again.
+func (m *SequenceManager) SetMaxRetries(maxRetries int) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.maxRetries = maxRetries
+}
+
+// Sequence returns the sequence the next transaction will be signed with.
+func (m *SequenceManager) Sequence() uint64 {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return m.txf.Sequence()
+}
+
+// Sync queries the sequence and the latest block height, which is used as sig
+// block height of the next transactions. As the sig block height is only valid
+// for a limited number of blocks, long running services should sync regularly.
+func (m *SequenceManager) Sync() error {
+	m.mtx.Lock()
+	epoch := m.epoch
+	m.mtx.Unlock()
+
+	return m.sync(epoch, 0)
+}
+
+// sync queries the sequence and the latest block height without holding the
+// lock. The result is dropped if the manager was synced since the given epoch
+// meanwhile. The sequence is raised to minSeq if it is lower.
+func (m *SequenceManager) sync(epoch, minSeq uint64) error {
+	m.mtx.Lock()
+	txf := m.txf
+	m.mtx.Unlock()
+
+	txf, err := PrepareFactory(m.clientCtx, txf.WithSequence(0).WithSigBlockHeight(0))
+	if err != nil {
+		return err
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.epoch != epoch {
+		return nil
+	}
+
+	if minSeq > txf.Sequence() {
+		txf = txf.WithSequence(minSeq)
+	}
+	m.txf = txf
+	m.synced = true
+	m.epoch++
+	return nil
+}
+
+// reserve syncs the manager if needed and reserves the next sequence.
+func (m *SequenceManager) reserve() (reservation, error) {
+	m.mtx.Lock()
+	for !m.synced {
+		epoch := m.epoch
+		m.mtx.Unlock()
+		if err := m.sync(epoch, 0); err != nil {
+			return reservation{}, err
+		}
+		m.mtx.Lock()
+	}
+	defer m.mtx.Unlock()
+
+	r := reservation{txf: m.txf, epoch: m.epoch}
+	m.txf = m.txf.WithSequence(r.txf.Sequence() + 1)
+	m.inFlight[r.txf.Sequence()]++
+	return r, nil
+}
+
+// release marks the transaction of a reservation as done. If it didn't
+// consume its sequence, the sequence is reused by the next transaction when
+// no later one was reserved.
+func (m *SequenceManager) release(r reservation, consumed bool) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	seq := r.txf.Sequence()
+	if !consumed && m.epoch == r.epoch && m.txf.Sequence() == seq+1 {
+		m.txf = m.txf.WithSequence(seq)
+	}
+	m.finish(seq)
+}
+
+// invalidate marks the transaction of a reservation as done when it's unknown
+// whether it consumed its sequence, so the manager is synced again.
+func (m *SequenceManager) invalidate(r reservation) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.epoch == r.epoch {
+		m.synced = false
+	}
+	m.finish(r.txf.Sequence())
+}
+
+func (m *SequenceManager) finish(seq uint64) {
+	if m.inFlight[seq]--; m.inFlight[seq] == 0 {
+		delete(m.inFlight, seq)
+	}
+	m.finished++
+	m.done.Broadcast()
+}
+
+// BroadcastTx signs a transaction with the given messages using the next
+// sequence and broadcasts it in sync mode. It returns the CheckTx response,
+// and the pending transaction which can be waited for with
+// client.Context.WaitForTxs. If the transaction fails CheckTx, the response
+// code is set and its sequence is used by the next transaction.
+// An error is returned if the transaction can't be signed or broadcast.
+func (m *SequenceManager) BroadcastTx(msgs ...sdk.Msg) (*sdk.TxResponse, client.PendingTx, error) {
+	m.mtx.Lock()
+	maxRetries := m.maxRetries
+	m.mtx.Unlock()
+
+	for attempt := 0; ; attempt++ {
+		r, err := m.reserve()
+		if err != nil {
+			return nil, client.PendingTx{}, err
+		}
+
+		txBytes, err := m.signTx(r.txf, msgs...)
+		if err != nil {
+			m.release(r, false)
+			return nil, client.PendingTx{}, err
+		}
+
+		pending := client.PendingTx{
+			Hash:           fmt.Sprintf("%X", tmhash.Sum(txBytes)),
+			SigBlockHeight: r.txf.SigBlockHeight(),
+		}
+
+		res, err := m.broadcastTx(r, txBytes)
+		if err != nil {
+			// the transaction may have been received, so the sequence is unknown
+			m.invalidate(r)
+			return nil, pending, err
+		}
+
+		m.release(r, res.Code == 0)
+		if res.Code == 0 || attempt >= maxRetries || res.Codespace != sdkerrors.RootCodespace {
+			return res, pending, nil
+		}
+
+		switch res.Code {
+		case sdkerrors.ErrWrongSequence.ABCICode():
+			// the queried sequence lags behind the mempool if transactions are pending
+			seq, _ := expectedSequence(res.RawLog)
+			if err := m.sync(r.epoch, seq); err != nil {
+				return nil, pending, err
+			}
+
+		case sdkerrors.ErrInvalidSigBlockHeight.ABCICode():
+			if err := m.sync(r.epoch, 0); err != nil {
+				return nil, pending, err
+			}
+
+		default:
+			return res, pending, nil
+		}
+	}
+}
+
+// broadcastTx broadcasts a signed transaction in sync mode. If it is rejected
+// because a transaction with a lower sequence hasn't reached CheckTx yet, it is
+// broadcast again once that one is done.
+func (m *SequenceManager) broadcastTx(r reservation, txBytes []byte) (*sdk.TxResponse, error) {
+	seq := r.txf.Sequence()
+	for {
+		m.mtx.Lock()
+		finished := m.finished
+		m.mtx.Unlock()
+
+		res, err := m.clientCtx.BroadcastTxSync(txBytes)
+		if err != nil || res.Codespace != sdkerrors.RootCodespace || res.Code != sdkerrors.ErrWrongSequence.ABCICode() {
+			return res, err
+		}
+
+		if expected, ok := expectedSequence(res.RawLog); !ok || expected >= seq || !m.waitForEarlier(seq, finished) {
+			return res, nil
+		}
+	}
+}
+
+// waitForEarlier waits until no transaction with a lower sequence than seq is
+// in flight. It returns false if there was none and no transaction was done
+// since finished, so broadcasting again is pointless.
+func (m *SequenceManager) waitForEarlier(seq, finished uint64) bool {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	waited := m.finished != finished
+	for m.hasEarlier(seq) {
+		m.done.Wait()
+		waited = true
+	}
+	return waited
+}
+
+func (m *SequenceManager) hasEarlier(seq uint64) bool {
+	for s := range m.inFlight {
+		if s < seq {
+			return true
+		}
+	}
+	return false
+}
+
+// signTx builds and signs a transaction with the reserved sequence.
+func (m *SequenceManager) signTx(txf Factory, msgs ...sdk.Msg) ([]byte, error) {
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := CalculateGas(m.clientCtx.QueryWithData, txf, msgs...)
+		if err != nil {
+			return nil, err
+		}
+		txf = txf.WithGas(adjusted)
+	}
+
+	tx, err := BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.SetFeeGranter(m.clientCtx.GetFeeGranterAddress())
+
+	if err := Sign(txf, m.clientCtx.GetFromName(), tx, true); err != nil {
+		return nil, err
+	}
+
+	return m.clientCtx.TxConfig.TxEncoder()(tx.GetTx())
+}
+
+// expectedSequence parses the expected sequence from the log of a transaction
+// rejected with ErrWrongSequence.
+func expectedSequence(log string) (uint64, bool) {
+	matches := expectedSequenceRegex.FindStringSubmatch(log)
+	if matches == nil {
+		return 0, false
+	}
+
+	seq, err := strconv.ParseUint(matches[1], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return seq, true
+}
@@ -0,0 +1,234 @@
+package tx_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/line/ostracon/crypto/tmhash"
+	"github.com/line/ostracon/rpc/client/mock"
+	ctypes "github.com/line/ostracon/rpc/core/types"
+	osttypes "github.com/line/ostracon/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/client"
+	"github.com/line/lfb-sdk/client/tx"
+	"github.com/line/lfb-sdk/crypto/hd"
+	"github.com/line/lfb-sdk/crypto/keyring"
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	"github.com/line/lfb-sdk/x/auth/signing"
+	banktypes "github.com/line/lfb-sdk/x/bank/types"
+)
+
+// mockMempool checks the sequence of broadcast transactions like the ante
+// handler does in CheckTx, and rejects sends to the "fail" address.
+type mockMempool struct {
+	mock.Client
+
+	txConfig client.TxConfig
+	received chan struct{} // if set, signaled when a broadcast is received
+	release  chan struct{} // if set, a broadcast waits for it before CheckTx
+
+	mtx        sync.Mutex
+	committed  uint64 // the sequence returned by the account retriever
+	sequence   uint64 // the sequence in the check state
+	sequences  []uint64
+	broadcasts int
+}
+
+func (m *mockMempool) BroadcastTxSync(_ context.Context, txBytes osttypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	if m.received != nil {
+		m.received <- struct{}{}
+		<-m.release
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.broadcasts++
+
+	decoded, err := m.txConfig.TxDecoder()(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	sigs, err := decoded.(signing.SigVerifiableTx).GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ctypes.ResultBroadcastTx{Hash: tmhash.Sum(txBytes)}
+	switch {
+	case sigs[0].Sequence != m.sequence:
+		res.Code = sdkerrors.ErrWrongSequence.ABCICode()
+		res.Codespace = sdkerrors.ErrWrongSequence.Codespace()
+		res.Log = fmt.Sprintf("account sequence mismatch, expected %d, got %d: incorrect account sequence", m.sequence, sigs[0].Sequence)
+	case decoded.GetMsgs()[0].(*banktypes.MsgSend).ToAddress == testAddr("fail").String():
+		res.Code = sdkerrors.ErrInsufficientFunds.ABCICode()
+		res.Codespace = sdkerrors.ErrInsufficientFunds.Codespace()
+	default:
+		m.sequences = append(m.sequences, m.sequence)
+		m.sequence++
+	}
+
+	return res, nil
+}
+
+// useElsewhere simulates the account sending a transaction from another client
+func (m *mockMempool) useElsewhere() {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.sequence++
+}
+
+func (m *mockMempool) GetAccountSequence(client.Context, sdk.AccAddress) (uint64, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	return m.committed, nil
+}
+
+func (m *mockMempool) GetLatestHeight(client.Context) (uint64, error) {
+	return 1, nil
+}
+
+type mockRetriever struct {
+	client.TestAccountRetriever
+	*mockMempool
+}
+
+func (r mockRetriever) GetAccountSequence(clientCtx client.Context, addr sdk.AccAddress) (uint64, error) {
+	return r.mockMempool.GetAccountSequence(clientCtx, addr)
+}
+
+func (r mockRetriever) GetLatestHeight(clientCtx client.Context) (uint64, error) {
+	return r.mockMempool.GetLatestHeight(clientCtx)
+}
+
+func newSequenceManager(t *testing.T, committed uint64) (*tx.SequenceManager, *mockMempool) {
+	txConfig := NewTestTxConfig()
+	kr := keyring.NewInMemory()
+	info, _, err := kr.NewMnemonic("alice", keyring.English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+
+	mempool := &mockMempool{txConfig: txConfig, committed: committed, sequence: committed}
+	clientCtx := client.Context{}.
+		WithTxConfig(txConfig).
+		WithClient(mempool).
+		WithAccountRetriever(mockRetriever{mockMempool: mempool}).
+		WithKeyring(kr).
+		WithFromName("alice").
+		WithFromAddress(info.GetAddress())
+	txf := tx.Factory{}.
+		WithTxConfig(txConfig).
+		WithAccountRetriever(clientCtx.AccountRetriever).
+		WithKeybase(kr).
+		WithChainID("test-chain").
+		WithGas(100000).
+		WithSignMode(txConfig.SignModeHandler().DefaultMode())
+
+	return tx.NewSequenceManager(clientCtx, txf), mempool
+}
+
+func testAddr(name string) sdk.AccAddress {
+	return sdk.AccAddress(fmt.Sprintf("%-20s", name))
+}
+
+func sendMsg(to string) sdk.Msg {
+	return banktypes.NewMsgSend(testAddr("from"), testAddr(to), sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+}
+
+func TestSequenceManagerConcurrent(t *testing.T) {
+	m, mempool := newSequenceManager(t, 5)
+
+	const n = 50
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for i := 0; i < n; i++ {
+		go func() {
+			defer wg.Done()
+			res, pending, err := m.BroadcastTx(sendMsg("to"))
+			if assert.NoError(t, err) {
+				assert.Equal(t, uint32(0), res.Code)
+				assert.Equal(t, res.TxHash, pending.Hash)
+				assert.Equal(t, uint64(1), pending.SigBlockHeight)
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.Len(t, mempool.sequences, n)
+	for i, seq := range mempool.sequences {
+		require.Equal(t, uint64(5+i), seq)
+	}
+	// transactions reaching CheckTx before an earlier one are broadcast again
+	require.GreaterOrEqual(t, mempool.broadcasts, n)
+	require.Equal(t, uint64(5+n), m.Sequence())
+}
+
+func TestSequenceManagerBroadcastOutsideLock(t *testing.T) {
+	m, mempool := newSequenceManager(t, 0)
+	mempool.received = make(chan struct{})
+	mempool.release = make(chan struct{})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _, err := m.BroadcastTx(sendMsg("to"))
+		assert.NoError(t, err)
+	}()
+
+	// the sequence is reserved while the transaction is being broadcast
+	<-mempool.received
+	require.Equal(t, uint64(1), m.Sequence())
+
+	close(mempool.release)
+	<-done
+	require.Equal(t, []uint64{0}, mempool.sequences)
+	require.Equal(t, uint64(1), m.Sequence())
+}
+
+func TestSequenceManagerFailedCheckTx(t *testing.T) {
+	m, mempool := newSequenceManager(t, 0)
+
+	res, _, err := m.BroadcastTx(sendMsg("to"))
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.Code)
+
+	// a failed tx doesn't consume its sequence and isn't retried
+	res, _, err = m.BroadcastTx(sendMsg("fail"))
+	require.NoError(t, err)
+	require.True(t, sdkerrors.ErrInsufficientFunds.Is(sdkerrors.ABCIError(res.Codespace, res.Code, res.RawLog)))
+	require.Equal(t, uint64(1), m.Sequence())
+
+	res, _, err = m.BroadcastTx(sendMsg("to"))
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.Code)
+	require.Equal(t, []uint64{0, 1}, mempool.sequences)
+	require.Equal(t, 3, mempool.broadcasts)
+}
+
+func TestSequenceManagerWrongSequence(t *testing.T) {
+	m, mempool := newSequenceManager(t, 0)
+
+	_, _, err := m.BroadcastTx(sendMsg("to"))
+	require.NoError(t, err)
+
+	// the account is used by another client, the tx is signed again with the
+	// sequence expected by the mempool, which is ahead of the committed one
+	mempool.useElsewhere()
+	res, _, err := m.BroadcastTx(sendMsg("to"))
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.Code)
+	require.Equal(t, []uint64{0, 2}, mempool.sequences)
+	require.Equal(t, 3, mempool.broadcasts)
+	require.Equal(t, uint64(3), m.Sequence())
+
+	// retries are limited
+	m.SetMaxRetries(0)
+	mempool.useElsewhere()
+	res, _, err = m.BroadcastTx(sendMsg("to"))
+	require.NoError(t, err)
+	require.True(t, sdkerrors.ErrWrongSequence.Is(sdkerrors.ABCIError(res.Codespace, res.Code, res.RawLog)))
+	require.Equal(t, 4, mempool.broadcasts)
+}
@@ -1,10 +1,14 @@
 package tx_test
 
 import (
+	"context"
 	"errors"
+	"net"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
 
 	"github.com/line/lfb-sdk/client"
 	"github.com/line/lfb-sdk/client/tx"
@@ -220,6 +224,64 @@ func TestSign(t *testing.T) {
 	}
 }
 
+func TestSignWithRemoteKeyring(t *testing.T) {
+	requireT := require.New(t)
+	signer := keyring.NewInMemory()
+	info, _, err := signer.NewMnemonic("remote_key", keyring.English, hd.CreateHDPath(118, 0, 0).String(), hd.Secp256k1)
+	requireT.NoError(err)
+
+	kr := newRemoteKeyring(t, signer)
+
+	txConfig := NewTestTxConfig()
+	txf := tx.Factory{}.
+		WithTxConfig(txConfig).
+		WithKeybase(kr).
+		WithSigBlockHeight(1).
+		WithSequence(23).
+		WithFees("50stake").
+		WithChainID("test-chain")
+	msg := banktypes.NewMsgSend(info.GetAddress(), sdk.AccAddress("to"), nil)
+
+	for _, signMode := range []signingtypes.SignMode{
+		signingtypes.SignMode_SIGN_MODE_DIRECT,
+		signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+	} {
+		t.Run(signMode.String(), func(t *testing.T) {
+			txb, err := tx.BuildUnsignedTx(txf, msg)
+			requireT.NoError(err)
+			requireT.NoError(tx.Sign(txf.WithSignMode(signMode), "remote_key", txb, true))
+
+			sigs := testSigners(requireT, txb.GetTx(), info.GetPubKey())
+			signBytes, err := txConfig.SignModeHandler().GetSignBytes(signMode, signing.SignerData{
+				ChainID:  "test-chain",
+				Sequence: 23,
+			}, txb.GetTx())
+			requireT.NoError(err)
+			sig := sigs[0].Data.(*signingtypes.SingleSignatureData).Signature
+			requireT.True(info.GetPubKey().VerifySignature(signBytes, sig))
+		})
+	}
+}
+
+// newRemoteKeyring serves the keys of signer over an in-process gRPC connection
+// and returns a remote keyring using them.
+func newRemoteKeyring(t *testing.T, signer keyring.Keyring) keyring.Keyring {
+	lis := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	keyring.RegisterRemoteSignerServer(server, keyring.NewRemoteSignerServer(signer))
+	go server.Serve(lis) // nolint: errcheck
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithInsecure(),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return keyring.NewRemote(conn)
+}
+
 func testSigners(require *require.Assertions, tr signing.Tx, pks ...cryptotypes.PubKey) []signingtypes.SignatureV2 {
 	sigs, err := tr.GetSignaturesV2()
 	require.Len(sigs, len(pks))
@@ -0,0 +1,230 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tmrand "github.com/line/ostracon/libs/rand"
+	ctypes "github.com/line/ostracon/rpc/core/types"
+	osttypes "github.com/line/ostracon/types"
+
+	codectypes "github.com/line/lfb-sdk/codec/types"
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+)
+
+// DefaultTxWaitPollInterval is the interval at which new blocks are looked for
+// when the RPC client isn't subscribed to events.
+const DefaultTxWaitPollInterval = time.Second
+
+// PendingTx is a broadcast transaction waiting to be included in a block.
+type PendingTx struct {
+	// Hash is the hex encoded transaction hash, as returned in TxResponse.TxHash.
+	Hash string
+	// SigBlockHeight is the sig block height the transaction was signed with.
+	SigBlockHeight uint64
+}
+
+// TxWaitResult is the outcome of waiting for a PendingTx.
+type TxWaitResult struct {
+	TxHash string
+	// TxResponse is the result of the transaction once committed, whether it
+	// succeeded or not.
+	TxResponse *sdk.TxResponse
+	// Err is set if the transaction can't be included anymore because its sig
+	// block height is out of the valid period, or if the waiting was cancelled.
+	Err error
+}
+
+// WaitForTx waits until the given transaction is committed in a block. See WaitForTxs.
+func (ctx Context) WaitForTx(goCtx context.Context, validSigBlockPeriod uint64, tx PendingTx) (*sdk.TxResponse, error) {
+	res, err := ctx.WaitForTxs(goCtx, validSigBlockPeriod, []PendingTx{tx})
+	if err != nil {
+		return nil, err
+	}
+
+	return res[0].TxResponse, res[0].Err
+}
+
+// WaitForTxs waits until each of the given transactions is committed in a block,
+// or can't be included anymore, and returns their results in the same order.
+//
+// Rather than querying every transaction, blocks are scanned from the lowest sig
+// block height on, as a transaction can't be included before its sig block height.
+// A transaction isn't waited for anymore once the chain is past its sig block
+// height plus validSigBlockPeriod, which is the ValidSigBlockPeriod parameter of
+// x/auth. New blocks are learnt of through a subscription to the node's events
+// if the RPC client is running, and by polling otherwise. Waiting for the
+// remaining transactions is given up when goCtx is done.
+//
+// The returned error is only set if a transaction has no sig block height, as
+// it would have to be looked for from the first block on, or if the node can't
+// be queried.
+func (ctx Context) WaitForTxs(goCtx context.Context, validSigBlockPeriod uint64, txs []PendingTx) ([]TxWaitResult, error) {
+	for _, tx := range txs {
+		if tx.SigBlockHeight == 0 {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidSigBlockHeight, "tx %s has no sig block height", tx.Hash)
+		}
+	}
+
+	node, err := ctx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]TxWaitResult, len(txs))
+	// transactions are tracked by hash, the same one may be waited for several times
+	pending := make(map[string][]int, len(txs))
+	next := int64(0)
+	for i, tx := range txs {
+		hash := strings.ToUpper(tx.Hash)
+		results[i].TxHash = hash
+		pending[hash] = append(pending[hash], i)
+		if next == 0 || int64(tx.SigBlockHeight) < next {
+			next = int64(tx.SigBlockHeight)
+		}
+	}
+	if len(pending) == 0 {
+		return results, nil
+	}
+
+	newBlocks, unsubscribe := ctx.subscribeNewBlocks(goCtx)
+	defer unsubscribe()
+
+	ticker := time.NewTicker(DefaultTxWaitPollInterval)
+	defer ticker.Stop()
+
+	for {
+		status, err := node.Status(goCtx)
+		if err != nil && goCtx.Err() == nil {
+			return nil, err
+		}
+
+		if err == nil {
+			latest := status.SyncInfo.LatestBlockHeight
+			for ; next <= latest && goCtx.Err() == nil; next++ {
+				if err := ctx.scanBlockForTxs(goCtx, next, pending, results); err != nil && goCtx.Err() == nil {
+					return nil, err
+				}
+			}
+
+			for hash, indexes := range pending {
+				expiry := txs[indexes[0]].SigBlockHeight + validSigBlockPeriod
+				if next > int64(expiry) {
+					for _, i := range indexes {
+						results[i].Err = sdkerrors.Wrapf(sdkerrors.ErrInvalidSigBlockHeight,
+							"tx %s was not included up to height %d", hash, expiry)
+					}
+					delete(pending, hash)
+				}
+			}
+		}
+
+		if len(pending) == 0 {
+			return results, nil
+		}
+
+		select {
+		case <-goCtx.Done():
+			for _, indexes := range pending {
+				for _, i := range indexes {
+					results[i].Err = goCtx.Err()
+				}
+			}
+			return results, nil
+		case <-newBlocks:
+		case <-ticker.C:
+		}
+	}
+}
+
+// scanBlockForTxs resolves the pending transactions included in the block at the given height.
+func (ctx Context) scanBlockForTxs(goCtx context.Context, height int64, pending map[string][]int, results []TxWaitResult) error {
+	node, err := ctx.GetNode()
+	if err != nil {
+		return err
+	}
+
+	block, err := node.Block(goCtx, &height)
+	if err != nil {
+		return err
+	}
+
+	var blockResults *ctypes.ResultBlockResults
+	for index, tx := range block.Block.Data.Txs {
+		hash := fmt.Sprintf("%X", tx.Hash())
+		indexes, ok := pending[hash]
+		if !ok {
+			continue
+		}
+
+		if blockResults == nil {
+			if blockResults, err = node.BlockResults(goCtx, &height); err != nil {
+				return err
+			}
+			if len(blockResults.TxsResults) != len(block.Block.Data.Txs) {
+				return fmt.Errorf("block %d has %d txs but %d results", height, len(block.Block.Data.Txs), len(blockResults.TxsResults))
+			}
+		}
+
+		resTx := &ctypes.ResultTx{
+			Hash:     tx.Hash(),
+			Height:   height,
+			Index:    uint32(index),
+			TxResult: *blockResults.TxsResults[index],
+			Tx:       tx,
+		}
+		txResponse, err := ctx.makeTxResponse(resTx, block)
+		if err != nil {
+			return err
+		}
+		for _, i := range indexes {
+			results[i].TxResponse = txResponse
+		}
+		delete(pending, hash)
+	}
+
+	return nil
+}
+
+func (ctx Context) makeTxResponse(resTx *ctypes.ResultTx, block *ctypes.ResultBlock) (*sdk.TxResponse, error) {
+	timestamp := block.Block.Time.Format(time.RFC3339)
+
+	// the tx is left out of the response if there's no tx config to decode it
+	var anyTx *codectypes.Any
+	if ctx.TxConfig != nil {
+		txb, err := ctx.TxConfig.TxDecoder()(resTx.Tx)
+		if err != nil {
+			return nil, err
+		}
+		if p, ok := txb.(interface{ AsAny() *codectypes.Any }); ok {
+			anyTx = p.AsAny()
+		}
+	}
+
+	return sdk.NewResponseResultTx(resTx, anyTx, timestamp), nil
+}
+
+// subscribeNewBlocks subscribes to new block headers if the RPC client is running,
+// e.g. an HTTP client whose websocket has been started. Otherwise the returned
+// channel never fires.
+func (ctx Context) subscribeNewBlocks(goCtx context.Context) (<-chan ctypes.ResultEvent, func()) {
+	noop := func() {}
+	if !ctx.Client.IsRunning() {
+		return nil, noop
+	}
+
+	subscriber := fmt.Sprintf("tx-waiter-%s", tmrand.Str(8))
+	query := osttypes.EventQueryNewBlockHeader.String()
+	out, err := ctx.Client.Subscribe(goCtx, subscriber, query)
+	if err != nil {
+		// fall back to polling
+		return nil, noop
+	}
+
+	return out, func() {
+		_ = ctx.Client.Unsubscribe(context.Background(), subscriber, query)
+	}
+}
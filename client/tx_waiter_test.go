@@ -0,0 +1,167 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	abci "github.com/line/ostracon/abci/types"
+	"github.com/line/ostracon/rpc/client/mock"
+	ctypes "github.com/line/ostracon/rpc/core/types"
+	osttypes "github.com/line/ostracon/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/client"
+	"github.com/line/lfb-sdk/simapp"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+)
+
+// mockChain is a RPC client serving blocks appended while waiting
+type mockChain struct {
+	mock.Client
+
+	mtx     sync.Mutex
+	blocks  []osttypes.Txs
+	results [][]*abci.ResponseDeliverTx
+}
+
+func (c *mockChain) addBlock(txs ...[]byte) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	block := make(osttypes.Txs, len(txs))
+	results := make([]*abci.ResponseDeliverTx, len(txs))
+	for i, tx := range txs {
+		block[i] = tx
+		results[i] = &abci.ResponseDeliverTx{Code: uint32(i), Log: "[]"}
+	}
+	c.blocks = append(c.blocks, block)
+	c.results = append(c.results, results)
+}
+
+func (c *mockChain) IsRunning() bool {
+	return false
+}
+
+func (c *mockChain) Status(context.Context) (*ctypes.ResultStatus, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return &ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{LatestBlockHeight: int64(len(c.blocks))}}, nil
+}
+
+func (c *mockChain) Block(_ context.Context, height *int64) (*ctypes.ResultBlock, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if *height < 1 || *height > int64(len(c.blocks)) {
+		return nil, fmt.Errorf("height %d is not available", *height)
+	}
+
+	block := &osttypes.Block{Data: osttypes.Data{Txs: c.blocks[*height-1]}}
+	block.Header.Height = *height
+	block.Header.Time = time.Unix(*height, 0).UTC()
+	return &ctypes.ResultBlock{Block: block}, nil
+}
+
+func (c *mockChain) BlockResults(_ context.Context, height *int64) (*ctypes.ResultBlockResults, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return &ctypes.ResultBlockResults{Height: *height, TxsResults: c.results[*height-1]}, nil
+}
+
+func mockTx(t *testing.T, txConfig client.TxConfig, memo string) ([]byte, string) {
+	builder := txConfig.NewTxBuilder()
+	builder.SetMemo(memo)
+	bz, err := txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	return bz, fmt.Sprintf("%X", osttypes.Tx(bz).Hash())
+}
+
+func TestWaitForTxs(t *testing.T) {
+	txConfig := simapp.MakeTestEncodingConfig().TxConfig
+	chain := &mockChain{}
+	clientCtx := client.Context{}.WithClient(chain).WithTxConfig(txConfig)
+
+	tx1, hash1 := mockTx(t, txConfig, "tx1")
+	tx2, hash2 := mockTx(t, txConfig, "tx2")
+	_, hash3 := mockTx(t, txConfig, "tx3")
+	other, _ := mockTx(t, txConfig, "other")
+
+	// tx1 was already included before waiting
+	chain.addBlock()
+	chain.addBlock(other, tx1)
+	go func() {
+		for i := 0; i < 4; i++ {
+			time.Sleep(100 * time.Millisecond)
+			if i == 1 {
+				chain.addBlock(tx2)
+			} else {
+				chain.addBlock()
+			}
+		}
+	}()
+
+	results, err := clientCtx.WaitForTxs(context.Background(), 3, []client.PendingTx{
+		{Hash: hash1, SigBlockHeight: 1},
+		{Hash: hash2, SigBlockHeight: 2},
+		{Hash: hash3, SigBlockHeight: 2},
+		// the same tx can be waited for twice
+		{Hash: hash1, SigBlockHeight: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+
+	require.NoError(t, results[0].Err)
+	require.Equal(t, hash1, results[0].TxHash)
+	require.Equal(t, int64(2), results[0].TxResponse.Height)
+	require.Equal(t, uint32(1), results[0].TxResponse.Code)
+	require.Equal(t, time.Unix(2, 0).UTC().Format(time.RFC3339), results[0].TxResponse.Timestamp)
+	require.NotNil(t, results[0].TxResponse.Tx)
+	require.Equal(t, results[0], results[3])
+
+	require.NoError(t, results[1].Err)
+	require.Equal(t, int64(4), results[1].TxResponse.Height)
+	require.Equal(t, uint32(0), results[1].TxResponse.Code)
+
+	// tx3 can't be included after height 5
+	require.Nil(t, results[2].TxResponse)
+	require.True(t, sdkerrors.ErrInvalidSigBlockHeight.Is(results[2].Err))
+}
+
+func TestWaitForTxCancelled(t *testing.T) {
+	txConfig := simapp.MakeTestEncodingConfig().TxConfig
+	chain := &mockChain{}
+	chain.addBlock()
+	clientCtx := client.Context{}.WithClient(chain).WithTxConfig(txConfig)
+	_, hash := mockTx(t, txConfig, "tx")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	res, err := clientCtx.WaitForTx(ctx, 100, client.PendingTx{Hash: hash, SigBlockHeight: 1})
+	require.Nil(t, res)
+	require.Equal(t, context.DeadlineExceeded, err)
+
+	_, err = client.Context{}.WaitForTx(ctx, 100, client.PendingTx{Hash: hash, SigBlockHeight: 1})
+	require.Error(t, err)
+}
+
+func TestWaitForTxsWithoutSigBlockHeight(t *testing.T) {
+	txConfig := simapp.MakeTestEncodingConfig().TxConfig
+	chain := &mockChain{}
+	chain.addBlock()
+	clientCtx := client.Context{}.WithClient(chain).WithTxConfig(txConfig)
+	_, hash1 := mockTx(t, txConfig, "tx1")
+	_, hash2 := mockTx(t, txConfig, "tx2")
+
+	// no block is scanned from the first one on for a tx without sig block height
+	results, err := clientCtx.WaitForTxs(context.Background(), 100, []client.PendingTx{
+		{Hash: hash1, SigBlockHeight: 1},
+		{Hash: hash2},
+	})
+	require.Nil(t, results)
+	require.True(t, sdkerrors.ErrInvalidSigBlockHeight.Is(err))
+}
@@ -6,6 +6,8 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
+	"time"
 )
 
 const (
@@ -13,6 +15,11 @@ const (
 	genesisDir  = "genesis"
 	upgradesDir = "upgrades"
 	currentLink = "current"
+	dataDir     = "data"
+	backupDir   = "backup"
+
+	// defaultPollInterval is how often the upgrade info file is checked by default
+	defaultPollInterval = 300 * time.Millisecond
 )
 
 // Config is the information passed in to control the daemon
@@ -21,6 +28,10 @@ type Config struct {
 	Name                  string
 	AllowDownloadBinaries bool
 	RestartAfterUpgrade   bool
+	PollInterval          time.Duration
+	BackupData            bool
+	PreUpgradeCmd         string
+	PreUpgradeMaxRetries  int
 }
 
 // Root returns the root directory where all info lives
@@ -44,6 +55,22 @@ func (cfg *Config) UpgradeDir(upgradeName string) string {
 	return filepath.Join(cfg.Root(), upgradesDir, safeName)
 }
 
+// UpgradeInfoFilePath is the path to the upgrade info file the daemon writes
+// into its data directory when it halts for an upgrade
+func (cfg *Config) UpgradeInfoFilePath() string {
+	return filepath.Join(cfg.Home, dataDir, upgradeInfoFileName)
+}
+
+// DataDir is the data directory of the daemon
+func (cfg *Config) DataDir() string {
+	return filepath.Join(cfg.Home, dataDir)
+}
+
+// BackupDir is the directory holding the backup of the data directory taken before the last upgrade
+func (cfg *Config) BackupDir() string {
+	return filepath.Join(cfg.Root(), backupDir)
+}
+
 // Symlink to genesis
 func (cfg *Config) SymLinkToGenesis() (string, error) {
 	genesis := filepath.Join(cfg.Root(), genesisDir)
@@ -83,6 +110,22 @@ func (cfg *Config) CurrentBin() (string, error) {
 	return filepath.Join(dest, "bin", cfg.Name), nil
 }
 
+// CurrentUpgradeName returns the name of the upgrade the current link points to,
+// or an empty string if it points to genesis or is not set
+func (cfg *Config) CurrentUpgradeName() string {
+	dest, err := os.Readlink(filepath.Join(cfg.Root(), currentLink))
+	if err != nil || filepath.Dir(dest) != filepath.Join(cfg.Root(), upgradesDir) {
+		return ""
+	}
+
+	name, err := url.PathUnescape(filepath.Base(dest))
+	if err != nil {
+		return ""
+	}
+
+	return name
+}
+
 // GetConfigFromEnv will read the environmental variables into a config
 // and then validate it is reasonable
 func GetConfigFromEnv() (*Config, error) {
@@ -99,6 +142,28 @@ func GetConfigFromEnv() (*Config, error) {
 		cfg.RestartAfterUpgrade = true
 	}
 
+	if interval := os.Getenv("DAEMON_POLL_INTERVAL"); interval != "" {
+		d, err := time.ParseDuration(interval)
+		if err != nil {
+			return nil, fmt.Errorf("invalid DAEMON_POLL_INTERVAL: %w", err)
+		}
+		cfg.PollInterval = d
+	}
+
+	if os.Getenv("DAEMON_BACKUP_DATA") == "true" {
+		cfg.BackupData = true
+	}
+
+	cfg.PreUpgradeCmd = os.Getenv("DAEMON_PREUPGRADE_CMD")
+
+	if retries := os.Getenv("DAEMON_PREUPGRADE_MAX_RETRIES"); retries != "" {
+		n, err := strconv.Atoi(retries)
+		if err != nil {
+			return nil, fmt.Errorf("invalid DAEMON_PREUPGRADE_MAX_RETRIES: %w", err)
+		}
+		cfg.PreUpgradeMaxRetries = n
+	}
+
 	if err := cfg.validate(); err != nil {
 		return nil, err
 	}
@@ -118,6 +183,14 @@ func (cfg *Config) validate() error {
 		return errors.New("DAEMON_HOME is not set")
 	}
 
+	if cfg.PollInterval < 0 {
+		return errors.New("DAEMON_POLL_INTERVAL must not be negative")
+	}
+
+	if cfg.PreUpgradeMaxRetries < 0 {
+		return errors.New("DAEMON_PREUPGRADE_MAX_RETRIES must not be negative")
+	}
+
 	if !filepath.IsAbs(cfg.Home) {
 		return errors.New("DAEMON_HOME must be an absolute path")
 	}
@@ -0,0 +1,128 @@
+package cosmovisor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/otiai10/copy"
+)
+
+const (
+	// backupInfoFileName is the file describing the backup, stored next to the backed up data
+	backupInfoFileName = "backup-info.json"
+	// privValidatorStateFileName is the file in the data directory holding the last height,
+	// round and step signed by the validator
+	privValidatorStateFileName = "priv_validator_state.json"
+)
+
+// BackupInfo describes the backup of the data directory taken before an upgrade
+type BackupInfo struct {
+	// Upgrade is the name of the upgrade the backup was taken for
+	Upgrade string `json:"upgrade"`
+	Height  int64  `json:"height"`
+	// Previous is the name of the upgrade current pointed to before, empty for genesis
+	Previous string `json:"previous"`
+}
+
+// BackupData copies the data directory of the daemon into the backup directory,
+// replacing the backup of any previous upgrade. It is meant to be called after the
+// daemon halted for the given upgrade and before the current link is switched.
+func BackupData(cfg *Config, info *UpgradeInfo) error {
+	backup := BackupInfo{
+		Upgrade:  info.Name,
+		Height:   info.Height,
+		Previous: cfg.CurrentUpgradeName(),
+	}
+	bz, err := json.Marshal(backup)
+	if err != nil {
+		return err
+	}
+
+	// write the new backup aside, so a failure doesn't lose the previous one
+	tmp := cfg.BackupDir() + ".tmp"
+	if err := os.RemoveAll(tmp); err != nil {
+		return err
+	}
+	if err := copy.Copy(cfg.DataDir(), filepath.Join(tmp, dataDir)); err != nil {
+		return fmt.Errorf("copying %s: %w", cfg.DataDir(), err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(tmp, backupInfoFileName), bz, 0600); err != nil {
+		return err
+	}
+
+	if err := os.RemoveAll(cfg.BackupDir()); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, cfg.BackupDir())
+}
+
+// GetBackupInfo reads the description of the last backup, or returns an error if there is none
+func GetBackupInfo(cfg *Config) (*BackupInfo, error) {
+	bz, err := ioutil.ReadFile(filepath.Join(cfg.BackupDir(), backupInfoFileName))
+	if os.IsNotExist(err) {
+		return nil, errors.New("no backup found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var backup BackupInfo
+	if err := json.Unmarshal(bz, &backup); err != nil {
+		return nil, fmt.Errorf("invalid backup info: %w", err)
+	}
+
+	return &backup, nil
+}
+
+// Rollback restores the data directory from the last backup and points the current
+// link back to the binary which ran before the upgrade. The daemon must not be running.
+// The backup is kept, so rolling back can be repeated.
+//
+// The current priv_validator_state.json is kept rather than restored: the one of the
+// backup is older and would let the validator sign again at heights it already signed.
+func Rollback(cfg *Config) (*BackupInfo, error) {
+	backup, err := GetBackupInfo(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	pvStateFile := filepath.Join(cfg.DataDir(), privValidatorStateFileName)
+	pvState, err := ioutil.ReadFile(pvStateFile)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	if err := os.RemoveAll(cfg.DataDir()); err != nil {
+		return nil, err
+	}
+	copyErr := copy.Copy(filepath.Join(cfg.BackupDir(), dataDir), cfg.DataDir())
+
+	// put the validator state back even if the restore failed, so it is never lost
+	if pvState != nil {
+		if err := os.MkdirAll(cfg.DataDir(), 0700); err != nil {
+			return nil, err
+		}
+		if err := ioutil.WriteFile(pvStateFile, pvState, 0600); err != nil {
+			return nil, fmt.Errorf("keeping %s: %w", pvStateFile, err)
+		}
+	}
+	if copyErr != nil {
+		return nil, fmt.Errorf("restoring %s: %w", cfg.DataDir(), copyErr)
+	}
+
+	if backup.Previous == "" {
+		err = cfg.SetCurrentGenesis()
+	} else {
+		err = cfg.SetCurrentUpgrade(backup.Previous)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return backup, nil
+}
@@ -0,0 +1,172 @@
+// +build linux
+
+package cosmovisor_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/cosmovisor"
+)
+
+func writeDataFile(t *testing.T, cfg *cosmovisor.Config, content string) {
+	require.NoError(t, os.MkdirAll(cfg.DataDir(), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(cfg.DataDir(), "state.db"), []byte(content), 0600))
+}
+
+func readDataFile(t *testing.T, cfg *cosmovisor.Config) string {
+	bz, err := ioutil.ReadFile(filepath.Join(cfg.DataDir(), "state.db"))
+	require.NoError(t, err)
+	return string(bz)
+}
+
+// writeHook creates a pre-upgrade script which exits with the given codes, one per run,
+// and logs the upgrade name it was called with
+func writeHook(t *testing.T, dir string, codes ...int) (string, string) {
+	log := filepath.Join(dir, "hook.log")
+	script := "#!/bin/sh\necho $UPGRADE_NAME $UPGRADE_HEIGHT >> " + log + "\nrun=$(wc -l < " + log + ")\n"
+	for i, code := range codes {
+		script += fmt.Sprintf("[ $run -eq %d ] && exit %d\n", i+1, code)
+	}
+	script += "exit 0\n"
+
+	hook := filepath.Join(dir, "hook.sh")
+	require.NoError(t, ioutil.WriteFile(hook, []byte(script), 0755))
+	return hook, log
+}
+
+func TestBackupAndRollback(t *testing.T) {
+	home := copyTestData(t, "validate")
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd", BackupData: true}
+
+	_, err := cosmovisor.Rollback(cfg)
+	require.Error(t, err)
+
+	// upgrade from genesis to chain2
+	writeDataFile(t, cfg, "genesis state")
+	require.NoError(t, cosmovisor.DoUpgrade(cfg, &cosmovisor.UpgradeInfo{Name: "chain2", Height: 10}))
+	require.Equal(t, "chain2", cfg.CurrentUpgradeName())
+
+	backup, err := cosmovisor.GetBackupInfo(cfg)
+	require.NoError(t, err)
+	require.Equal(t, &cosmovisor.BackupInfo{Upgrade: "chain2", Height: 10, Previous: ""}, backup)
+
+	// the migration corrupts the state, roll back to genesis
+	writeDataFile(t, cfg, "corrupted state")
+	backup, err = cosmovisor.Rollback(cfg)
+	require.NoError(t, err)
+	require.Equal(t, "chain2", backup.Upgrade)
+	require.Equal(t, "genesis state", readDataFile(t, cfg))
+	currentBin, err := cfg.CurrentBin()
+	require.NoError(t, err)
+	require.Equal(t, cfg.GenesisBin(), currentBin)
+
+	// upgrade through chain2 to chain3, only the last backup is kept
+	require.NoError(t, cosmovisor.DoUpgrade(cfg, &cosmovisor.UpgradeInfo{Name: "chain2", Height: 10}))
+	writeDataFile(t, cfg, "chain2 state")
+	require.NoError(t, cosmovisor.DoUpgrade(cfg, &cosmovisor.UpgradeInfo{Name: "chain3", Height: 20}))
+	writeDataFile(t, cfg, "chain3 state")
+
+	backup, err = cosmovisor.Rollback(cfg)
+	require.NoError(t, err)
+	require.Equal(t, &cosmovisor.BackupInfo{Upgrade: "chain3", Height: 20, Previous: "chain2"}, backup)
+	require.Equal(t, "chain2 state", readDataFile(t, cfg))
+	require.Equal(t, "chain2", cfg.CurrentUpgradeName())
+}
+
+func TestRollbackKeepsPrivValidatorState(t *testing.T) {
+	home := copyTestData(t, "validate")
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd", BackupData: true}
+	pvStateFile := filepath.Join(cfg.DataDir(), "priv_validator_state.json")
+
+	writeDataFile(t, cfg, "genesis state")
+	require.NoError(t, ioutil.WriteFile(pvStateFile, []byte(`{"height":"10"}`), 0600))
+	require.NoError(t, cosmovisor.DoUpgrade(cfg, &cosmovisor.UpgradeInfo{Name: "chain2", Height: 10}))
+
+	// the validator signs blocks with the new binary before the rollback
+	writeDataFile(t, cfg, "corrupted state")
+	require.NoError(t, ioutil.WriteFile(pvStateFile, []byte(`{"height":"12"}`), 0600))
+
+	_, err := cosmovisor.Rollback(cfg)
+	require.NoError(t, err)
+	require.Equal(t, "genesis state", readDataFile(t, cfg))
+	bz, err := ioutil.ReadFile(pvStateFile)
+	require.NoError(t, err)
+	require.Equal(t, `{"height":"12"}`, string(bz))
+}
+
+func TestUpgradeWithoutBackup(t *testing.T) {
+	home := copyTestData(t, "validate")
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd"}
+
+	writeDataFile(t, cfg, "genesis state")
+	require.NoError(t, cosmovisor.DoUpgrade(cfg, &cosmovisor.UpgradeInfo{Name: "chain2"}))
+	require.Equal(t, "chain2", cfg.CurrentUpgradeName())
+
+	_, err := os.Stat(cfg.BackupDir())
+	require.True(t, os.IsNotExist(err))
+	_, err = cosmovisor.Rollback(cfg)
+	require.Error(t, err)
+}
+
+func TestPreUpgradeHook(t *testing.T) {
+	cases := map[string]struct {
+		codes      []int
+		maxRetries int
+		expRuns    int
+		expErr     bool
+	}{
+		"success": {
+			expRuns: 1,
+		},
+		"retry then success": {
+			codes:      []int{cosmovisor.PreUpgradeRetryCode, cosmovisor.PreUpgradeRetryCode},
+			maxRetries: 2,
+			expRuns:    3,
+		},
+		"too many retries": {
+			codes:      []int{cosmovisor.PreUpgradeRetryCode, cosmovisor.PreUpgradeRetryCode},
+			maxRetries: 1,
+			expRuns:    2,
+			expErr:     true,
+		},
+		"failure": {
+			codes:      []int{cosmovisor.PreUpgradeRetryCode, 1},
+			maxRetries: 5,
+			expRuns:    2,
+			expErr:     true,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			home := copyTestData(t, "validate")
+			hook, log := writeHook(t, t.TempDir(), tc.codes...)
+			cfg := &cosmovisor.Config{Home: home, Name: "dummyd", PreUpgradeCmd: hook, PreUpgradeMaxRetries: tc.maxRetries}
+
+			err := cosmovisor.DoUpgrade(cfg, &cosmovisor.UpgradeInfo{Name: "chain2", Height: 10})
+			bz, readErr := ioutil.ReadFile(log)
+			require.NoError(t, readErr)
+			runs := ""
+			for i := 0; i < tc.expRuns; i++ {
+				runs += "chain2 10\n"
+			}
+			require.Equal(t, runs, string(bz))
+
+			if tc.expErr {
+				require.Error(t, err)
+				// the current binary is left untouched
+				require.Equal(t, "", cfg.CurrentUpgradeName())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "chain2", cfg.CurrentUpgradeName())
+			}
+		})
+	}
+}
@@ -21,6 +21,10 @@ func Run(args []string) error {
 		return err
 	}
 
+	if len(args) > 0 && args[0] == rollbackCmd {
+		return rollback(cfg)
+	}
+
 	doUpgrade, err := cosmovisor.LaunchProcess(cfg, args, os.Stdout, os.Stderr)
 	// if RestartAfterUpgrade, we launch after a successful upgrade (only condition LaunchProcess returns nil)
 	for cfg.RestartAfterUpgrade && err == nil && doUpgrade {
@@ -28,3 +32,22 @@ func Run(args []string) error {
 	}
 	return err
 }
+
+// rollbackCmd is the argument making cosmovisor roll back the last upgrade instead of launching the daemon
+const rollbackCmd = "rollback"
+
+// rollback restores the data backed up before the last upgrade and switches back to the previous binary
+func rollback(cfg *cosmovisor.Config) error {
+	backup, err := cosmovisor.Rollback(cfg)
+	if err != nil {
+		return fmt.Errorf("rollback failed: %w", err)
+	}
+
+	previous := backup.Previous
+	if previous == "" {
+		previous = "genesis"
+	}
+	fmt.Printf("rolled back upgrade %s at height %d, current binary is now %s\n", backup.Upgrade, backup.Height, previous)
+
+	return nil
+}
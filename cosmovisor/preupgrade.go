@@ -0,0 +1,50 @@
+package cosmovisor
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// PreUpgradeRetryCode is the exit code the pre-upgrade command returns to be run again.
+// Exit code 0 lets the upgrade proceed, any other exit code aborts it.
+const PreUpgradeRetryCode = 31
+
+// RunPreUpgrade runs the configured pre-upgrade command, if any, before switching to the
+// binary of the given upgrade. The upgrade name, height and info are passed in the
+// UPGRADE_NAME, UPGRADE_HEIGHT and UPGRADE_INFO environment variables.
+// The command is run again as long as it exits with PreUpgradeRetryCode,
+// at most PreUpgradeMaxRetries times.
+func RunPreUpgrade(cfg *Config, info *UpgradeInfo) error {
+	args := strings.Fields(cfg.PreUpgradeCmd)
+	if len(args) == 0 {
+		return nil
+	}
+
+	for attempt := 0; ; attempt++ {
+		cmd := exec.Command(args[0], args[1:]...)
+		cmd.Env = append(os.Environ(),
+			"UPGRADE_NAME="+info.Name,
+			"UPGRADE_HEIGHT="+strconv.FormatInt(info.Height, 10),
+			"UPGRADE_INFO="+info.Info,
+		)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+
+		err := cmd.Run()
+		if err == nil {
+			return nil
+		}
+
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) || exitErr.ExitCode() != PreUpgradeRetryCode {
+			return fmt.Errorf("pre-upgrade command failed, aborting upgrade: %w", err)
+		}
+		if attempt >= cfg.PreUpgradeMaxRetries {
+			return fmt.Errorf("pre-upgrade command failed after %d retries, aborting upgrade", attempt)
+		}
+	}
+}
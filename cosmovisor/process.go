@@ -38,6 +38,8 @@ func LaunchProcess(cfg *Config, args []string, stdout, stderr io.Writer) (bool,
 
 	scanOut := bufio.NewScanner(io.TeeReader(outpipe, stdout))
 	scanErr := bufio.NewScanner(io.TeeReader(errpipe, stderr))
+	// created before the process starts so that only upgrade info written by it is picked up
+	watcher := NewUpgradeInfoWatcher(cfg.UpgradeInfoFilePath(), cfg.PollInterval, cfg.CurrentUpgradeName())
 
 	if err := cmd.Start(); err != nil {
 		return false, fmt.Errorf("launching process %s %s: %w", bin, strings.Join(args, " "), err)
@@ -52,8 +54,8 @@ func LaunchProcess(cfg *Config, args []string, stdout, stderr io.Writer) (bool,
 		}
 	}()
 
-	// three ways to exit - command ends, find regexp in scanOut, find regexp in scanErr
-	upgradeInfo, err := WaitForUpgradeOrExit(cmd, scanOut, scanErr)
+	// four ways to exit - command ends, upgrade info file is written, find regexp in scanOut, find regexp in scanErr
+	upgradeInfo, err := WaitForUpgradeOrExit(cmd, scanOut, scanErr, watcher)
 	if err != nil {
 		return false, err
 	}
@@ -104,38 +106,90 @@ func (u *WaitResult) SetUpgrade(up *UpgradeInfo) {
 	}
 }
 
-// WaitForUpgradeOrExit listens to both output streams of the process, as well as the process state itself
+// WaitForUpgradeOrExit watches the upgrade info file and listens to both output streams of the process,
+// as well as the process state itself. The output streams are scanned as a fallback in case the
+// upgrade info file is not written, e.g. by binaries built with an older SDK. The watcher may be nil.
 // When it returns, the process is finished and all streams have closed.
 //
 // It returns (info, nil) if an upgrade should be initiated (and we killed the process)
 // It returns (nil, err) if the process died by itself, or there was an issue reading the pipes
 // It returns (nil, nil) if the process exited normally without triggering an upgrade. This is very unlikely
 // to happened with "start" but may happened with short-lived commands like `gaiad export ...`
-func WaitForUpgradeOrExit(cmd *exec.Cmd, scanOut, scanErr *bufio.Scanner) (*UpgradeInfo, error) {
-	var res WaitResult
+func WaitForUpgradeOrExit(cmd *exec.Cmd, scanOut, scanErr *bufio.Scanner, watcher *UpgradeInfoWatcher) (*UpgradeInfo, error) {
+	var (
+		res      WaitResult
+		scanners sync.WaitGroup
+		killOnce sync.Once
+	)
+
+	killed := make(chan struct{})
+	upgradeAndKill := func(upgrade *UpgradeInfo) {
+		res.SetUpgrade(upgrade)
+		// now we need to kill the process
+		killOnce.Do(func() {
+			_ = cmd.Process.Kill()
+			close(killed)
+		})
+	}
 
 	waitScan := func(scan *bufio.Scanner) {
+		defer scanners.Done()
 		upgrade, err := WaitForUpdate(scan)
 		if err != nil {
 			res.SetError(err)
 		} else if upgrade != nil {
-			res.SetUpgrade(upgrade)
-			// now we need to kill the process
-			_ = cmd.Process.Kill()
+			upgradeAndKill(upgrade)
 		}
 	}
 
-	// wait for the scanners, which can trigger upgrade and kill cmd
+	// wait for the watcher and the scanners, which can trigger upgrade and kill cmd
+	done := make(chan struct{})
+	watched := make(chan struct{})
+	if watcher != nil {
+		go func() {
+			defer close(watched)
+			upgrade, err := watcher.Wait(done)
+			if err != nil {
+				res.SetError(err)
+			} else if upgrade != nil {
+				upgradeAndKill(upgrade)
+			}
+		}()
+	} else {
+		close(watched)
+	}
+	scanners.Add(2)
 	go waitScan(scanOut)
 	go waitScan(scanErr)
 
+	// the streams must be read to the end before waiting for the command, as Wait
+	// closes them and any unread output would be lost, unless we killed it anyway
+	scanned := make(chan struct{})
+	go func() {
+		scanners.Wait()
+		close(scanned)
+	}()
+	select {
+	case <-scanned:
+	case <-killed:
+	}
+
 	// if the command exits normally (eg. short command like `gaiad version`), just return (nil, nil)
-	// we often get broken read pipes if it runs too fast.
 	// if we had upgrade info, we would have killed it, and thus got a non-nil error code
 	err := cmd.Wait()
+	close(done)
+	<-watched
+	<-scanned
 	if err == nil {
 		return nil, nil
 	}
+	// the daemon halts right after writing the upgrade info file, so check it
+	// once more in case it exited before the next poll
+	if watcher != nil {
+		if upgrade, err := watcher.CheckUpgrade(); err == nil {
+			res.SetUpgrade(upgrade)
+		}
+	}
 	// this will set the error code if it wasn't killed due to upgrade
 	res.SetError(err)
 	return res.AsResult()
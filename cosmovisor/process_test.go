@@ -4,7 +4,9 @@ package cosmovisor_test
 
 import (
 	"bytes"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -57,6 +59,49 @@ func (s *processTestSuite) TestLaunchProcess() {
 	s.Require().Equal(cfg.UpgradeBin("chain2"), currentBin)
 }
 
+// TestLaunchProcessWithUpgradeInfoFile checks upgrades are triggered by the upgrade info file
+// written by the daemon, without any log output, and that stale files are ignored
+func (s *processTestSuite) TestLaunchProcessWithUpgradeInfoFile() {
+	home := copyTestData(s.T(), "watch")
+	cfg := &cosmovisor.Config{Home: home, Name: "watchd", PollInterval: 20 * time.Millisecond}
+	s.Require().NoError(os.Setenv("DAEMON_HOME", home))
+	defer os.Unsetenv("DAEMON_HOME")
+
+	// the genesis binary writes the file and hangs, it is killed by the watcher
+	var stdout, stderr bytes.Buffer
+	args := []string{"foo", "bar"}
+	doUpgrade, err := cosmovisor.LaunchProcess(cfg, args, &stdout, &stderr)
+	s.Require().NoError(err)
+	s.Require().True(doUpgrade)
+	s.Require().Equal("", stderr.String())
+	s.Require().Equal("Genesis foo bar\n", stdout.String())
+
+	currentBin, err := cfg.CurrentBin()
+	s.Require().NoError(err)
+	s.Require().Equal(cfg.UpgradeBin("chain2"), currentBin)
+	s.Require().Equal("chain2", cfg.CurrentUpgradeName())
+
+	// chain2 ignores the file left by genesis, then writes a new one and exits right away
+	stdout.Reset()
+	stderr.Reset()
+	doUpgrade, err = cosmovisor.LaunchProcess(cfg, args, &stdout, &stderr)
+	s.Require().NoError(err)
+	s.Require().True(doUpgrade)
+	s.Require().Equal("Chain 2 is live!\nArgs: foo bar\n", stdout.String())
+
+	currentBin, err = cfg.CurrentBin()
+	s.Require().NoError(err)
+	s.Require().Equal(cfg.UpgradeBin("chain3"), currentBin)
+
+	// chain3 ignores the file left by chain2 and runs to the end
+	stdout.Reset()
+	stderr.Reset()
+	doUpgrade, err = cosmovisor.LaunchProcess(cfg, args, &stdout, &stderr)
+	s.Require().NoError(err)
+	s.Require().False(doUpgrade)
+	s.Require().Equal("Chain 3 is live!\nArgs: foo bar\nFinished successfully\n", stdout.String())
+}
+
 // TestLaunchProcess will try running the script a few times and watch upgrades work properly
 // and args are passed through
 func (s *processTestSuite) TestLaunchProcessWithDownloads() {
@@ -16,10 +16,11 @@ import (
 //    return fmt.Sprintf("height: %d", p.Height)
 var upgradeRegex = regexp.MustCompile(`UPGRADE "(.*)" NEEDED at ((height): (\d+)|(time): (\S+)):\s+(\S*)`)
 
-// UpgradeInfo is the details from the regexp
+// UpgradeInfo is the details from the regexp or the upgrade info file
 type UpgradeInfo struct {
-	Name string
-	Info string
+	Name   string `json:"name"`
+	Height int64  `json:"height"`
+	Info   string `json:"info"`
 }
 
 // WaitForUpdate will listen to the scanner until a line matches upgradeRegexp.
@@ -16,13 +16,33 @@ import (
 
 // DoUpgrade will be called after the log message has been parsed and the process has terminated.
 // We can now make any changes to the underlying directory without interference and leave it
-// in a state, so we can make a proper restart
+// in a state, so we can make a proper restart.
+// Once the binary is in place, the data directory is backed up if enabled and the pre-upgrade
+// hook is run, if any, before the current link is switched to the new binary.
 func DoUpgrade(cfg *Config, info *UpgradeInfo) error {
-	// Simplest case is to switch the link
+	if err := ensureUpgradeBinary(cfg, info); err != nil {
+		return err
+	}
+
+	if cfg.BackupData {
+		if err := BackupData(cfg, info); err != nil {
+			return fmt.Errorf("backing up data: %w", err)
+		}
+	}
+
+	if err := RunPreUpgrade(cfg, info); err != nil {
+		return err
+	}
+
+	return cfg.SetCurrentUpgrade(info.Name)
+}
+
+// ensureUpgradeBinary makes sure the binary of the upgrade is present, downloading it if allowed
+func ensureUpgradeBinary(cfg *Config, info *UpgradeInfo) error {
+	// Simplest case is the binary being there already
 	err := EnsureBinary(cfg.UpgradeBin(info.Name))
 	if err == nil {
-		// we have the binary - do it
-		return cfg.SetCurrentUpgrade(info.Name)
+		return nil
 	}
 	// if auto-download is disabled, we fail
 	if !cfg.AllowDownloadBinaries {
@@ -39,12 +59,12 @@ func DoUpgrade(cfg *Config, info *UpgradeInfo) error {
 		return fmt.Errorf("cannot download binary: %w", err)
 	}
 
-	// and then set the binary again
+	// and then check the binary again
 	if err := EnsureBinary(cfg.UpgradeBin(info.Name)); err != nil {
 		return fmt.Errorf("downloaded binary doesn't check out: %w", err)
 	}
 
-	return cfg.SetCurrentUpgrade(info.Name)
+	return nil
 }
 
 // DownloadBinary will grab the binary and place it in the proper directory
@@ -164,6 +184,25 @@ func (cfg *Config) SetCurrentUpgrade(upgradeName string) error {
 	return nil
 }
 
+// SetCurrentGenesis sets the genesis binary to be the current link
+func (cfg *Config) SetCurrentGenesis() error {
+	if err := EnsureBinary(cfg.GenesisBin()); err != nil {
+		return err
+	}
+
+	// remove link if it exists
+	link := filepath.Join(cfg.Root(), currentLink)
+	if _, err := os.Lstat(link); err == nil {
+		os.Remove(link)
+	}
+
+	if _, err := cfg.SymLinkToGenesis(); err != nil {
+		return fmt.Errorf("creating current symlink: %w", err)
+	}
+
+	return nil
+}
+
 // EnsureBinary ensures the file exists and is executable, or returns an error
 func EnsureBinary(path string) error {
 	info, err := os.Stat(path)
@@ -0,0 +1,95 @@
+package cosmovisor
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"time"
+)
+
+// upgradeInfoFileName is the file x/upgrade writes into the data directory of
+// the daemon when it halts for an upgrade
+const upgradeInfoFileName = "upgrade-info.json"
+
+// UpgradeInfoWatcher polls the upgrade info file written by the daemon.
+// Only files written after the watcher was created are taken into account,
+// and the upgrade the current binary already runs is ignored, so a file left
+// over from a previous upgrade doesn't trigger again.
+type UpgradeInfoWatcher struct {
+	filename    string
+	interval    time.Duration
+	current     string
+	lastModTime time.Time
+}
+
+// NewUpgradeInfoWatcher creates a watcher for the given upgrade info file.
+// current is the name of the upgrade the current binary runs, if any.
+func NewUpgradeInfoWatcher(filename string, interval time.Duration, current string) *UpgradeInfoWatcher {
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+
+	w := &UpgradeInfoWatcher{
+		filename: filename,
+		interval: interval,
+		current:  current,
+	}
+	if info, err := os.Stat(filename); err == nil {
+		w.lastModTime = info.ModTime()
+	}
+
+	return w
+}
+
+// CheckUpgrade returns the upgrade info if the file has been written since the
+// last check and names a new upgrade. It returns (nil, nil) otherwise.
+func (w *UpgradeInfoWatcher) CheckUpgrade() (*UpgradeInfo, error) {
+	stat, err := os.Stat(w.filename)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !stat.ModTime().After(w.lastModTime) {
+		return nil, nil
+	}
+
+	bz, err := ioutil.ReadFile(w.filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var info UpgradeInfo
+	if err := json.Unmarshal(bz, &info); err != nil {
+		// the file may be partially written, try again on the next check
+		return nil, nil
+	}
+	w.lastModTime = stat.ModTime()
+
+	if info.Name == "" || info.Name == w.current {
+		return nil, nil
+	}
+
+	return &info, nil
+}
+
+// Wait polls the upgrade info file until it names a new upgrade, reading it
+// fails or done is closed. It returns (nil, nil) in the latter case.
+func (w *UpgradeInfoWatcher) Wait(done <-chan struct{}) (*UpgradeInfo, error) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return nil, nil
+		case <-ticker.C:
+			info, err := w.CheckUpgrade()
+			if err != nil || info != nil {
+				return info, err
+			}
+		}
+	}
+}
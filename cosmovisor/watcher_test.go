@@ -0,0 +1,73 @@
+package cosmovisor_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/cosmovisor"
+)
+
+func TestUpgradeInfoWatcher(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "upgrade-info.json")
+	writeInfo := func(content string, modTime time.Time) {
+		require.NoError(t, ioutil.WriteFile(filename, []byte(content), 0600))
+		require.NoError(t, os.Chtimes(filename, modTime, modTime))
+	}
+	start := time.Now()
+
+	// a file written before the watcher starts is ignored
+	writeInfo(`{"name":"old","height":10}`, start)
+	w := cosmovisor.NewUpgradeInfoWatcher(filename, time.Millisecond, "")
+	info, err := w.CheckUpgrade()
+	require.NoError(t, err)
+	require.Nil(t, info)
+
+	// a partially written file is retried
+	writeInfo(`{"name":"chai`, start.Add(time.Second))
+	info, err = w.CheckUpgrade()
+	require.NoError(t, err)
+	require.Nil(t, info)
+
+	writeInfo(`{"name":"chain2","height":49,"info":"{}"}`, start.Add(time.Second))
+	info, err = w.CheckUpgrade()
+	require.NoError(t, err)
+	require.Equal(t, &cosmovisor.UpgradeInfo{Name: "chain2", Height: 49, Info: "{}"}, info)
+
+	// the same file isn't reported twice
+	info, err = w.CheckUpgrade()
+	require.NoError(t, err)
+	require.Nil(t, info)
+
+	// the current upgrade is ignored
+	w = cosmovisor.NewUpgradeInfoWatcher(filename, time.Millisecond, "chain3")
+	writeInfo(`{"name":"chain3","height":60}`, start.Add(2*time.Second))
+	info, err = w.CheckUpgrade()
+	require.NoError(t, err)
+	require.Nil(t, info)
+}
+
+func TestUpgradeInfoWatcherWait(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "upgrade-info.json")
+	w := cosmovisor.NewUpgradeInfoWatcher(filename, 10*time.Millisecond, "")
+
+	// returns once done is closed
+	done := make(chan struct{})
+	close(done)
+	info, err := w.Wait(done)
+	require.NoError(t, err)
+	require.Nil(t, info)
+
+	// returns once the file is written
+	go func() {
+		time.Sleep(30 * time.Millisecond)
+		_ = ioutil.WriteFile(filename, []byte(`{"name":"chain2","height":49}`), 0600)
+	}()
+	info, err = w.Wait(make(chan struct{}))
+	require.NoError(t, err)
+	require.Equal(t, "chain2", info.Name)
+}
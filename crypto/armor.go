@@ -18,6 +18,7 @@ const (
 	blockTypePrivKey = "OSTRACON PRIVATE KEY"
 	blockTypeKeyInfo = "OSTRACON KEY INFO"
 	blockTypePubKey  = "OSTRACON PUBLIC KEY"
+	blockTypeBundle  = "OSTRACON KEYRING BUNDLE"
 
 	defaultAlgo = "secp256k1"
 
@@ -144,6 +145,15 @@ func EncryptArmorPrivKey(privKey cryptotypes.PrivKey, passphrase string, algo st
 // generated salt and the xsalsa20 cipher. returns the salt and the
 // encrypted priv key.
 func encryptPrivKey(privKey cryptotypes.PrivKey, passphrase string) (saltBytes []byte, encBytes []byte) {
+	privKeyBytes := legacy.Cdc.MustMarshalBinaryBare(privKey)
+
+	return encryptBytes(privKeyBytes, passphrase)
+}
+
+// encrypt the given bytes with the passphrase using a randomly
+// generated salt and the xsalsa20 cipher. returns the salt and the
+// encrypted bytes.
+func encryptBytes(bz []byte, passphrase string) (saltBytes []byte, encBytes []byte) {
 	saltBytes = crypto.CRandBytes(16)
 	key, err := bcrypt.GenerateFromPassword(saltBytes, []byte(passphrase), BcryptSecurityParameter)
 
@@ -152,9 +162,8 @@ func encryptPrivKey(privKey cryptotypes.PrivKey, passphrase string) (saltBytes [
 	}
 
 	key = crypto.Sha256(key) // get 32 bytes
-	privKeyBytes := legacy.Cdc.MustMarshalBinaryBare(privKey)
 
-	return saltBytes, xsalsa20symmetric.EncryptSymmetric(privKeyBytes, key)
+	return saltBytes, xsalsa20symmetric.EncryptSymmetric(bz, key)
 }
 
 // UnarmorDecryptPrivKey returns the privkey byte slice, a string of the algo type, and an error
@@ -191,19 +200,63 @@ func UnarmorDecryptPrivKey(armorStr string, passphrase string) (privKey cryptoty
 }
 
 func decryptPrivKey(saltBytes []byte, encBytes []byte, passphrase string) (privKey cryptotypes.PrivKey, err error) {
+	privKeyBytes, err := decryptBytes(saltBytes, encBytes, passphrase)
+	if err != nil {
+		return privKey, err
+	}
+
+	return legacy.PrivKeyFromBytes(privKeyBytes)
+}
+
+func decryptBytes(saltBytes []byte, encBytes []byte, passphrase string) ([]byte, error) {
 	key, err := bcrypt.GenerateFromPassword(saltBytes, []byte(passphrase), BcryptSecurityParameter)
 	if err != nil {
-		return privKey, sdkerrors.Wrap(err, "error generating bcrypt key from passphrase")
+		return nil, sdkerrors.Wrap(err, "error generating bcrypt key from passphrase")
 	}
 
 	key = crypto.Sha256(key) // Get 32 bytes
 
-	privKeyBytes, err := xsalsa20symmetric.DecryptSymmetric(encBytes, key)
+	bz, err := xsalsa20symmetric.DecryptSymmetric(encBytes, key)
 	if err != nil && err.Error() == "Ciphertext decryption failed" {
-		return privKey, sdkerrors.ErrWrongPassword
+		return nil, sdkerrors.ErrWrongPassword
 	} else if err != nil {
-		return privKey, err
+		return nil, err
 	}
 
-	return legacy.PrivKeyFromBytes(privKeyBytes)
+	return bz, nil
+}
+
+// EncryptArmorKeyringBundle encrypts and armors a serialized keyring bundle.
+func EncryptArmorKeyringBundle(bz []byte, passphrase string) string {
+	saltBytes, encBytes := encryptBytes(bz, passphrase)
+	header := map[string]string{
+		"kdf":  "bcrypt",
+		"salt": fmt.Sprintf("%X", saltBytes),
+	}
+
+	return armor.EncodeArmor(blockTypeBundle, header, encBytes)
+}
+
+// UnarmorDecryptKeyringBundle returns the serialized keyring bundle of an
+// armored bundle produced by EncryptArmorKeyringBundle.
+func UnarmorDecryptKeyringBundle(armorStr string, passphrase string) ([]byte, error) {
+	encBytes, header, err := unarmorBytes(armorStr, blockTypeBundle)
+	if err != nil {
+		return nil, err
+	}
+
+	if header["kdf"] != "bcrypt" {
+		return nil, fmt.Errorf("unrecognized KDF type: %v", header["kdf"])
+	}
+
+	if header["salt"] == "" {
+		return nil, fmt.Errorf("missing salt bytes")
+	}
+
+	saltBytes, err := hex.DecodeString(header["salt"])
+	if err != nil {
+		return nil, fmt.Errorf("error decoding salt: %v", err.Error())
+	}
+
+	return decryptBytes(saltBytes, encBytes, passphrase)
 }
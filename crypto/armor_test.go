@@ -157,6 +157,36 @@ func TestUnarmorInfoBytesErrors(t *testing.T) {
 	require.Nil(t, unarmoredBytes)
 }
 
+func TestArmorUnarmorKeyringBundle(t *testing.T) {
+	bz := []byte("keyring bundle")
+	armored := crypto.EncryptArmorKeyringBundle(bz, "passphrase")
+
+	_, err := crypto.UnarmorDecryptKeyringBundle(armored, "wrongpassphrase")
+	require.Error(t, err)
+
+	decrypted, err := crypto.UnarmorDecryptKeyringBundle(armored, "passphrase")
+	require.NoError(t, err)
+	require.Equal(t, bz, decrypted)
+
+	// wrong armor type
+	armored = crypto.EncryptArmorPrivKey(secp256k1.GenPrivKey(), "passphrase", "")
+	_, err = crypto.UnarmorDecryptKeyringBundle(armored, "passphrase")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unrecognized armor type")
+
+	// wrong kdf header
+	armored = armor.EncodeArmor("OSTRACON KEYRING BUNDLE", map[string]string{"kdf": "wrong", "salt": "00"}, bz)
+	_, err = crypto.UnarmorDecryptKeyringBundle(armored, "passphrase")
+	require.Error(t, err)
+	require.Equal(t, "unrecognized KDF type: wrong", err.Error())
+
+	// missing salt
+	armored = armor.EncodeArmor("OSTRACON KEYRING BUNDLE", map[string]string{"kdf": "bcrypt"}, bz)
+	_, err = crypto.UnarmorDecryptKeyringBundle(armored, "passphrase")
+	require.Error(t, err)
+	require.Equal(t, "missing salt bytes", err.Error())
+}
+
 func BenchmarkBcryptGenerateFromPassword(b *testing.B) {
 	passphrase := []byte("passphrase")
 	for securityParam := 9; securityParam < 16; securityParam++ {
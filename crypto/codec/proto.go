@@ -5,6 +5,7 @@ import (
 	"github.com/line/lfb-sdk/crypto/keys/ed25519"
 	"github.com/line/lfb-sdk/crypto/keys/multisig"
 	"github.com/line/lfb-sdk/crypto/keys/secp256k1"
+	"github.com/line/lfb-sdk/crypto/keys/secp256r1"
 	cryptotypes "github.com/line/lfb-sdk/crypto/types"
 )
 
@@ -13,5 +14,6 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterInterface("lfb.crypto.PubKey", (*cryptotypes.PubKey)(nil))
 	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &ed25519.PubKey{})
 	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &secp256k1.PubKey{})
+	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &secp256r1.PubKey{})
 	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &multisig.LegacyAminoPubKey{})
 }
@@ -11,6 +11,27 @@ import (
 func TestDefaults(t *testing.T) {
 	require.Equal(t, hd.PubKeyType("multi"), hd.MultiType)
 	require.Equal(t, hd.PubKeyType("secp256k1"), hd.Secp256k1Type)
+	require.Equal(t, hd.PubKeyType("secp256r1"), hd.Secp256r1Type)
 	require.Equal(t, hd.PubKeyType("ed25519"), hd.Ed25519Type)
 	require.Equal(t, hd.PubKeyType("sr25519"), hd.Sr25519Type)
 }
+
+func TestSecp256r1Algo(t *testing.T) {
+	const mnemonic = "equip will roof matter pink blind book anxiety banner elbow sun young"
+	path := hd.CreateHDPath(438, 0, 0).String()
+
+	bz, err := hd.Secp256r1.Derive()(mnemonic, "", path)
+	require.NoError(t, err)
+	priv := hd.Secp256r1.Generate()(bz)
+	require.Equal(t, "secp256r1", priv.Type())
+
+	// derivation is deterministic
+	bz2, err := hd.Secp256r1.Derive()(mnemonic, "", path)
+	require.NoError(t, err)
+	require.True(t, priv.Equals(hd.Secp256r1.Generate()(bz2)))
+
+	msg := []byte("hello")
+	sig, err := priv.Sign(msg)
+	require.NoError(t, err)
+	require.True(t, priv.PubKey().VerifySignature(msg, sig))
+}
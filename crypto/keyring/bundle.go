@@ -0,0 +1,120 @@
+package keyring
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/line/lfb-sdk/codec/legacy"
+	"github.com/line/lfb-sdk/crypto"
+)
+
+// BundleExporter is implemented by key stores that support export of all
+// their keys in a single encrypted bundle.
+type BundleExporter interface {
+	// ExportAllArmor returns all local, ledger, offline and multi keys, including
+	// private key material of local keys, in ASCII armored encrypted format.
+	ExportAllArmor(encryptPassphrase string) (armor string, err error)
+}
+
+// BundleImporter is implemented by key stores that support import of
+// bundles produced by BundleExporter.
+type BundleImporter interface {
+	// ImportAllArmor imports all keys of an ASCII armored encrypted bundle.
+	// Keys whose name or address are already in use are skipped and returned.
+	ImportAllArmor(armor, passphrase string) (skipped []Info, err error)
+}
+
+var (
+	_ BundleExporter = keystore{}
+	_ BundleImporter = keystore{}
+)
+
+func (ks keystore) ExportAllArmor(encryptPassphrase string) (string, error) {
+	infos, err := ks.List()
+	if err != nil {
+		return "", err
+	}
+
+	bundle := make([][]byte, len(infos))
+	for i, info := range infos {
+		if info.GetType() == TypeRemote {
+			return "", fmt.Errorf("cannot export remote key: %s", info.GetName())
+		}
+		bundle[i] = marshalInfo(info)
+	}
+
+	bz, err := legacy.Cdc.MarshalBinaryBare(bundle)
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.EncryptArmorKeyringBundle(bz, encryptPassphrase), nil
+}
+
+func (ks keystore) ImportAllArmor(armor, passphrase string) ([]Info, error) {
+	bz, err := crypto.UnarmorDecryptKeyringBundle(armor, passphrase)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decrypt keyring bundle")
+	}
+
+	var bundle [][]byte
+	if err := legacy.Cdc.UnmarshalBinaryBare(bz, &bundle); err != nil {
+		return nil, err
+	}
+
+	infos := make([]Info, len(bundle))
+	for i, infoBz := range bundle {
+		if infos[i], err = unmarshalInfo(infoBz); err != nil {
+			return nil, err
+		}
+	}
+
+	return importInfos(ks, ks, infos)
+}
+
+// MigrateKeyring copies all keys of the keyring src, including private key
+// material of local keys, to the keyring dst, which must support import of
+// Info records. Keys whose name or address are already in use in dst are
+// skipped and returned. Keys held by a remote signer can't be migrated.
+func MigrateKeyring(src, dst Keyring) ([]Info, error) {
+	importer, ok := dst.(LegacyInfoImporter)
+	if !ok {
+		return nil, fmt.Errorf("the destination keyring does not support importing keys")
+	}
+
+	infos, err := src.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, info := range infos {
+		if info.GetType() == TypeRemote {
+			return nil, fmt.Errorf("cannot migrate remote key: %s", info.GetName())
+		}
+	}
+
+	return importInfos(dst, importer, infos)
+}
+
+func importInfos(kr Keyring, importer LegacyInfoImporter, infos []Info) ([]Info, error) {
+	var skipped []Info
+
+	for _, info := range infos {
+		if _, err := kr.Key(info.GetName()); err == nil {
+			skipped = append(skipped, info)
+			continue
+		}
+
+		if _, err := kr.KeyByAddress(info.GetAddress()); err == nil {
+			skipped = append(skipped, info)
+			continue
+		}
+
+		if err := importer.ImportInfo(info); err != nil {
+			return skipped, err
+		}
+	}
+
+	return skipped, nil
+}
@@ -0,0 +1,113 @@
+package keyring
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/crypto/hd"
+	"github.com/line/lfb-sdk/crypto/keys/multisig"
+	"github.com/line/lfb-sdk/crypto/keys/secp256k1"
+	"github.com/line/lfb-sdk/crypto/types"
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+// populateKeyring stores one key of each kind in the given keyring.
+func populateKeyring(t *testing.T, kr Keyring) []Info {
+	local, _, err := kr.NewMnemonic("local", English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+
+	ledger := newLedgerInfo("ledger", secp256k1.GenPrivKey().PubKey(), *hd.NewFundraiserParams(0, sdk.CoinType, 0), hd.Secp256k1Type)
+	require.NoError(t, kr.(LegacyInfoImporter).ImportInfo(ledger))
+
+	offline, err := kr.SavePubKey("offline", secp256k1.GenPrivKey().PubKey(), hd.Secp256k1Type)
+	require.NoError(t, err)
+
+	multi, err := kr.SaveMultisig("multi", multisig.NewLegacyAminoPubKey(
+		1, []types.PubKey{local.GetPubKey(), offline.GetPubKey()},
+	))
+	require.NoError(t, err)
+
+	return []Info{local, ledger, offline, multi}
+}
+
+func requireSameKeys(t *testing.T, exp []Info, kr Keyring) {
+	for _, info := range exp {
+		got, err := kr.Key(info.GetName())
+		require.NoError(t, err)
+		require.Equal(t, info.GetType(), got.GetType())
+		require.True(t, info.GetPubKey().Equals(got.GetPubKey()))
+		require.Equal(t, info.GetAlgo(), got.GetAlgo())
+
+		got, err = kr.KeyByAddress(info.GetAddress())
+		require.NoError(t, err)
+		require.Equal(t, info.GetName(), got.GetName())
+	}
+}
+
+func TestExportImportAllArmor(t *testing.T) {
+	src := NewInMemory()
+	infos := populateKeyring(t, src)
+
+	armor, err := src.(BundleExporter).ExportAllArmor("passphrase")
+	require.NoError(t, err)
+
+	dst := NewInMemory()
+	_, err = dst.(BundleImporter).ImportAllArmor(armor, "wrongpassphrase")
+	require.Error(t, err)
+
+	skipped, err := dst.(BundleImporter).ImportAllArmor(armor, "passphrase")
+	require.NoError(t, err)
+	require.Empty(t, skipped)
+	requireSameKeys(t, infos, dst)
+
+	// the private key of the local key has been restored
+	msg := []byte("message")
+	sig, pub, err := dst.Sign("local", msg)
+	require.NoError(t, err)
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	// a second import skips all keys
+	skipped, err = dst.(BundleImporter).ImportAllArmor(armor, "passphrase")
+	require.NoError(t, err)
+	require.Len(t, skipped, len(infos))
+
+	// keys colliding by address are skipped too
+	other := NewInMemory()
+	_, err = other.SavePubKey("renamed", infos[2].GetPubKey(), hd.Secp256k1Type)
+	require.NoError(t, err)
+	skipped, err = other.(BundleImporter).ImportAllArmor(armor, "passphrase")
+	require.NoError(t, err)
+	require.Len(t, skipped, 1)
+	require.Equal(t, "offline", skipped[0].GetName())
+}
+
+func TestMigrateKeyring(t *testing.T) {
+	src := NewInMemory()
+	infos := populateKeyring(t, src)
+
+	dst, err := New("keybasename", BackendTest, t.TempDir(), nil)
+	require.NoError(t, err)
+	_, err = dst.SavePubKey("local", secp256k1.GenPrivKey().PubKey(), hd.Secp256k1Type)
+	require.NoError(t, err)
+
+	skipped, err := MigrateKeyring(src, dst)
+	require.NoError(t, err)
+	require.Len(t, skipped, 1)
+	require.Equal(t, "local", skipped[0].GetName())
+	requireSameKeys(t, infos[1:], dst)
+
+	// the source keyring is left untouched
+	requireSameKeys(t, infos, src)
+
+	// remote keys can't be migrated
+	remote, stop, err := newMockRemote(NewRemoteSignerServer(src))
+	require.NoError(t, err)
+	defer stop()
+	_, err = MigrateKeyring(remote, NewInMemory())
+	require.Error(t, err)
+
+	// the remote keyring can't be a destination
+	_, err = MigrateKeyring(src, remote)
+	require.Error(t, err)
+}
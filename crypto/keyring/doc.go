@@ -43,4 +43,14 @@
 // 			be unlocked and it should be use only for testing purposes.
 // 	memory	Same instance as returned by NewInMemory. This backend uses a transient storage. Keys
 // 			are discarded when the process terminates or the type instance is garbage collected.
+// 	remote	This backend delegates listing keys and signing to a signing daemon implementing the
+// 			RemoteSigner gRPC service, whose address is set by Options.RemoteSignerAddr. Private
+// 			keys never leave the signer, hence keys can't be created, imported or deleted.
+//
+// NewRemote
+//
+// The NewRemote constructor returns a remote keyring over an existing gRPC connection.
+// Otherwise, the remote backend connects to the signer over TLS, unless
+// Options.RemoteSignerInsecure is set. NewRemoteSignerServer serves the keys of any
+// Keyring as a RemoteSigner service.
 package keyring
@@ -16,6 +16,7 @@ import (
 	ostcrypto "github.com/line/ostracon/crypto"
 	"github.com/pkg/errors"
 	"github.com/tendermint/crypto/bcrypt"
+	"google.golang.org/grpc"
 
 	"github.com/line/lfb-sdk/client/input"
 	"github.com/line/lfb-sdk/codec/legacy"
@@ -35,6 +36,7 @@ const (
 	BackendPass    = "pass"
 	BackendTest    = "test"
 	BackendMemory  = "memory"
+	BackendRemote  = "remote"
 )
 
 const (
@@ -148,6 +150,19 @@ type Options struct {
 	SupportedAlgos SigningAlgoList
 	// supported signing algorithms for Ledger
 	SupportedAlgosLedger SigningAlgoList
+	// address of the gRPC signer used by the remote backend
+	RemoteSignerAddr string
+	// PEM files of the CA certificate verifying the remote signer, and of the
+	// certificate and key authenticating the client to it; the system CAs are
+	// used if no CA certificate is given
+	RemoteSignerTLSCA   string
+	RemoteSignerTLSCert string
+	RemoteSignerTLSKey  string
+	// connect to the remote signer without TLS
+	RemoteSignerInsecure bool
+	// dial options used to connect to the remote signer, overriding the TLS
+	// options above if given
+	RemoteSignerDialOptions []grpc.DialOption
 }
 
 // NewInMemory creates a transient keyring useful for testing
@@ -159,7 +174,7 @@ func NewInMemory(opts ...Option) Keyring {
 
 // New creates a new instance of a keyring.
 // Keyring ptions can be applied when generating the new instance.
-// Available backends are "os", "file", "kwallet", "memory", "pass", "test".
+// Available backends are "os", "file", "kwallet", "memory", "pass", "test", "remote".
 func New(
 	appName, backend, rootDir string, userInput io.Reader, opts ...Option,
 ) (Keyring, error) {
@@ -171,6 +186,8 @@ func New(
 	switch backend {
 	case BackendMemory:
 		return NewInMemory(opts...), err
+	case BackendRemote:
+		return newRemoteFromOptions(opts...)
 	case BackendTest:
 		db, err = keyring.Open(newTestBackendKeyringConfig(appName, rootDir))
 	case BackendFile:
@@ -200,9 +217,13 @@ type keystore struct {
 func infoKey(name string) []byte { return []byte(fmt.Sprintf("%s.%s", name, infoSuffix)) }
 
 func newKeystore(kr keyring.Keyring, opts ...Option) keystore {
+	return keystore{kr, newOptions(opts...)}
+}
+
+func newOptions(opts ...Option) Options {
 	// Default options for keybase
 	options := Options{
-		SupportedAlgos:       SigningAlgoList{hd.Secp256k1},
+		SupportedAlgos:       SigningAlgoList{hd.Secp256k1, hd.Secp256r1},
 		SupportedAlgosLedger: SigningAlgoList{hd.Secp256k1},
 	}
 
@@ -210,7 +231,7 @@ func newKeystore(kr keyring.Keyring, opts ...Option) keystore {
 		optionFn(&options)
 	}
 
-	return keystore{kr, options}
+	return options
 }
 
 func (ks keystore) ExportPubKeyArmor(uid string) (string, error) {
@@ -270,7 +291,7 @@ func (ks keystore) ExportPrivateKeyObject(uid string) (types.PrivKey, error) {
 			return nil, err
 		}
 
-	case ledgerInfo, offlineInfo, multiInfo:
+	case ledgerInfo, offlineInfo, multiInfo, remoteInfo:
 		return nil, errors.New("only works on local private keys")
 	}
 
@@ -358,7 +379,7 @@ func (ks keystore) Sign(uid string, msg []byte) ([]byte, types.PubKey, error) {
 	case ledgerInfo:
 		return SignWithLedger(info, msg)
 
-	case offlineInfo, multiInfo:
+	case offlineInfo, multiInfo, remoteInfo:
 		return nil, info.GetPubKey(), errors.New("cannot sign with offline keys")
 	}
 
@@ -0,0 +1,357 @@
+package keyring
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/line/lfb-sdk/codec/legacy"
+	codectypes "github.com/line/lfb-sdk/codec/types"
+	"github.com/line/lfb-sdk/crypto"
+	cryptocodec "github.com/line/lfb-sdk/crypto/codec"
+	"github.com/line/lfb-sdk/crypto/hd"
+	"github.com/line/lfb-sdk/crypto/keys/secp256r1"
+	"github.com/line/lfb-sdk/crypto/types"
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+var (
+	_ Keyring            = remoteKeystore{}
+	_ RemoteSignerServer = remoteSignerServer{}
+)
+
+// remoteKeystore is a Keyring which delegates listing keys and signing to a
+// remote signer. Private keys never leave the signer, so operations which
+// create, import, export or delete private keys are not supported.
+type remoteKeystore struct {
+	client   RemoteSignerClient
+	registry codectypes.InterfaceRegistry
+	options  Options
+}
+
+// NewRemote creates a keyring backed by the remote signer reachable through
+// the given gRPC connection.
+func NewRemote(conn grpc.ClientConnInterface, opts ...Option) Keyring {
+	return newRemoteKeystore(conn, newOptions(opts...))
+}
+
+func newRemoteKeystore(conn grpc.ClientConnInterface, options Options) remoteKeystore {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+
+	return remoteKeystore{
+		client:   NewRemoteSignerClient(conn),
+		registry: registry,
+		options:  options,
+	}
+}
+
+func newRemoteFromOptions(opts ...Option) (Keyring, error) {
+	options := newOptions(opts...)
+	if options.RemoteSignerAddr == "" {
+		return nil, fmt.Errorf("remote signer address must be set for the %s keyring backend", BackendRemote)
+	}
+
+	dialOpts, err := remoteSignerDialOptions(options)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.Dial(options.RemoteSignerAddr, dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRemoteKeystore(conn, options), nil
+}
+
+// remoteSignerDialOptions returns the dial options of the remote signer. The
+// connection uses TLS unless it is explicitly made insecure.
+func remoteSignerDialOptions(options Options) ([]grpc.DialOption, error) {
+	if len(options.RemoteSignerDialOptions) > 0 {
+		return options.RemoteSignerDialOptions, nil
+	}
+	if options.RemoteSignerInsecure {
+		return []grpc.DialOption{grpc.WithInsecure()}, nil
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if options.RemoteSignerTLSCA != "" {
+		pem, err := ioutil.ReadFile(options.RemoteSignerTLSCA)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate found in %s", options.RemoteSignerTLSCA)
+		}
+	}
+	if options.RemoteSignerTLSCert != "" || options.RemoteSignerTLSKey != "" {
+		cert, err := tls.LoadX509KeyPair(options.RemoteSignerTLSCert, options.RemoteSignerTLSKey)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))}, nil
+}
+
+func (ks remoteKeystore) List() ([]Info, error) {
+	res, err := ks.client.List(context.Background(), &ListKeysRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]Info, len(res.Keys))
+	for i, key := range res.Keys {
+		if infos[i], err = ks.toInfo(key); err != nil {
+			return nil, err
+		}
+	}
+
+	return infos, nil
+}
+
+func (ks remoteKeystore) SupportedAlgorithms() (SigningAlgoList, SigningAlgoList) {
+	return ks.options.SupportedAlgos, ks.options.SupportedAlgosLedger
+}
+
+func (ks remoteKeystore) Key(uid string) (Info, error) {
+	res, err := ks.client.Key(context.Background(), &KeyRequest{Name: uid})
+	if err != nil {
+		return nil, err
+	}
+
+	return ks.toInfo(res.Key)
+}
+
+func (ks remoteKeystore) KeyByAddress(address sdk.Address) (Info, error) {
+	infos, err := ks.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, info := range infos {
+		if info.GetAddress().Equals(address) {
+			return info, nil
+		}
+	}
+
+	return nil, fmt.Errorf("key with address %s not found", address)
+}
+
+func (ks remoteKeystore) Sign(uid string, msg []byte) ([]byte, types.PubKey, error) {
+	info, err := ks.Key(uid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res, err := ks.client.Sign(context.Background(), &SignRequest{Name: uid, Msg: msg})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ks.toSignature(res, info.GetAddress(), msg)
+}
+
+func (ks remoteKeystore) SignByAddress(address sdk.Address, msg []byte) ([]byte, types.PubKey, error) {
+	res, err := ks.client.SignByAddress(context.Background(), &SignByAddressRequest{Address: address.Bytes(), Msg: msg})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ks.toSignature(res, address, msg)
+}
+
+func (ks remoteKeystore) ExportPubKeyArmor(uid string) (string, error) {
+	info, err := ks.Key(uid)
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.ArmorPubKeyBytes(legacy.Cdc.MustMarshalBinaryBare(info.GetPubKey()), string(info.GetAlgo())), nil
+}
+
+func (ks remoteKeystore) ExportPubKeyArmorByAddress(address sdk.Address) (string, error) {
+	info, err := ks.KeyByAddress(address)
+	if err != nil {
+		return "", err
+	}
+
+	return ks.ExportPubKeyArmor(info.GetName())
+}
+
+func (ks remoteKeystore) Delete(string) error {
+	return ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) DeleteByAddress(sdk.Address) error {
+	return ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) NewMnemonic(string, Language, string, SignatureAlgo) (Info, string, error) {
+	return nil, "", ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) NewAccount(string, string, string, string, SignatureAlgo) (Info, error) {
+	return nil, ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) SaveLedgerKey(string, SignatureAlgo, string, uint32, uint32, uint32) (Info, error) {
+	return nil, ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) SavePubKey(string, types.PubKey, hd.PubKeyType) (Info, error) {
+	return nil, ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) SaveMultisig(string, types.PubKey) (Info, error) {
+	return nil, ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) ImportPrivKey(string, string, string) error {
+	return ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) ImportPubKey(string, string) error {
+	return ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmor(string, string) (string, error) {
+	return "", ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmorByAddress(sdk.Address, string) (string, error) {
+	return "", ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) unpackPubKey(any *codectypes.Any) (types.PubKey, error) {
+	var pk types.PubKey
+	if err := ks.registry.UnpackAny(any, &pk); err != nil {
+		return nil, err
+	}
+	if pk == nil {
+		return nil, fmt.Errorf("remote signer returned no public key")
+	}
+
+	return pk, nil
+}
+
+func (ks remoteKeystore) toInfo(key RemoteKey) (Info, error) {
+	pk, err := ks.unpackPubKey(key.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRemoteInfo(key.Name, pk, hd.PubKeyType(key.Algo)), nil
+}
+
+// toSignature returns the signature of a response, after checking that it was
+// made by the key of the requested address. secp256r1 signatures are normalized,
+// as signers backed by HSMs usually return them in DER form.
+func (ks remoteKeystore) toSignature(res *SignResponse, address sdk.Address, msg []byte) ([]byte, types.PubKey, error) {
+	pk, err := ks.unpackPubKey(res.PubKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !bytes.Equal(pk.Address(), address.Bytes()) {
+		return nil, nil, fmt.Errorf("remote signer returned the public key of %s instead of %s", sdk.AccAddress(pk.Address()), address)
+	}
+
+	sig := res.Signature
+	if _, ok := pk.(*secp256r1.PubKey); ok {
+		if sig, err = secp256r1.NormalizeSignature(sig); err != nil {
+			return nil, nil, fmt.Errorf("remote signer returned an invalid signature: %w", err)
+		}
+	}
+	if !pk.VerifySignature(msg, sig) {
+		return nil, nil, fmt.Errorf("remote signer returned an invalid signature")
+	}
+
+	return sig, pk, nil
+}
+
+// remoteSignerServer implements the RemoteSigner service on top of a Keyring.
+type remoteSignerServer struct {
+	kr Keyring
+}
+
+// NewRemoteSignerServer returns a RemoteSigner service which serves the keys
+// of the given keyring. It can be registered on a gRPC server to run a
+// signing daemon.
+func NewRemoteSignerServer(kr Keyring) RemoteSignerServer {
+	return remoteSignerServer{kr: kr}
+}
+
+func (s remoteSignerServer) List(context.Context, *ListKeysRequest) (*ListKeysResponse, error) {
+	infos, err := s.kr.List()
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]RemoteKey, len(infos))
+	for i, info := range infos {
+		if keys[i], err = toRemoteKey(info); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ListKeysResponse{Keys: keys}, nil
+}
+
+func (s remoteSignerServer) Key(_ context.Context, req *KeyRequest) (*KeyResponse, error) {
+	info, err := s.kr.Key(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := toRemoteKey(info)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyResponse{Key: key}, nil
+}
+
+func (s remoteSignerServer) Sign(_ context.Context, req *SignRequest) (*SignResponse, error) {
+	sig, pk, err := s.kr.Sign(req.Name, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return toSignResponse(sig, pk)
+}
+
+func (s remoteSignerServer) SignByAddress(_ context.Context, req *SignByAddressRequest) (*SignResponse, error) {
+	sig, pk, err := s.kr.SignByAddress(sdk.AccAddress(req.Address), req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return toSignResponse(sig, pk)
+}
+
+func toRemoteKey(info Info) (RemoteKey, error) {
+	any, err := codectypes.NewAnyWithValue(info.GetPubKey())
+	if err != nil {
+		return RemoteKey{}, err
+	}
+
+	return RemoteKey{Name: info.GetName(), PubKey: any, Algo: string(info.GetAlgo())}, nil
+}
+
+func toSignResponse(sig []byte, pk types.PubKey) (*SignResponse, error) {
+	any, err := codectypes.NewAnyWithValue(pk)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignResponse{Signature: sig, PubKey: any}, nil
+}
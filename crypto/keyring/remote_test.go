@@ -0,0 +1,231 @@
+package keyring
+
+import (
+	"context"
+	"encoding/asn1"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/line/lfb-sdk/codec/legacy"
+	"github.com/line/lfb-sdk/crypto"
+	"github.com/line/lfb-sdk/crypto/hd"
+	"github.com/line/lfb-sdk/crypto/keys/secp256k1"
+	"github.com/line/lfb-sdk/crypto/keys/secp256r1"
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+// newMockRemote starts an in-process remote signer and returns a remote keyring
+// connected to it, together with a function stopping the signer.
+func newMockRemote(signer RemoteSignerServer, opts ...Option) (Keyring, func(), error) {
+	lis := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	RegisterRemoteSignerServer(server, signer)
+	go server.Serve(lis) // nolint: errcheck
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		server.Stop()
+		return nil, nil, err
+	}
+
+	stop := func() {
+		conn.Close()
+		server.Stop()
+	}
+
+	return NewRemote(conn, opts...), stop, nil
+}
+
+// wrongKeySigner is a remote signer signing every message with the same key.
+type wrongKeySigner struct {
+	RemoteSignerServer
+	uid string
+}
+
+func (s wrongKeySigner) Sign(ctx context.Context, req *SignRequest) (*SignResponse, error) {
+	return s.RemoteSignerServer.Sign(ctx, &SignRequest{Name: s.uid, Msg: req.Msg})
+}
+
+func (s wrongKeySigner) SignByAddress(ctx context.Context, req *SignByAddressRequest) (*SignResponse, error) {
+	return s.Sign(ctx, &SignRequest{Msg: req.Msg})
+}
+
+// derSigner is a remote signer returning signatures in DER form, like signers
+// backed by HSMs.
+type derSigner struct {
+	RemoteSignerServer
+}
+
+func (s derSigner) Sign(ctx context.Context, req *SignRequest) (*SignResponse, error) {
+	res, err := s.RemoteSignerServer.Sign(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	der, err := asn1.Marshal(struct{ R, S *big.Int }{
+		R: new(big.Int).SetBytes(res.Signature[:32]),
+		S: new(big.Int).SetBytes(res.Signature[32:]),
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.Signature = der
+	return res, nil
+}
+
+func TestRemoteKeyring(t *testing.T) {
+	signer := NewInMemory()
+	local1, _, err := signer.NewMnemonic("key1", English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+	local2, _, err := signer.NewMnemonic("key2", English, sdk.FullFundraiserPath, hd.Secp256r1)
+	require.NoError(t, err)
+
+	kr, stop, err := newMockRemote(NewRemoteSignerServer(signer))
+	require.NoError(t, err)
+	defer stop()
+
+	// list keys
+	infos, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 2)
+	require.Equal(t, "key1", infos[0].GetName())
+	require.Equal(t, "key2", infos[1].GetName())
+
+	// get keys
+	info, err := kr.Key("key2")
+	require.NoError(t, err)
+	require.Equal(t, TypeRemote, info.GetType())
+	require.Equal(t, local2.GetPubKey(), info.GetPubKey())
+	require.Equal(t, local2.GetAddress(), info.GetAddress())
+	require.Equal(t, hd.Secp256r1Type, info.GetAlgo())
+
+	info, err = kr.KeyByAddress(local1.GetAddress())
+	require.NoError(t, err)
+	require.Equal(t, "key1", info.GetName())
+
+	_, err = kr.Key("unknown")
+	require.Error(t, err)
+	_, err = kr.KeyByAddress(sdk.AccAddress("unknown"))
+	require.Error(t, err)
+
+	// sign
+	msg := []byte("message")
+	sig, pub, err := kr.Sign("key1", msg)
+	require.NoError(t, err)
+	require.Equal(t, local1.GetPubKey(), pub)
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	sig, pub, err = kr.SignByAddress(local2.GetAddress(), msg)
+	require.NoError(t, err)
+	require.Equal(t, local2.GetPubKey(), pub)
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	_, _, err = kr.Sign("unknown", msg)
+	require.Error(t, err)
+
+	// export public key
+	armor, err := kr.ExportPubKeyArmor("key1")
+	require.NoError(t, err)
+	bz, algo, err := crypto.UnarmorPubKeyBytes(armor)
+	require.NoError(t, err)
+	require.Equal(t, hd.Secp256k1Type, hd.PubKeyType(algo))
+	pubKey, err := legacy.PubKeyFromBytes(bz)
+	require.NoError(t, err)
+	require.Equal(t, local1.GetPubKey(), pubKey)
+
+	// private key material can't be managed through the remote keyring
+	_, _, err = kr.NewMnemonic("key3", English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.Equal(t, ErrUnsupportedByRemote, err)
+	_, err = kr.SavePubKey("key3", secp256k1.GenPrivKey().PubKey(), hd.Secp256k1Type)
+	require.Equal(t, ErrUnsupportedByRemote, err)
+	require.Equal(t, ErrUnsupportedByRemote, kr.Delete("key1"))
+	_, err = kr.ExportPrivKeyArmor("key1", "passphrase")
+	require.Equal(t, ErrUnsupportedByRemote, err)
+
+	// the remote info round trips through amino
+	restored, err := unmarshalInfo(marshalInfo(info))
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), restored.GetPubKey())
+	require.Equal(t, TypeRemote, restored.GetType())
+}
+
+func TestRemoteKeyringWrongKey(t *testing.T) {
+	signer := NewInMemory()
+	local1, _, err := signer.NewMnemonic("key1", English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+	_, _, err = signer.NewMnemonic("key2", English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+
+	kr, stop, err := newMockRemote(wrongKeySigner{RemoteSignerServer: NewRemoteSignerServer(signer), uid: "key2"})
+	require.NoError(t, err)
+	defer stop()
+
+	// signatures made by another key than the requested one are rejected
+	_, _, err = kr.Sign("key1", []byte("message"))
+	require.Error(t, err)
+	_, _, err = kr.SignByAddress(local1.GetAddress(), []byte("message"))
+	require.Error(t, err)
+
+	_, _, err = kr.Sign("key2", []byte("message"))
+	require.NoError(t, err)
+}
+
+func TestRemoteKeyringDERSignature(t *testing.T) {
+	signer := NewInMemory()
+	local, _, err := signer.NewMnemonic("key1", English, sdk.FullFundraiserPath, hd.Secp256r1)
+	require.NoError(t, err)
+
+	kr, stop, err := newMockRemote(derSigner{RemoteSignerServer: NewRemoteSignerServer(signer)})
+	require.NoError(t, err)
+	defer stop()
+
+	// secp256r1 signatures in DER form are converted to R || S
+	msg := []byte("message")
+	sig, pk, err := kr.Sign("key1", msg)
+	require.NoError(t, err)
+	require.Len(t, sig, secp256r1.SignatureSize)
+	require.True(t, local.GetPubKey().Equals(pk))
+	require.True(t, pk.VerifySignature(msg, sig))
+}
+
+func TestRemoteSignerDialOptions(t *testing.T) {
+	// TLS is used by default
+	opts, err := remoteSignerDialOptions(Options{})
+	require.NoError(t, err)
+	require.Len(t, opts, 1)
+
+	opts, err = remoteSignerDialOptions(Options{RemoteSignerInsecure: true})
+	require.NoError(t, err)
+	require.Len(t, opts, 1)
+
+	_, err = remoteSignerDialOptions(Options{RemoteSignerTLSCA: filepath.Join(t.TempDir(), "missing.pem")})
+	require.Error(t, err)
+
+	ca := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, ioutil.WriteFile(ca, []byte("not a certificate"), 0600))
+	_, err = remoteSignerDialOptions(Options{RemoteSignerTLSCA: ca})
+	require.Error(t, err)
+
+	_, err = remoteSignerDialOptions(Options{RemoteSignerTLSCert: ca, RemoteSignerTLSKey: ca})
+	require.Error(t, err)
+}
+
+func TestNewRemoteBackend(t *testing.T) {
+	_, err := New("keybasename", BackendRemote, t.TempDir(), nil)
+	require.Error(t, err)
+
+	kr, err := New("keybasename", BackendRemote, t.TempDir(), nil, func(options *Options) {
+		options.RemoteSignerAddr = "localhost:0"
+	})
+	require.NoError(t, err)
+	require.NotNil(t, kr)
+}
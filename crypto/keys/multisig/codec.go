@@ -6,6 +6,7 @@ import (
 	"github.com/line/lfb-sdk/codec"
 	"github.com/line/lfb-sdk/crypto/keys/ed25519"
 	"github.com/line/lfb-sdk/crypto/keys/secp256k1"
+	"github.com/line/lfb-sdk/crypto/keys/secp256r1"
 	cryptotypes "github.com/line/lfb-sdk/crypto/types"
 )
 
@@ -25,6 +26,8 @@ func init() {
 		sr25519.PubKeyName, nil)
 	AminoCdc.RegisterConcrete(&secp256k1.PubKey{},
 		secp256k1.PubKeyName, nil)
+	AminoCdc.RegisterConcrete(&secp256r1.PubKey{},
+		secp256r1.PubKeyName, nil)
 	AminoCdc.RegisterConcrete(&LegacyAminoPubKey{},
 		PubKeyAminoRoute, nil)
 }
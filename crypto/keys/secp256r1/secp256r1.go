@@ -0,0 +1,295 @@
+package secp256r1
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/asn1"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/line/ostracon/crypto"
+
+	"github.com/line/lfb-sdk/codec"
+	cryptotypes "github.com/line/lfb-sdk/crypto/types"
+	"github.com/line/lfb-sdk/types/errors"
+)
+
+var _ cryptotypes.PrivKey = &PrivKey{}
+var _ codec.AminoMarshaler = &PrivKey{}
+
+const (
+	PrivKeySize = 32
+	// SignatureSize is the size of a signature serialized as R || S.
+	SignatureSize = 64
+	keyType       = "secp256r1"
+	PrivKeyName   = "lfb/PrivKeySecp256r1"
+	PubKeyName    = "lfb/PubKeySecp256r1"
+)
+
+var (
+	curve = elliptic.P256()
+	// halfN is used to reject signatures which are not in lower-S form.
+	halfN = new(big.Int).Rsh(curve.Params().N, 1)
+	one   = new(big.Int).SetInt64(1)
+)
+
+// Bytes returns the byte representation of the Private Key.
+func (privKey *PrivKey) Bytes() []byte {
+	return privKey.Key
+}
+
+// PubKey performs the point-scalar multiplication from the privKey on the
+// generator point to get the pubkey.
+func (privKey *PrivKey) PubKey() cryptotypes.PubKey {
+	x, y := curve.ScalarBaseMult(privKey.Key)
+	return &PubKey{Key: elliptic.MarshalCompressed(curve, x, y)}
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey *PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
+	return privKey.Type() == other.Type() && subtle.ConstantTimeCompare(privKey.Bytes(), other.Bytes()) == 1
+}
+
+func (privKey *PrivKey) Type() string {
+	return keyType
+}
+
+// Sign creates an ECDSA signature on curve P-256 over the sha256 hash of the
+// given message. The signature is serialized as R || S with S in lower-S form.
+func (privKey *PrivKey) Sign(msg []byte) ([]byte, error) {
+	priv := &ecdsa.PrivateKey{D: new(big.Int).SetBytes(privKey.Key)}
+	priv.Curve = curve
+	priv.X, priv.Y = curve.ScalarBaseMult(privKey.Key)
+
+	r, s, err := ecdsa.Sign(crypto.CReader(), priv, crypto.Sha256(msg))
+	if err != nil {
+		return nil, err
+	}
+	// normalize to lower-S form to prevent signature malleability
+	if s.Cmp(halfN) > 0 {
+		s = new(big.Int).Sub(curve.Params().N, s)
+	}
+	return serializeSignature(r, s), nil
+}
+
+// NormalizeSignature converts an ECDSA signature on curve P-256, serialized
+// either as R || S or in ASN.1 DER form as produced by most HSMs and crypto
+// libraries, to the R || S form with S in lower-S form which is accepted by
+// VerifySignature.
+func NormalizeSignature(sig []byte) ([]byte, error) {
+	var r, s *big.Int
+	if len(sig) == SignatureSize {
+		r = new(big.Int).SetBytes(sig[:32])
+		s = new(big.Int).SetBytes(sig[32:])
+	} else {
+		var der struct{ R, S *big.Int }
+		rest, err := asn1.Unmarshal(sig, &der)
+		if err != nil {
+			return nil, fmt.Errorf("invalid secp256r1 signature: %w", err)
+		}
+		if len(rest) != 0 {
+			return nil, fmt.Errorf("invalid secp256r1 signature: trailing data")
+		}
+		r, s = der.R, der.S
+	}
+	if !isValidFieldElement(r) || !isValidFieldElement(s) {
+		return nil, fmt.Errorf("invalid secp256r1 signature: R or S out of range")
+	}
+	if s.Cmp(halfN) > 0 {
+		s = new(big.Int).Sub(curve.Params().N, s)
+	}
+	return serializeSignature(r, s), nil
+}
+
+func serializeSignature(r, s *big.Int) []byte {
+	sig := make([]byte, SignatureSize)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+	return sig
+}
+
+// MarshalAmino overrides Amino binary marshalling.
+func (privKey PrivKey) MarshalAmino() ([]byte, error) {
+	return privKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshalling.
+func (privKey *PrivKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PrivKeySize {
+		return fmt.Errorf("invalid privkey size")
+	}
+	privKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshalling.
+func (privKey PrivKey) MarshalAminoJSON() ([]byte, error) {
+	// When we marshal to Amino JSON, we don't marshal the "key" field itself,
+	// just its contents (i.e. the key bytes).
+	return privKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshalling.
+func (privKey *PrivKey) UnmarshalAminoJSON(bz []byte) error {
+	return privKey.UnmarshalAmino(bz)
+}
+
+// GenPrivKey generates a new ECDSA private key on curve P-256.
+// It uses OS randomness to generate the private key.
+func GenPrivKey() *PrivKey {
+	return &PrivKey{Key: genPrivKey(crypto.CReader())}
+}
+
+// genPrivKey generates a new secp256r1 private key using the provided reader.
+func genPrivKey(rand io.Reader) []byte {
+	var privKeyBytes [PrivKeySize]byte
+	d := new(big.Int)
+	for {
+		privKeyBytes = [PrivKeySize]byte{}
+		_, err := io.ReadFull(rand, privKeyBytes[:])
+		if err != nil {
+			panic(err)
+		}
+
+		d.SetBytes(privKeyBytes[:])
+		// break if we found a valid point (i.e. > 0 and < N == curverOrder)
+		if isValidFieldElement(d) {
+			break
+		}
+	}
+
+	return privKeyBytes[:]
+}
+
+// GenPrivKeyFromSecret hashes the secret with SHA2, and uses
+// that 32 byte output to create the private key.
+//
+// It makes sure the private key is a valid field element by setting:
+//
+// c = sha256(secret)
+// k = (c mod (n − 1)) + 1, where n = curve order.
+//
+// NOTE: secret should be the output of a KDF like bcrypt,
+// if it's derived from user input.
+func GenPrivKeyFromSecret(secret []byte) *PrivKey {
+	secHash := sha256.Sum256(secret)
+	return &PrivKey{Key: reduceToFieldElement(secHash[:])}
+}
+
+// PrivKeyFromBytes creates a private key from the given 32 bytes. Bytes that are
+// not a valid secret scalar on curve P-256 are mapped into the valid range by
+// k = (c mod (n − 1)) + 1, where n = curve order.
+func PrivKeyFromBytes(bz []byte) *PrivKey {
+	d := new(big.Int).SetBytes(bz)
+	if len(bz) == PrivKeySize && isValidFieldElement(d) {
+		key := make([]byte, PrivKeySize)
+		copy(key, bz)
+		return &PrivKey{Key: key}
+	}
+	return &PrivKey{Key: reduceToFieldElement(bz)}
+}
+
+func isValidFieldElement(d *big.Int) bool {
+	return 0 < d.Sign() && d.Cmp(curve.Params().N) < 0
+}
+
+// reduceToFieldElement maps the given bytes to a valid secret scalar
+// (see "Suite B Implementer’s Guide to FIPS 186-3", A.2.1).
+func reduceToFieldElement(bz []byte) []byte {
+	fe := new(big.Int).SetBytes(bz)
+	n := new(big.Int).Sub(curve.Params().N, one)
+	fe.Mod(fe, n)
+	fe.Add(fe, one)
+
+	privKey32 := make([]byte, PrivKeySize)
+	fe.FillBytes(privKey32)
+	return privKey32
+}
+
+//-------------------------------------
+
+var _ cryptotypes.PubKey = &PubKey{}
+var _ codec.AminoMarshaler = &PubKey{}
+
+// PubKeySize is comprised of 32 bytes for one field element
+// (the x-coordinate), plus one byte for the parity of the y-coordinate.
+const PubKeySize = 33
+
+// Address returns the first 20 bytes of the sha256 hash of the compressed pubkey.
+func (pubKey *PubKey) Address() crypto.Address {
+	if len(pubKey.Key) != PubKeySize {
+		panic("length of pubkey is incorrect")
+	}
+	return crypto.AddressHash(pubKey.Key)
+}
+
+// Bytes returns the pubkey byte format.
+func (pubKey *PubKey) Bytes() []byte {
+	return pubKey.Key
+}
+
+// VerifySignature verifies a signature of the form R || S over the sha256 hash of msg.
+// It rejects signatures in DER form or which are not in lower-S form, as accepting
+// several encodings of the same signature would make transaction hashes malleable.
+// Signatures made outside of this package should be converted with NormalizeSignature.
+func (pubKey *PubKey) VerifySignature(msg []byte, sigStr []byte) bool {
+	if len(sigStr) != SignatureSize {
+		return false
+	}
+	x, y := elliptic.UnmarshalCompressed(curve, pubKey.Key)
+	if x == nil {
+		return false
+	}
+	r := new(big.Int).SetBytes(sigStr[:32])
+	s := new(big.Int).SetBytes(sigStr[32:])
+	// reject malleable signatures
+	if s.Cmp(halfN) > 0 {
+		return false
+	}
+	return ecdsa.Verify(&ecdsa.PublicKey{Curve: curve, X: x, Y: y}, crypto.Sha256(msg), r, s)
+}
+
+func (pubKey *PubKey) String() string {
+	return fmt.Sprintf("PubKeySecp256r1{%X}", pubKey.Key)
+}
+
+func (pubKey *PubKey) Type() string {
+	return keyType
+}
+
+func (pubKey *PubKey) Equals(other cryptotypes.PubKey) bool {
+	return pubKey.Type() == other.Type() && bytes.Equal(pubKey.Bytes(), other.Bytes())
+}
+
+// MarshalAmino overrides Amino binary marshalling.
+func (pubKey PubKey) MarshalAmino() ([]byte, error) {
+	return pubKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshalling.
+func (pubKey *PubKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PubKeySize {
+		return errors.Wrap(errors.ErrInvalidPubKey, "invalid pubkey size")
+	}
+	pubKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshalling.
+func (pubKey PubKey) MarshalAminoJSON() ([]byte, error) {
+	// When we marshal to Amino JSON, we don't marshal the "key" field itself,
+	// just its contents (i.e. the key bytes).
+	return pubKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshalling.
+func (pubKey *PubKey) UnmarshalAminoJSON(bz []byte) error {
+	return pubKey.UnmarshalAmino(bz)
+}
@@ -0,0 +1,253 @@
+package secp256r1_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"encoding/base64"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/line/ostracon/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/codec"
+	codectypes "github.com/line/lfb-sdk/codec/types"
+	cryptocodec "github.com/line/lfb-sdk/crypto/codec"
+	"github.com/line/lfb-sdk/crypto/keys/secp256k1"
+	"github.com/line/lfb-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/line/lfb-sdk/crypto/types"
+)
+
+func TestPubKeySecp256r1(t *testing.T) {
+	// key pair from RFC 6979, A.2.5
+	privB, err := hex.DecodeString("c9afa9d845ba75166b5c215767b1d6934e50c3db36e89b127b8a622b120f6721")
+	require.NoError(t, err)
+	pubB, err := hex.DecodeString("0360fed4ba255a9d31c961eb74c6356d68c049b8923b61fa6ce669622e60f29fb6")
+	require.NoError(t, err)
+
+	priv := secp256r1.PrivKey{Key: privB}
+	pubKey := priv.PubKey()
+	assert.Equal(t, &secp256r1.PubKey{Key: pubB}, pubKey)
+	assert.Equal(t, crypto.AddressHash(pubB), pubKey.Address())
+	assert.Equal(t, "secp256r1", pubKey.Type())
+}
+
+func TestSignAndValidateSecp256r1(t *testing.T) {
+	privKey := secp256r1.GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	msg := crypto.CRandBytes(1000)
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, 64)
+	assert.True(t, pubKey.VerifySignature(msg, sig))
+
+	// mutate the message
+	msg[7] ^= byte(0x01)
+	assert.False(t, pubKey.VerifySignature(msg, sig))
+	msg[7] ^= byte(0x01)
+
+	// mutate the signature
+	sig[7] ^= byte(0x01)
+	assert.False(t, pubKey.VerifySignature(msg, sig))
+	sig[7] ^= byte(0x01)
+
+	// wrong signature size
+	assert.False(t, pubKey.VerifySignature(msg, sig[:63]))
+
+	// a signature of another key
+	otherSig, err := secp256r1.GenPrivKey().Sign(msg)
+	require.NoError(t, err)
+	assert.False(t, pubKey.VerifySignature(msg, otherSig))
+}
+
+func TestSignatureIsLowS(t *testing.T) {
+	privKey := secp256r1.GenPrivKey()
+	pubKey := privKey.PubKey()
+	n := elliptic.P256().Params().N
+	halfN := new(big.Int).Rsh(n, 1)
+
+	msg := []byte("hello world")
+	for i := 0; i < 20; i++ {
+		sig, err := privKey.Sign(msg)
+		require.NoError(t, err)
+		s := new(big.Int).SetBytes(sig[32:])
+		require.True(t, s.Cmp(halfN) <= 0)
+
+		// the malleated signature with high S is valid ECDSA but rejected
+		highS := make([]byte, 64)
+		copy(highS, sig[:32])
+		new(big.Int).Sub(n, s).FillBytes(highS[32:])
+		x, y := elliptic.UnmarshalCompressed(elliptic.P256(), pubKey.Bytes())
+		require.True(t, ecdsa.Verify(&ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, crypto.Sha256(msg),
+			new(big.Int).SetBytes(highS[:32]), new(big.Int).SetBytes(highS[32:])))
+		assert.False(t, pubKey.VerifySignature(msg, highS))
+	}
+}
+
+func TestNormalizeSignature(t *testing.T) {
+	privKey := secp256r1.GenPrivKey()
+	pubKey := privKey.PubKey()
+	n := elliptic.P256().Params().N
+	halfN := new(big.Int).Rsh(n, 1)
+
+	priv := &ecdsa.PrivateKey{D: new(big.Int).SetBytes(privKey.Bytes())}
+	priv.Curve = elliptic.P256()
+	priv.X, priv.Y = elliptic.P256().ScalarBaseMult(privKey.Bytes())
+
+	msg := []byte("hello world")
+	for i := 0; i < 20; i++ {
+		// a DER signature made outside of the package may have a high S
+		der, err := ecdsa.SignASN1(crypto.CReader(), priv, crypto.Sha256(msg))
+		require.NoError(t, err)
+		assert.False(t, pubKey.VerifySignature(msg, der))
+
+		sig, err := secp256r1.NormalizeSignature(der)
+		require.NoError(t, err)
+		require.Len(t, sig, secp256r1.SignatureSize)
+		require.True(t, new(big.Int).SetBytes(sig[32:]).Cmp(halfN) <= 0)
+		assert.True(t, pubKey.VerifySignature(msg, sig))
+
+		// normalizing a canonical signature doesn't change it
+		normalized, err := secp256r1.NormalizeSignature(sig)
+		require.NoError(t, err)
+		assert.Equal(t, sig, normalized)
+
+		// a high S signature is converted to lower-S form
+		highS := make([]byte, secp256r1.SignatureSize)
+		copy(highS, sig[:32])
+		new(big.Int).Sub(n, new(big.Int).SetBytes(sig[32:])).FillBytes(highS[32:])
+		normalized, err = secp256r1.NormalizeSignature(highS)
+		require.NoError(t, err)
+		assert.Equal(t, sig, normalized)
+	}
+
+	_, err := secp256r1.NormalizeSignature([]byte("not a signature"))
+	assert.Error(t, err)
+	_, err = secp256r1.NormalizeSignature(make([]byte, secp256r1.SignatureSize))
+	assert.Error(t, err)
+}
+
+func TestInvalidPubKey(t *testing.T) {
+	pubKey := &secp256r1.PubKey{Key: make([]byte, secp256r1.PubKeySize)}
+	sig, err := secp256r1.GenPrivKey().Sign([]byte("msg"))
+	require.NoError(t, err)
+	assert.False(t, pubKey.VerifySignature([]byte("msg"), sig))
+}
+
+func TestPrivKeyFromBytes(t *testing.T) {
+	n := elliptic.P256().Params().N
+	specs := map[string]struct {
+		src []byte
+		exp []byte
+	}{
+		"valid scalar": {
+			src: []byte{31: 1},
+			exp: []byte{31: 1},
+		},
+		"zero": {
+			src: make([]byte, 32),
+			exp: []byte{31: 1},
+		},
+		"curve order": {
+			src: n.Bytes(),
+			exp: []byte{31: 2},
+		},
+		"short input": {
+			src: []byte{1},
+			exp: []byte{31: 2},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got := secp256r1.PrivKeyFromBytes(spec.src)
+			assert.Equal(t, spec.exp, got.Key)
+		})
+	}
+}
+
+func TestGenPrivKeyFromSecret(t *testing.T) {
+	n := elliptic.P256().Params().N
+	for _, secret := range [][]byte{nil, []byte("a"), []byte("a secret")} {
+		priv := secp256r1.GenPrivKeyFromSecret(secret)
+		require.Len(t, priv.Key, secp256r1.PrivKeySize)
+		d := new(big.Int).SetBytes(priv.Key)
+		assert.True(t, d.Sign() > 0 && d.Cmp(n) < 0)
+		assert.True(t, priv.Equals(secp256r1.GenPrivKeyFromSecret(secret)))
+	}
+}
+
+func TestPrivKeyEquals(t *testing.T) {
+	privKey := secp256r1.GenPrivKey()
+	assert.True(t, privKey.Equals(&secp256r1.PrivKey{Key: privKey.Key}))
+	assert.False(t, privKey.Equals(secp256r1.GenPrivKey()))
+	assert.False(t, privKey.Equals(&secp256k1.PrivKey{Key: privKey.Key}))
+	assert.False(t, privKey.PubKey().Equals(&secp256k1.PubKey{Key: privKey.PubKey().Bytes()}))
+}
+
+func TestMarshalAmino(t *testing.T) {
+	aminoCdc := codec.NewLegacyAmino()
+	privKey := secp256r1.GenPrivKey()
+	pubKey := privKey.PubKey().(*secp256r1.PubKey)
+
+	testCases := []struct {
+		desc      string
+		msg       codec.AminoMarshaler
+		typ       interface{}
+		expBinary []byte
+		expJSON   string
+	}{
+		{
+			"secp256r1 private key",
+			privKey,
+			&secp256r1.PrivKey{},
+			append([]byte{32}, privKey.Bytes()...), // Length-prefixed.
+			"\"" + base64.StdEncoding.EncodeToString(privKey.Bytes()) + "\"",
+		},
+		{
+			"secp256r1 public key",
+			pubKey,
+			&secp256r1.PubKey{},
+			append([]byte{33}, pubKey.Bytes()...), // Length-prefixed.
+			"\"" + base64.StdEncoding.EncodeToString(pubKey.Bytes()) + "\"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			// Do a round trip of encoding/decoding binary.
+			bz, err := aminoCdc.MarshalBinaryBare(tc.msg)
+			require.NoError(t, err)
+			require.Equal(t, tc.expBinary, bz)
+
+			err = aminoCdc.UnmarshalBinaryBare(bz, tc.typ)
+			require.NoError(t, err)
+			require.Equal(t, tc.msg, tc.typ)
+
+			// Do a round trip of encoding/decoding JSON.
+			bz, err = aminoCdc.MarshalJSON(tc.msg)
+			require.NoError(t, err)
+			require.Equal(t, tc.expJSON, string(bz))
+
+			err = aminoCdc.UnmarshalJSON(bz, tc.typ)
+			require.NoError(t, err)
+			require.Equal(t, tc.msg, tc.typ)
+		})
+	}
+}
+
+func TestPubKeyAny(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	pubKey := secp256r1.GenPrivKey().PubKey()
+	bz, err := cdc.MarshalInterface(pubKey)
+	require.NoError(t, err)
+
+	var got cryptotypes.PubKey
+	require.NoError(t, cdc.UnmarshalInterface(bz, &got))
+	assert.True(t, pubKey.Equals(got))
+}
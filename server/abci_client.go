@@ -0,0 +1,144 @@
+package server
+
+import (
+	"sync"
+
+	abcicli "github.com/line/ostracon/abci/client"
+	abci "github.com/line/ostracon/abci/types"
+	ostsync "github.com/line/ostracon/libs/sync"
+	"github.com/line/ostracon/proxy"
+)
+
+// deliverTxBatcher is implemented by applications that can execute the
+// transactions of a block as a batch, such as BaseApp.
+type deliverTxBatcher interface {
+	abci.Application
+
+	DeliverTxBatch(reqs []abci.RequestDeliverTx) []abci.ResponseDeliverTx
+}
+
+// newLocalClientCreator returns the client creator of an app running in-process
+// with Ostracon. If workers is above one and the app executes batches of
+// transactions, the DeliverTx requests of a block are buffered and delivered
+// with DeliverTxBatch, so that they can be executed in parallel.
+func newLocalClientCreator(app abci.Application, workers int) proxy.ClientCreator {
+	if batcher, ok := app.(deliverTxBatcher); ok && workers > 1 {
+		return &batchingClientCreator{mtx: new(ostsync.Mutex), app: batcher}
+	}
+
+	return proxy.NewLocalClientCreator(app)
+}
+
+// batchingClientCreator creates local clients sharing a mutex on the app, like
+// the local client creator of Ostracon, that buffer the DeliverTx requests.
+type batchingClientCreator struct {
+	mtx *ostsync.Mutex
+	app deliverTxBatcher
+}
+
+// NewABCIClient implements proxy.ClientCreator.
+func (c *batchingClientCreator) NewABCIClient() (abcicli.Client, error) {
+	return &batchingClient{
+		Client: abcicli.NewLocalClient(c.mtx, c.app),
+		mtx:    c.mtx,
+		app:    c.app,
+	}, nil
+}
+
+// batchingClient is a local client that buffers the DeliverTxAsync requests
+// and delivers them with DeliverTxBatch before the next request that depends
+// on them, i.e. EndBlock, Commit, BeginBlock or DeliverTxSync. Ostracon only
+// reads the DeliverTx responses from the global callback, which is called for
+// each buffered request in order once the batch is executed.
+type batchingClient struct {
+	abcicli.Client
+
+	mtx *ostsync.Mutex
+	app deliverTxBatcher
+
+	pendingMtx sync.Mutex
+	pending    []*abcicli.ReqRes
+}
+
+// DeliverTxAsync implements abcicli.Client.
+func (c *batchingClient) DeliverTxAsync(req abci.RequestDeliverTx, cb abcicli.ResponseCallback) *abcicli.ReqRes {
+	reqRes := abcicli.NewReqRes(abci.ToRequestDeliverTx(req), cb)
+
+	c.pendingMtx.Lock()
+	c.pending = append(c.pending, reqRes)
+	c.pendingMtx.Unlock()
+
+	return reqRes
+}
+
+// DeliverTxSync implements abcicli.Client.
+func (c *batchingClient) DeliverTxSync(req abci.RequestDeliverTx) (*abci.ResponseDeliverTx, error) {
+	c.flush()
+	return c.Client.DeliverTxSync(req)
+}
+
+// BeginBlockAsync implements abcicli.Client.
+func (c *batchingClient) BeginBlockAsync(req abci.RequestBeginBlock, cb abcicli.ResponseCallback) *abcicli.ReqRes {
+	c.flush()
+	return c.Client.BeginBlockAsync(req, cb)
+}
+
+// BeginBlockSync implements abcicli.Client.
+func (c *batchingClient) BeginBlockSync(req abci.RequestBeginBlock) (*abci.ResponseBeginBlock, error) {
+	c.flush()
+	return c.Client.BeginBlockSync(req)
+}
+
+// EndBlockAsync implements abcicli.Client.
+func (c *batchingClient) EndBlockAsync(req abci.RequestEndBlock, cb abcicli.ResponseCallback) *abcicli.ReqRes {
+	c.flush()
+	return c.Client.EndBlockAsync(req, cb)
+}
+
+// EndBlockSync implements abcicli.Client.
+func (c *batchingClient) EndBlockSync(req abci.RequestEndBlock) (*abci.ResponseEndBlock, error) {
+	c.flush()
+	return c.Client.EndBlockSync(req)
+}
+
+// CommitAsync implements abcicli.Client.
+func (c *batchingClient) CommitAsync(cb abcicli.ResponseCallback) *abcicli.ReqRes {
+	c.flush()
+	return c.Client.CommitAsync(cb)
+}
+
+// CommitSync implements abcicli.Client.
+func (c *batchingClient) CommitSync() (*abci.ResponseCommit, error) {
+	c.flush()
+	return c.Client.CommitSync()
+}
+
+// flush delivers the buffered DeliverTx requests as a batch and completes
+// them in order.
+func (c *batchingClient) flush() {
+	c.pendingMtx.Lock()
+	pending := c.pending
+	c.pending = nil
+	c.pendingMtx.Unlock()
+
+	if len(pending) == 0 {
+		return
+	}
+
+	reqs := make([]abci.RequestDeliverTx, len(pending))
+	for i, reqRes := range pending {
+		reqs[i] = *reqRes.Request.GetDeliverTx()
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	res := c.app.DeliverTxBatch(reqs)
+	globalCb := c.GetGlobalCallback()
+	for i, reqRes := range pending {
+		r := abci.ToResponseDeliverTx(res[i])
+		if reqRes.SetDone(r) && globalCb != nil {
+			globalCb(reqRes.Request, r)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package server
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+
+	abcicli "github.com/line/ostracon/abci/client"
+	abci "github.com/line/ostracon/abci/types"
+	"github.com/line/ostracon/libs/log"
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/line/tm-db/v2/memdb"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/baseapp"
+	"github.com/line/lfb-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/line/lfb-sdk/crypto/types"
+	"github.com/line/lfb-sdk/simapp"
+	"github.com/line/lfb-sdk/simapp/helpers"
+	sdk "github.com/line/lfb-sdk/types"
+	authtypes "github.com/line/lfb-sdk/x/auth/types"
+	banktypes "github.com/line/lfb-sdk/x/bank/types"
+)
+
+// recordingLogger records the messages logged by an app.
+type recordingLogger struct {
+	mtx  *sync.Mutex
+	msgs *[]string
+}
+
+func newRecordingLogger() recordingLogger {
+	return recordingLogger{mtx: new(sync.Mutex), msgs: new([]string)}
+}
+
+func (l recordingLogger) record(msg string) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	*l.msgs = append(*l.msgs, msg)
+}
+
+func (l recordingLogger) Debug(msg string, _ ...interface{}) { l.record(msg) }
+func (l recordingLogger) Info(msg string, _ ...interface{})  { l.record(msg) }
+func (l recordingLogger) Error(msg string, _ ...interface{}) { l.record(msg) }
+func (l recordingLogger) With(_ ...interface{}) log.Logger   { return l }
+
+func (l recordingLogger) contains(substr string) bool {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	for _, msg := range *l.msgs {
+		if strings.Contains(msg, substr) {
+			return true
+		}
+	}
+	return false
+}
+
+// setupBankApp returns a SimApp whose genesis funds the senders and the
+// recipients, and creates the fee collector.
+func setupBankApp(t *testing.T, logger log.Logger, workers int, addrs []sdk.AccAddress) *simapp.SimApp {
+	encCfg := simapp.MakeTestEncodingConfig()
+	app := simapp.NewSimApp(
+		logger, memdb.NewDB(), nil, true, map[int64]bool{}, t.TempDir(), 0, encCfg, simapp.EmptyAppOptions{},
+		baseapp.SetParallelDeliverTx(workers),
+	)
+
+	genesisState := simapp.NewDefaultGenesisState(encCfg.Marshaler)
+	genAccs := make([]authtypes.GenesisAccount, len(addrs), len(addrs)+1)
+	balances := make([]banktypes.Balance, len(addrs))
+	totalSupply := sdk.NewCoins()
+	for i, addr := range addrs {
+		genAccs[i] = authtypes.NewBaseAccount(addr, nil, 0)
+		balances[i] = banktypes.Balance{Address: addr.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))}
+		totalSupply = totalSupply.Add(balances[i].Coins...)
+	}
+	genAccs = append(genAccs, authtypes.NewEmptyModuleAccount(authtypes.FeeCollectorName))
+	genesisState[authtypes.ModuleName] = app.AppCodec().MustMarshalJSON(authtypes.NewGenesisState(authtypes.DefaultParams(), genAccs))
+	genesisState[banktypes.ModuleName] = app.AppCodec().MustMarshalJSON(
+		banktypes.NewGenesisState(banktypes.DefaultGenesisState().Params, balances, totalSupply, []banktypes.Metadata{}),
+	)
+
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{ConsensusParams: simapp.DefaultConsensusParams, AppStateBytes: stateBytes})
+	app.Commit()
+
+	return app
+}
+
+// runBlock executes a block through the client Ostracon would use for the app
+// and returns the DeliverTx responses and the app hash.
+func runBlock(t *testing.T, app *simapp.SimApp, workers int, txs [][]byte) ([]*abci.ResponseDeliverTx, []byte) {
+	cli, err := newLocalClientCreator(app, workers).NewABCIClient()
+	require.NoError(t, err)
+
+	var res []*abci.ResponseDeliverTx
+	cli.SetGlobalCallback(func(req *abci.Request, r *abci.Response) {
+		if dtx := r.GetDeliverTx(); dtx != nil {
+			res = append(res, dtx)
+		}
+	})
+
+	height := app.LastBlockHeight() + 1
+	_, err = cli.BeginBlockSync(abci.RequestBeginBlock{Header: ostproto.Header{Height: height}})
+	require.NoError(t, err)
+	reqRes := make([]*abcicli.ReqRes, len(txs))
+	for i, tx := range txs {
+		reqRes[i] = cli.DeliverTxAsync(abci.RequestDeliverTx{Tx: tx}, nil)
+	}
+	_, err = cli.EndBlockSync(abci.RequestEndBlock{Height: height})
+	require.NoError(t, err)
+	for _, r := range reqRes {
+		r.Wait()
+	}
+	commit, err := cli.CommitSync()
+	require.NoError(t, err)
+
+	return res, commit.Data
+}
+
+// sendTxs returns txs in which each of numSenders senders pays fee and sends
+// coins to a recipient of its own, and the addresses of the senders and the
+// recipients.
+func sendTxs(t *testing.T, numSenders int, fee sdk.Coins) ([][]byte, []sdk.AccAddress) {
+	privs := make([]cryptotypes.PrivKey, numSenders)
+	addrs := make([]sdk.AccAddress, 2*numSenders)
+	for i := range addrs {
+		priv := secp256k1.GenPrivKey()
+		if i < numSenders {
+			privs[i] = priv
+		}
+		addrs[i] = sdk.AccAddress(priv.PubKey().Address())
+	}
+
+	txCfg := simapp.MakeTestEncodingConfig().TxConfig
+	txs := make([][]byte, numSenders)
+	for i := range txs {
+		msg := banktypes.NewMsgSend(addrs[i], addrs[numSenders+i], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+		tx, err := helpers.GenTx(
+			txCfg, []sdk.Msg{msg}, fee, helpers.DefaultGenTxGas,
+			"", []uint64{0}, []uint64{0}, privs[i],
+		)
+		require.NoError(t, err)
+		txs[i], err = txCfg.TxEncoder()(tx)
+		require.NoError(t, err)
+	}
+
+	return txs, addrs
+}
+
+func TestBatchingClientDeliversInParallel(t *testing.T) {
+	const numSenders = 8
+	txs, addrs := sendTxs(t, numSenders, sdk.NewCoins())
+
+	serialApp := setupBankApp(t, log.NewNopLogger(), 1, addrs)
+	serialRes, serialHash := runBlock(t, serialApp, 1, txs)
+
+	logger := newRecordingLogger()
+	parallelApp := setupBankApp(t, logger, 4, addrs)
+	parallelRes, parallelHash := runBlock(t, parallelApp, 4, txs)
+
+	require.True(t, logger.contains("executed block in parallel"))
+	require.False(t, logger.contains("re-executing block serially"))
+
+	require.Len(t, parallelRes, numSenders)
+	for _, r := range parallelRes {
+		require.True(t, r.IsOK(), r.String())
+	}
+	require.Equal(t, serialRes, parallelRes)
+	require.Equal(t, serialHash, parallelHash)
+}
+
+func TestBatchingClientMergesFees(t *testing.T) {
+	const numSenders = 8
+	txs, addrs := sendTxs(t, numSenders, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)))
+
+	serialApp := setupBankApp(t, log.NewNopLogger(), 1, addrs)
+	serialRes, serialHash := runBlock(t, serialApp, 1, txs)
+
+	// all the txs credit the fee collector, whose balance grows to two digits
+	// in serial execution, so the lanes are merged with corrected gas
+	logger := newRecordingLogger()
+	parallelApp := setupBankApp(t, logger, 4, addrs)
+	parallelRes, parallelHash := runBlock(t, parallelApp, 4, txs)
+
+	require.True(t, logger.contains("executed block in parallel"))
+	require.False(t, logger.contains("re-executing block serially"))
+	require.Equal(t, serialRes, parallelRes)
+	require.Equal(t, serialHash, parallelHash)
+
+	ctx := parallelApp.BaseApp.NewContext(true, ostproto.Header{})
+	feeCollector := parallelApp.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.Equal(t,
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5*numSenders)),
+		parallelApp.BankKeeper.GetAllBalances(ctx, feeCollector),
+	)
+}
@@ -77,6 +77,16 @@ type BaseConfig struct {
 	// Bech32CacheSize is the maximum bytes size of bech32 cache (Default : 1GB)
 	Bech32CacheSize int `mapstructure:"bech32-cache-size"`
 
+	// DBBackend defines the backend of the app DB, e.g. goleveldb or badgerdb. The
+	// backend must be compiled in with its build tag. If empty, the backend the
+	// binary was built with is used.
+	DBBackend string `mapstructure:"db-backend"`
+
+	// DeliverTxWorkers is the number of lanes of a block that are executed
+	// concurrently. A value below two keeps block execution serial. It only
+	// applies when the app runs in-process with Ostracon.
+	DeliverTxWorkers int `mapstructure:"deliver-tx-workers"`
+
 	// When true, Prometheus metrics are served under /metrics on prometheus_listen_addr in config.toml.
 	// It works when tendermint's prometheus option (config.toml) is set to true.
 	Prometheus bool `mapstructure:"prometheus"`
@@ -136,6 +146,55 @@ type StateSyncConfig struct {
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
 }
 
+// StoreConfig defines the state streaming configuration of the multistore.
+type StoreConfig struct {
+	// Streamers lists the streaming services enabled for the multistore.
+	Streamers []string `mapstructure:"streamers"`
+}
+
+// StreamersConfig defines the configuration of the streaming services.
+type StreamersConfig struct {
+	File FileStreamerConfig `mapstructure:"file"`
+}
+
+// FileStreamerConfig defines the configuration of the file streaming service.
+type FileStreamerConfig struct {
+	// Keys lists the store keys whose state changes are streamed. "*" streams
+	// the state changes of all stores.
+	Keys []string `mapstructure:"keys"`
+
+	// WriteDir is the directory the streamed files are written to.
+	WriteDir string `mapstructure:"write-dir"`
+
+	// Prefix is prepended to the names of the streamed files.
+	Prefix string `mapstructure:"prefix"`
+}
+
+// FeeMarketConfig defines the configuration of the tx priority and of the
+// dynamic minimum gas prices of CheckTx.
+type FeeMarketConfig struct {
+	// DynamicMinGasPrices enables the adjustment of the minimum gas prices to the
+	// fullness of the recent blocks.
+	DynamicMinGasPrices bool `mapstructure:"dynamic-min-gas-prices"`
+
+	// TargetBlockFullness is the ratio of the maximum block gas used by a block
+	// which keeps the minimum gas prices unchanged.
+	TargetBlockFullness string `mapstructure:"target-block-fullness"`
+
+	// MaxChangeRate is the maximum relative change of the minimum gas prices
+	// after a block.
+	MaxChangeRate string `mapstructure:"max-change-rate"`
+
+	// MaxMultiplier is the maximum ratio of the minimum gas prices to the
+	// configured ones.
+	MaxMultiplier string `mapstructure:"max-multiplier"`
+
+	// MsgPriorityWeights lists the weights the priority of a tx is multiplied by
+	// according to the type of its Msgs, in the form "{msgTypeURL}={weight}".
+	// The priority is advisory only and does not order the mempool.
+	MsgPriorityWeights []string `mapstructure:"msg-priority-weights"`
+}
+
 // Config defines the server's top level configuration
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
@@ -145,6 +204,9 @@ type Config struct {
 	API       APIConfig        `mapstructure:"api"`
 	GRPC      GRPCConfig       `mapstructure:"grpc"`
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
+	Store     StoreConfig      `mapstructure:"store"`
+	Streamers StreamersConfig  `mapstructure:"streamers"`
+	FeeMarket FeeMarketConfig  `mapstructure:"fee-market"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -212,6 +274,23 @@ func DefaultConfig() *Config {
 			SnapshotInterval:   0,
 			SnapshotKeepRecent: 2,
 		},
+		Store: StoreConfig{
+			Streamers: []string{},
+		},
+		Streamers: StreamersConfig{
+			File: FileStreamerConfig{
+				Keys:     []string{"*"},
+				WriteDir: "",
+				Prefix:   "",
+			},
+		},
+		FeeMarket: FeeMarketConfig{
+			DynamicMinGasPrices: false,
+			TargetBlockFullness: "0.5",
+			MaxChangeRate:       "0.125",
+			MaxMultiplier:       "10",
+			MsgPriorityWeights:  []string{},
+		},
 	}
 }
 
@@ -238,6 +317,8 @@ func GetConfig(v *viper.Viper) Config {
 			HaltTime:          v.GetUint64("halt-time"),
 			IndexEvents:       v.GetStringSlice("index-events"),
 			MinRetainBlocks:   v.GetUint64("min-retain-blocks"),
+			DBBackend:         v.GetString("db-backend"),
+			DeliverTxWorkers:  v.GetInt("deliver-tx-workers"),
 		},
 		Telemetry: telemetry.Config{
 			ServiceName:             v.GetString("telemetry.service-name"),
@@ -267,5 +348,22 @@ func GetConfig(v *viper.Viper) Config {
 			SnapshotInterval:   v.GetUint64("state-sync.snapshot-interval"),
 			SnapshotKeepRecent: v.GetUint32("state-sync.snapshot-keep-recent"),
 		},
+		Store: StoreConfig{
+			Streamers: v.GetStringSlice("store.streamers"),
+		},
+		Streamers: StreamersConfig{
+			File: FileStreamerConfig{
+				Keys:     v.GetStringSlice("streamers.file.keys"),
+				WriteDir: v.GetString("streamers.file.write-dir"),
+				Prefix:   v.GetString("streamers.file.prefix"),
+			},
+		},
+		FeeMarket: FeeMarketConfig{
+			DynamicMinGasPrices: v.GetBool("fee-market.dynamic-min-gas-prices"),
+			TargetBlockFullness: v.GetString("fee-market.target-block-fullness"),
+			MaxChangeRate:       v.GetString("fee-market.max-change-rate"),
+			MaxMultiplier:       v.GetString("fee-market.max-multiplier"),
+			MsgPriorityWeights:  v.GetStringSlice("fee-market.msg-priority-weights"),
+		},
 	}
 }
@@ -1,8 +1,12 @@
 package config
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/line/lfb-sdk/types"
@@ -18,3 +22,61 @@ func TestSetMinimumFees(t *testing.T) {
 	cfg.SetMinGasPrices(sdk.DecCoins{sdk.NewInt64DecCoin("foo", 5)})
 	require.Equal(t, "5.000000000000000000foo", cfg.MinGasPrices)
 }
+
+func TestStreamingConfigRoundTrip(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Store.Streamers = []string{"file"}
+	cfg.Streamers.File.Keys = []string{"bank", "acc"}
+	cfg.Streamers.File.WriteDir = "/tmp/streams"
+	cfg.Streamers.File.Prefix = "node0-"
+
+	dir, err := ioutil.TempDir("", "config")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "app.toml")
+	WriteConfigFile(path, cfg)
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	require.NoError(t, v.ReadInConfig())
+
+	parsed := GetConfig(v)
+	require.Equal(t, cfg.Store, parsed.Store)
+	require.Equal(t, cfg.Streamers, parsed.Streamers)
+}
+
+func TestFeeMarketConfigRoundTrip(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.FeeMarket.DynamicMinGasPrices = true
+	cfg.FeeMarket.MaxMultiplier = "5"
+	cfg.FeeMarket.MsgPriorityWeights = []string{"/lfb.bank.v1beta1.MsgSend=2", "/lfb.gov.v1beta1.MsgVote=0.5"}
+
+	dir, err := ioutil.TempDir("", "config")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "app.toml")
+	WriteConfigFile(path, cfg)
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	require.NoError(t, v.ReadInConfig())
+
+	require.Equal(t, cfg.FeeMarket, GetConfig(v).FeeMarket)
+}
+
+func TestDBBackendConfigRoundTrip(t *testing.T) {
+	cfg := DefaultConfig()
+	require.Empty(t, cfg.DBBackend)
+	cfg.DBBackend = "badgerdb"
+
+	path := filepath.Join(t.TempDir(), "app.toml")
+	WriteConfigFile(path, cfg)
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	require.NoError(t, v.ReadInConfig())
+
+	require.Equal(t, "badgerdb", GetConfig(v).DBBackend)
+}
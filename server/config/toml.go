@@ -79,6 +79,21 @@ bech32-cache-size = {{ .BaseConfig.Bech32CacheSize }}
 # ["message.sender", "message.recipient"]
 index-events = {{ .BaseConfig.IndexEvents }}
 
+# DBBackend defines the backend of the app DB, e.g. goleveldb or badgerdb. The
+# backend must be compiled in with its build tag. If empty, the backend the binary
+# was built with is used. The backend of the Ostracon DBs is set by db_backend in
+# config.toml. Use the migrate-db command to copy the data to another backend.
+db-backend = "{{ .BaseConfig.DBBackend }}"
+
+# DeliverTxWorkers is the number of lanes of a block, i.e. groups of txs sharing
+# signers, that are executed concurrently. If the lanes of a block conflict, the
+# block is re-executed serially. Credits to the fee collector do not conflict,
+# nor does the gas used by the Msg types with a per-block gas limit in the auth
+# params, unless the lanes together exceed a limit.
+# A value below 2 keeps block execution serial.
+# It only applies when the app runs in-process with Ostracon.
+deliver-tx-workers = {{ .BaseConfig.DeliverTxWorkers }}
+
 # When true, Prometheus metrics are served under /metrics on prometheus_listen_addr in config.toml.
 # It works when tendermint's prometheus option (config.toml) is set to true.
 prometheus = {{ .BaseConfig.Prometheus }}
@@ -177,6 +192,59 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
+
+###############################################################################
+###                        State Streaming Configuration                    ###
+###############################################################################
+
+[store]
+
+# streamers lists the streaming services that receive the state changes and the
+# ABCI requests and responses of every block (e.g. ["file"]). Empty disables
+# state streaming.
+streamers = [{{ range .Store.Streamers }}"{{ . }}", {{ end }}]
+
+[streamers]
+
+[streamers.file]
+
+# keys lists the store keys whose state changes are streamed. "*" streams the
+# state changes of all stores.
+keys = [{{ range .Streamers.File.Keys }}"{{ . }}", {{ end }}]
+
+# write-dir is the directory the files of the file streaming service are
+# written to. It defaults to "data/file_streamer" in the node home directory.
+write-dir = "{{ .Streamers.File.WriteDir }}"
+
+# prefix is prepended to the names of the files of the file streaming service.
+prefix = "{{ .Streamers.File.Prefix }}"
+
+###############################################################################
+###                          Fee Market Configuration                       ###
+###############################################################################
+
+# The priority of a tx in CheckTx is its fee per unit of gas, returned in the
+# "priority" attribute of the "tx" event of ResponseCheckTx. The priority is
+# advisory only: the mempool keeps txs in arrival order and does not read it.
+[fee-market]
+
+# dynamic-min-gas-prices enables the adjustment of the minimum gas prices to the
+# fullness of the recent blocks. The minimum gas prices are raised after a block
+# using more than target-block-fullness of the maximum block gas, and lowered
+# otherwise, by at most max-change-rate per block. They stay between
+# minimum-gas-prices and max-multiplier times minimum-gas-prices.
+dynamic-min-gas-prices = {{ .FeeMarket.DynamicMinGasPrices }}
+target-block-fullness = "{{ .FeeMarket.TargetBlockFullness }}"
+max-change-rate = "{{ .FeeMarket.MaxChangeRate }}"
+max-multiplier = "{{ .FeeMarket.MaxMultiplier }}"
+
+# msg-priority-weights lists the weights the priority of a tx is multiplied by
+# according to the type of its Msgs. A tx has the lowest weight of its Msgs,
+# and Msg types not listed have a weight of 1.
+#
+# Example:
+# ["/lfb.bank.v1beta1.MsgSend=2", "/lfb.gov.v1beta1.MsgVote=0.5"]
+msg-priority-weights = [{{ range .FeeMarket.MsgPriorityWeights }}"{{ . }}", {{ end }}]
 `
 
 var configTemplate *template.Template
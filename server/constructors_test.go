@@ -9,8 +9,11 @@ import (
 
 func Test_openDB(t *testing.T) {
 	t.Parallel()
-	_, err := openDB(t.TempDir())
+	_, err := openDB(t.TempDir(), "")
 	require.NoError(t, err)
+
+	_, err = openDB(t.TempDir(), "unknowndb")
+	require.Error(t, err)
 }
 
 func Test_openTraceWriter(t *testing.T) {
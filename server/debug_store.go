@@ -0,0 +1,490 @@
+package server
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	ostbytes "github.com/line/ostracon/libs/bytes"
+	ostcli "github.com/line/ostracon/libs/cli"
+	tmdb "github.com/line/tm-db/v2"
+	"github.com/line/tm-db/v2/goleveldb"
+	"github.com/line/tm-db/v2/metadb"
+	"github.com/spf13/cobra"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+
+	"github.com/line/lfb-sdk/client/flags"
+	"github.com/line/lfb-sdk/store/rootmulti"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/types/kv"
+)
+
+const (
+	flagPrefix = "prefix"
+	flagLimit  = "limit"
+	flagDecode = "decode"
+	flagCopyDB = "copy-db"
+)
+
+// StoreDecodersFn returns the decoders of the values of the app stores by store
+// name, which are usually the StoreDecoders of the SimulationManager of the app.
+// It is only called when values are decoded, as it may have to create the app.
+type StoreDecodersFn func() (sdk.StoreDecoderRegistry, error)
+
+// valueDecoder decodes a value of a store, returning an empty string if it can't.
+type valueDecoder func(key, value []byte) string
+
+// storeEntry is a key-value pair of a store, along with its decoded value.
+type storeEntry struct {
+	Key     ostbytes.HexBytes `json:"key"`
+	Value   ostbytes.HexBytes `json:"value"`
+	Decoded string            `json:"decoded,omitempty"`
+}
+
+// DebugStoreCmd returns the command group to inspect the app state of a stopped node
+// offline, at any version that hasn't been pruned. The app state is never written, and
+// with --copy-db, the files of the app DB are left untouched too. With --decode,
+// values are decoded with the decoders returned by storeDecoders.
+func DebugStoreCmd(storeDecoders StoreDecodersFn, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store",
+		Short: "Inspect the app state of a stopped node",
+	}
+
+	cmd.AddCommand(
+		ListStoresCmd(),
+		IterateStoreCmd(storeDecoders),
+		GetStoreValueCmd(storeDecoders),
+		DiffStoresCmd(storeDecoders),
+	)
+	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.PersistentFlags().Int64(FlagHeight, 0, "Version of the app state, defaults to the latest one")
+	cmd.PersistentFlags().StringP(ostcli.OutputFlag, "o", "text", "Output format (text|json)")
+	cmd.PersistentFlags().Bool(flagCopyDB, false,
+		"Open a copy of the app DB in a temporary directory, for backends without a read-only mode")
+
+	return cmd
+}
+
+// ListStoresCmd returns a command that lists the stores of the app state with their
+// commit hashes.
+func ListStoresCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the stores of the app state with their commit hashes",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			db, version, err := openDBAtVersionFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			output, _ := cmd.Flags().GetString(ostcli.OutputFlag)
+			return listStores(cmd.OutOrStdout(), db, version, output)
+		},
+	}
+}
+
+// IterateStoreCmd returns a command that prints the key-value pairs of a store.
+func IterateStoreCmd(storeDecoders StoreDecodersFn) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "iterate [store]",
+		Short: "Print the key-value pairs of a store, optionally with a key prefix",
+		Long: `Print the key-value pairs of a store, optionally with a key prefix. Keys and values are
+printed in hex, and with --decode, values are also decoded by the store decoder of the
+module of the store.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			prefixStr, _ := cmd.Flags().GetString(flagPrefix)
+			prefix, err := hex.DecodeString(prefixStr)
+			if err != nil {
+				return fmt.Errorf("invalid prefix: %w", err)
+			}
+			decoder, err := storeDecoderFromCmd(cmd, storeDecoders, args[0])
+			if err != nil {
+				return err
+			}
+			db, version, err := openDBAtVersionFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			limit, _ := cmd.Flags().GetInt(flagLimit)
+			output, _ := cmd.Flags().GetString(ostcli.OutputFlag)
+			return iterateStore(cmd.OutOrStdout(), db, version, args[0], prefix, limit, decoder, output)
+		},
+	}
+
+	cmd.Flags().String(flagPrefix, "", "Hex encoded prefix of the keys")
+	cmd.Flags().Int(flagLimit, 0, "Maximum number of pairs to print, 0 for no limit")
+	cmd.Flags().Bool(flagDecode, false, "Decode the values with the store decoders of the app")
+
+	return cmd
+}
+
+// GetStoreValueCmd returns a command that prints the value of a key of a store.
+func GetStoreValueCmd(storeDecoders StoreDecodersFn) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get [store] [key]",
+		Short: "Print the value of a hex encoded key of a store",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid key: %w", err)
+			}
+			decoder, err := storeDecoderFromCmd(cmd, storeDecoders, args[0])
+			if err != nil {
+				return err
+			}
+			db, version, err := openDBAtVersionFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			output, _ := cmd.Flags().GetString(ostcli.OutputFlag)
+			return getStoreValue(cmd.OutOrStdout(), db, version, args[0], key, decoder, output)
+		},
+	}
+
+	cmd.Flags().Bool(flagDecode, false, "Decode the value with the store decoders of the app")
+
+	return cmd
+}
+
+// openDBAtVersionFromCmd opens the app DB of the home directory, and returns it with
+// the version given by the height flag, or the latest version.
+func openDBAtVersionFromCmd(cmd *cobra.Command) (tmdb.DB, int64, error) {
+	homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+	db, err := openDBFromCmd(cmd, homeDir)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	version, _ := cmd.Flags().GetInt64(FlagHeight)
+	if version == 0 {
+		version = rootmulti.GetLatestVersion(db)
+	}
+	if version <= 0 {
+		db.Close()
+		return nil, 0, fmt.Errorf("the app state is empty")
+	}
+	return db, version, nil
+}
+
+// openDBFromCmd opens the app DB of a home directory with openDBReadOnly, or a copy
+// of it if the copy-db flag is set.
+func openDBFromCmd(cmd *cobra.Command, homeDir string) (tmdb.DB, error) {
+	dbBackend := GetServerContextFromCmd(cmd).Viper.GetString(FlagDBBackend)
+	if copyDB, _ := cmd.Flags().GetBool(flagCopyDB); copyDB {
+		return openDBCopy(filepath.Join(homeDir, "data"), dbBackend)
+	}
+	return openDBReadOnly(homeDir, dbBackend)
+}
+
+// readOnlyDBOpeners open an existing DB in read-only mode, by backend. The backends
+// compiled in with a build tag register theirs in the file of the build tag.
+var readOnlyDBOpeners = map[metadb.BackendType]func(name, dir string) (tmdb.DB, error){
+	metadb.GoLevelDBBackend: func(name, dir string) (tmdb.DB, error) {
+		return goleveldb.NewDBWithOpts(name, dir, &opt.Options{ReadOnly: true, ErrorIfMissing: true})
+	},
+}
+
+// openDBReadOnly opens the app DB of a home directory in place. DBs of backends with a
+// read-only mode, goleveldb and badgerdb, are opened in it. The other backends have no
+// such mode, so their DBs are opened as usual: the node must be stopped, and the backend
+// may e.g. compact the DB, although nothing is written to the app state.
+func openDBReadOnly(rootDir, dbBackend string) (tmdb.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	if open, ok := readOnlyDBOpeners[metadb.BackendType(defaultDBBackend(dbBackend))]; ok {
+		return open("application", dataDir)
+	}
+
+	// the backend would create a missing DB
+	if _, err := appDBFiles(dataDir); err != nil {
+		return nil, err
+	}
+	return sdk.NewDB("application", dbBackend, dataDir)
+}
+
+// dbCopy is a copy of a DB in a temporary directory.
+type dbCopy struct {
+	tmdb.DB
+	dir string
+}
+
+// Close implements tmdb.DB, removing the copy.
+func (db dbCopy) Close() error {
+	err := db.DB.Close()
+	if rmErr := os.RemoveAll(db.dir); err == nil {
+		err = rmErr
+	}
+	return err
+}
+
+// appDBFiles returns the files of the app DB of a data directory, e.g. application.db.
+func appDBFiles(dataDir string) ([]os.FileInfo, error) {
+	files, err := ioutil.ReadDir(dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var dbFiles []os.FileInfo
+	for _, f := range files {
+		if f.Name() == "application" || strings.HasPrefix(f.Name(), "application.") {
+			dbFiles = append(dbFiles, f)
+		}
+	}
+	if len(dbFiles) == 0 {
+		return nil, fmt.Errorf("no app DB found in %s", dataDir)
+	}
+	return dbFiles, nil
+}
+
+// openDBCopy copies the files of the app DB of a data directory to a temporary
+// directory and opens the copy.
+func openDBCopy(dataDir, dbBackend string) (tmdb.DB, error) {
+	files, err := appDBFiles(dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpDir, err := ioutil.TempDir("", "debug-store")
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		if err := copyPath(filepath.Join(dataDir, f.Name()), filepath.Join(tmpDir, f.Name())); err != nil {
+			os.RemoveAll(tmpDir)
+			return nil, err
+		}
+	}
+
+	db, err := sdk.NewDB("application", dbBackend, tmpDir)
+	if err != nil {
+		os.RemoveAll(tmpDir)
+		return nil, err
+	}
+	return dbCopy{DB: db, dir: tmpDir}, nil
+}
+
+// copyPath copies a file or a directory recursively.
+func copyPath(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if info.IsDir() {
+			return os.MkdirAll(target, info.Mode().Perm())
+		}
+
+		bz, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(target, bz, info.Mode().Perm())
+	})
+}
+
+// storeDecodersFromCmd returns the value decoders of the stores if the decode flag is
+// set.
+func storeDecodersFromCmd(cmd *cobra.Command, storeDecoders StoreDecodersFn) (map[string]valueDecoder, error) {
+	if decode, _ := cmd.Flags().GetBool(flagDecode); !decode {
+		return nil, nil
+	}
+
+	if storeDecoders == nil {
+		return nil, fmt.Errorf("the app has no store decoders")
+	}
+	registry, err := storeDecoders()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the store decoders: %w", err)
+	}
+	return newStoreDecoders(registry), nil
+}
+
+// storeDecoderFromCmd returns the value decoder of a store if the decode flag is set.
+func storeDecoderFromCmd(cmd *cobra.Command, storeDecoders StoreDecodersFn, storeName string) (valueDecoder, error) {
+	decoders, err := storeDecodersFromCmd(cmd, storeDecoders)
+	if err != nil || decoders == nil {
+		return nil, err
+	}
+
+	decoder, ok := decoders[storeName]
+	if !ok {
+		return nil, fmt.Errorf("no store decoder registered for store %s", storeName)
+	}
+	return decoder, nil
+}
+
+// newStoreDecoders returns the value decoders of the stores of a store decoder registry.
+func newStoreDecoders(registry sdk.StoreDecoderRegistry) map[string]valueDecoder {
+	decoders := make(map[string]valueDecoder, len(registry))
+	for name, decode := range registry {
+		decode := decode
+		decoders[name] = func(key, value []byte) string {
+			return decodeValue(decode, key, value)
+		}
+	}
+	return decoders
+}
+
+// decodeValue decodes a value with a store decoder, and returns an empty string if the
+// decoder doesn't know the key, or the value isn't of the type of the key. Store
+// decoders print the values of two pairs on their own lines, so the value is given as
+// both pairs and printed once.
+func decodeValue(decode func(kvA, kvB kv.Pair) string, key, value []byte) (decoded string) {
+	// store decoders panic on keys they don't know and values they can't unmarshal
+	defer func() {
+		if r := recover(); r != nil {
+			decoded = ""
+		}
+	}()
+
+	pair := kv.Pair{Key: key, Value: value}
+	decoded = decode(pair, pair)
+	if n := len(decoded); n%2 == 1 && decoded[n/2] == '\n' && decoded[:n/2] == decoded[n/2+1:] {
+		return decoded[:n/2]
+	}
+	return decoded
+}
+
+// openMultiStore returns the stores of the app state at a version along with their keys,
+// branched so that nothing can be written to the DB.
+func openMultiStore(db tmdb.DB, version int64) (sdk.CacheMultiStore, map[string]sdk.StoreKey, error) {
+	rs, err := rootmulti.NewStoreFromCommitInfo(db, version)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rs.SetLazyLoading(true)
+	if err := rs.LoadVersion(version); err != nil {
+		return nil, nil, err
+	}
+	cms, err := rs.CacheMultiStoreWithVersion(version)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cms, rs.StoreKeysByName(), nil
+}
+
+// openKVStore returns a store of the app state at a version, branched so that nothing
+// can be written to the DB.
+func openKVStore(db tmdb.DB, version int64, storeName string) (sdk.KVStore, error) {
+	cms, keys, err := openMultiStore(db, version)
+	if err != nil {
+		return nil, err
+	}
+	key, ok := keys[storeName]
+	if !ok {
+		return nil, fmt.Errorf("store %s not found at version %d", storeName, version)
+	}
+	return cms.GetKVStore(key), nil
+}
+
+func listStores(w io.Writer, db tmdb.DB, version int64, output string) error {
+	cInfo, err := rootmulti.GetCommitInfo(db, version)
+	if err != nil {
+		return err
+	}
+	infos := cInfo.StoreInfos
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+
+	for _, info := range infos {
+		if output == "json" {
+			err = printJSONLine(w, struct {
+				Name    string            `json:"name"`
+				Version int64             `json:"version"`
+				Hash    ostbytes.HexBytes `json:"hash"`
+			}{info.Name, info.CommitId.Version, info.CommitId.Hash})
+		} else {
+			_, err = fmt.Fprintf(w, "%s version: %d hash: %X\n", info.Name, info.CommitId.Version, info.CommitId.Hash)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func iterateStore(
+	w io.Writer, db tmdb.DB, version int64, storeName string, prefix []byte, limit int,
+	decoder valueDecoder, output string,
+) error {
+	store, err := openKVStore(db, version, storeName)
+	if err != nil {
+		return err
+	}
+
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for count := 0; iter.Valid() && (limit <= 0 || count < limit); iter.Next() {
+		entry := newStoreEntry(iter.Key(), iter.Value(), decoder)
+		if err := printStoreEntry(w, entry, output); err != nil {
+			return err
+		}
+		count++
+	}
+	return nil
+}
+
+func getStoreValue(
+	w io.Writer, db tmdb.DB, version int64, storeName string, key []byte,
+	decoder valueDecoder, output string,
+) error {
+	store, err := openKVStore(db, version, storeName)
+	if err != nil {
+		return err
+	}
+
+	value := store.Get(key)
+	if value == nil {
+		return fmt.Errorf("key %X not found in store %s at version %d", key, storeName, version)
+	}
+	return printStoreEntry(w, newStoreEntry(key, value, decoder), output)
+}
+
+func newStoreEntry(key, value []byte, decoder valueDecoder) storeEntry {
+	entry := storeEntry{Key: key, Value: value}
+	if decoder != nil {
+		entry.Decoded = decoder(key, value)
+	}
+	return entry
+}
+
+func printStoreEntry(w io.Writer, entry storeEntry, output string) error {
+	if output == "json" {
+		return printJSONLine(w, entry)
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "key: %X\nvalue: %X\n", []byte(entry.Key), []byte(entry.Value))
+	if entry.Decoded != "" {
+		fmt.Fprintf(&sb, "decoded: %s\n", entry.Decoded)
+	}
+	_, err := fmt.Fprint(w, sb.String())
+	return err
+}
+
+func printJSONLine(w io.Writer, v interface{}) error {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(bz))
+	return err
+}
@@ -0,0 +1,21 @@
+// +build badgerdb
+
+package server
+
+import (
+	"path/filepath"
+
+	"github.com/dgraph-io/badger/v2"
+	tmdb "github.com/line/tm-db/v2"
+	"github.com/line/tm-db/v2/badgerdb"
+	"github.com/line/tm-db/v2/metadb"
+)
+
+func init() {
+	readOnlyDBOpeners[metadb.BadgerDBBackend] = func(name, dir string) (tmdb.DB, error) {
+		// badgerdb.NewDB keeps a DB in a directory with the name of the DB
+		opts := badger.DefaultOptions(filepath.Join(dir, name)).WithReadOnly(true)
+		opts.Logger = nil
+		return badgerdb.NewDBWithOptions(opts)
+	}
+}
@@ -0,0 +1,31 @@
+// +build badgerdb
+
+package server
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/line/tm-db/v2/badgerdb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenDBReadOnly_BadgerDB(t *testing.T) {
+	home := t.TempDir()
+	bdb, err := badgerdb.NewDB("application", filepath.Join(home, "data"))
+	require.NoError(t, err)
+	require.NoError(t, bdb.Set([]byte("key"), []byte("value")))
+	require.NoError(t, bdb.Close())
+
+	// badgerdb DBs are opened read-only
+	db, err := openDBReadOnly(home, "badgerdb")
+	require.NoError(t, err)
+	value, err := db.Get([]byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+	require.Error(t, db.Set([]byte("key"), []byte("other")))
+	require.NoError(t, db.Close())
+
+	_, err = openDBReadOnly(t.TempDir(), "badgerdb")
+	require.Error(t, err)
+}
@@ -0,0 +1,259 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+
+	ostbytes "github.com/line/ostracon/libs/bytes"
+	ostcli "github.com/line/ostracon/libs/cli"
+	tmdb "github.com/line/tm-db/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/line/lfb-sdk/client/flags"
+	"github.com/line/lfb-sdk/store/rootmulti"
+	storetypes "github.com/line/lfb-sdk/store/types"
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+const (
+	flagOtherHome   = "other-home"
+	flagOtherHeight = "other-height"
+
+	diffAdded   = "added"
+	diffRemoved = "removed"
+	diffChanged = "changed"
+)
+
+// storeDiff is a difference between two app states, A and B. It is a key of a store
+// that was added, removed or changed from A to B, or a whole store if the key is empty.
+type storeDiff struct {
+	Store   string            `json:"store"`
+	Type    string            `json:"type"`
+	Key     ostbytes.HexBytes `json:"key,omitempty"`
+	ValueA  ostbytes.HexBytes `json:"value_a,omitempty"`
+	ValueB  ostbytes.HexBytes `json:"value_b,omitempty"`
+	Decoded string            `json:"decoded,omitempty"`
+}
+
+// DiffStoresCmd returns a command that compares two versions of the app state, of the
+// same node or of two nodes.
+func DiffStoresCmd(storeDecoders StoreDecodersFn) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Compare two versions of the app state, of the same node or of two nodes",
+		Long: `Compare the app state of the home directory at --height (A) with the app state of
+--other-home at --other-height (B), and print the keys added, removed and changed
+from A to B, store by store. By default, the other home directory is the same one,
+and both heights are the latest ones, so at least one of them must be given.
+
+Stores with the same commit hash in both app states are skipped. With --decode, the
+values are also decoded by the store decoders of the modules of the stores,
+e.g. to find out why two nodes computed different app hashes for the same block.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			decoders, err := storeDecodersFromCmd(cmd, storeDecoders)
+			if err != nil {
+				return err
+			}
+			dbA, versionA, err := openDBAtVersionFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+			defer dbA.Close()
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			otherHomeDir, _ := cmd.Flags().GetString(flagOtherHome)
+			dbB := dbA
+			if otherHomeDir != "" && filepath.Clean(otherHomeDir) != filepath.Clean(homeDir) {
+				dbB, err = openDBFromCmd(cmd, otherHomeDir)
+				if err != nil {
+					return err
+				}
+				defer dbB.Close()
+			}
+			versionB, _ := cmd.Flags().GetInt64(flagOtherHeight)
+			if versionB == 0 {
+				versionB = rootmulti.GetLatestVersion(dbB)
+			}
+
+			output, _ := cmd.Flags().GetString(ostcli.OutputFlag)
+			return diffStores(cmd.OutOrStdout(), dbA, versionA, dbB, versionB, decoders, output)
+		},
+	}
+
+	cmd.Flags().String(flagOtherHome, "", "The home directory of the other app state, defaults to the home directory")
+	cmd.Flags().Int64(flagOtherHeight, 0, "Version of the other app state, defaults to the latest one")
+	cmd.Flags().Bool(flagDecode, false, "Decode the values with the store decoders of the app")
+
+	return cmd
+}
+
+// diffStores prints the differences between the app state of dbA at versionA and the
+// app state of dbB at versionB. Only the stores whose commit hashes differ are walked.
+func diffStores(
+	w io.Writer, dbA tmdb.DB, versionA int64, dbB tmdb.DB, versionB int64,
+	decoders map[string]valueDecoder, output string,
+) error {
+	infosA, err := storeInfosByName(dbA, versionA)
+	if err != nil {
+		return err
+	}
+	infosB, err := storeInfosByName(dbB, versionB)
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	for name := range infosA {
+		names = append(names, name)
+	}
+	for name := range infosB {
+		if _, ok := infosA[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var cmsA, cmsB sdk.CacheMultiStore
+	var keysA, keysB map[string]sdk.StoreKey
+	count := 0
+	report := func(diff storeDiff) error {
+		count++
+		return printStoreDiff(w, diff, output)
+	}
+	for _, name := range names {
+		infoA, okA := infosA[name]
+		infoB, okB := infosB[name]
+		switch {
+		case !okA:
+			err = report(storeDiff{Store: name, Type: diffAdded})
+		case !okB:
+			err = report(storeDiff{Store: name, Type: diffRemoved})
+		case bytes.Equal(infoA.CommitId.Hash, infoB.CommitId.Hash):
+			continue
+		default:
+			// the stores are only loaded if there are differences
+			if cmsA == nil {
+				if cmsA, keysA, err = openMultiStore(dbA, versionA); err != nil {
+					return err
+				}
+				if cmsB, keysB, err = openMultiStore(dbB, versionB); err != nil {
+					return err
+				}
+			}
+			keyA, keyB := keysA[name], keysB[name]
+			if keyA == nil || keyB == nil {
+				return fmt.Errorf("store %s isn't versioned, and can't be compared", name)
+			}
+			err = diffKVStores(name, cmsA.GetKVStore(keyA), cmsB.GetKVStore(keyB), decoders[name], report)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if count == 0 && output != "json" {
+		_, err = fmt.Fprintln(w, "The app states are identical")
+	}
+	return err
+}
+
+// diffKVStores walks two stores in the order of their keys, and reports each key that
+// differs.
+func diffKVStores(
+	name string, storeA, storeB sdk.KVStore, decoder valueDecoder,
+	report func(storeDiff) error,
+) error {
+	iterA := storeA.Iterator(nil, nil)
+	defer iterA.Close()
+	iterB := storeB.Iterator(nil, nil)
+	defer iterB.Close()
+
+	for iterA.Valid() || iterB.Valid() {
+		diff := storeDiff{Store: name}
+		nextA, nextB := false, false
+		switch {
+		case !iterB.Valid() || (iterA.Valid() && bytes.Compare(iterA.Key(), iterB.Key()) < 0):
+			diff.Type, diff.Key, diff.ValueA = diffRemoved, iterA.Key(), iterA.Value()
+			nextA = true
+		case !iterA.Valid() || bytes.Compare(iterA.Key(), iterB.Key()) > 0:
+			diff.Type, diff.Key, diff.ValueB = diffAdded, iterB.Key(), iterB.Value()
+			nextB = true
+		case bytes.Equal(iterA.Value(), iterB.Value()):
+			nextA, nextB = true, true
+		default:
+			diff.Type, diff.Key, diff.ValueA, diff.ValueB = diffChanged, iterA.Key(), iterA.Value(), iterB.Value()
+			nextA, nextB = true, true
+		}
+
+		// the difference is reported before moving the iterators, which may reuse the
+		// keys and values
+		if diff.Type != "" {
+			if decoder != nil {
+				diff.Decoded = decodeStoreDiff(decoder, diff)
+			}
+			if err := report(diff); err != nil {
+				return err
+			}
+		}
+		if nextA {
+			iterA.Next()
+		}
+		if nextB {
+			iterB.Next()
+		}
+	}
+	return nil
+}
+
+// decodeStoreDiff decodes the values of a difference. Both values of a changed key are
+// decoded, on their own lines.
+func decodeStoreDiff(decoder valueDecoder, diff storeDiff) string {
+	switch diff.Type {
+	case diffAdded:
+		return decoder(diff.Key, diff.ValueB)
+	case diffRemoved:
+		return decoder(diff.Key, diff.ValueA)
+	}
+
+	decodedA, decodedB := decoder(diff.Key, diff.ValueA), decoder(diff.Key, diff.ValueB)
+	if decodedA == "" && decodedB == "" {
+		return ""
+	}
+	return decodedA + "\n" + decodedB
+}
+
+func storeInfosByName(db tmdb.DB, version int64) (map[string]storetypes.StoreInfo, error) {
+	cInfo, err := rootmulti.GetCommitInfo(db, version)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make(map[string]storetypes.StoreInfo, len(cInfo.StoreInfos))
+	for _, info := range cInfo.StoreInfos {
+		infos[info.Name] = info
+	}
+	return infos, nil
+}
+
+func printStoreDiff(w io.Writer, diff storeDiff, output string) error {
+	if output == "json" {
+		return printJSONLine(w, diff)
+	}
+
+	var err error
+	switch {
+	case len(diff.Key) == 0:
+		_, err = fmt.Fprintf(w, "%s: store %s\n", diff.Store, diff.Type)
+	case diff.Decoded != "":
+		_, err = fmt.Fprintf(w, "%s: %s key %X\nA: %X\nB: %X\ndecoded:\n%s\n",
+			diff.Store, diff.Type, []byte(diff.Key), []byte(diff.ValueA), []byte(diff.ValueB), diff.Decoded)
+	default:
+		_, err = fmt.Fprintf(w, "%s: %s key %X\nA: %X\nB: %X\n",
+			diff.Store, diff.Type, []byte(diff.Key), []byte(diff.ValueA), []byte(diff.ValueB))
+	}
+	return err
+}
@@ -0,0 +1,269 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	tmdb "github.com/line/tm-db/v2"
+	"github.com/line/tm-db/v2/goleveldb"
+	"github.com/line/tm-db/v2/memdb"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/codec"
+	codectypes "github.com/line/lfb-sdk/codec/types"
+	"github.com/line/lfb-sdk/store/rootmulti"
+	storetypes "github.com/line/lfb-sdk/store/types"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/types/kv"
+)
+
+// makeAppDB commits two versions of an app state with a bank and an acc store.
+func makeAppDB(t *testing.T) tmdb.DB {
+	db := memdb.NewDB()
+	rs := rootmulti.NewStore(db)
+	bankKey, accKey := storetypes.NewKVStoreKey("bank"), storetypes.NewKVStoreKey("acc")
+	rs.MountStoreWithDB(bankKey, storetypes.StoreTypeIAVL, nil)
+	rs.MountStoreWithDB(accKey, storetypes.StoreTypeIAVL, nil)
+	rs.MountStoreWithDB(storetypes.NewMemoryStoreKey("mem"), storetypes.StoreTypeMemory, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+
+	bank := rs.GetKVStore(bankKey)
+	bank.Set([]byte{0x01, 0x01}, []byte("a"))
+	bank.Set([]byte{0x01, 0x02}, []byte("b"))
+	bank.Set([]byte{0x02, 0x01}, []byte("c"))
+	rs.GetKVStore(accKey).Set([]byte{0x01}, []byte("acc"))
+	rs.Commit()
+
+	bank.Set([]byte{0x01, 0x01}, []byte("A"))
+	bank.Delete([]byte{0x02, 0x01})
+	rs.Commit()
+
+	return db
+}
+
+// testDecoder decodes the values of the bank store with the 0x01 prefix.
+func testDecoder(key, value []byte) string {
+	if key[0] != 0x01 {
+		return ""
+	}
+	return string(value)
+}
+
+func TestListStores(t *testing.T) {
+	db := makeAppDB(t)
+
+	out := &bytes.Buffer{}
+	require.NoError(t, listStores(out, db, 2, "text"))
+	cInfo, err := rootmulti.GetCommitInfo(db, 2)
+	require.NoError(t, err)
+	var bankHash, accHash []byte
+	for _, info := range cInfo.StoreInfos {
+		switch info.Name {
+		case "bank":
+			bankHash = info.CommitId.Hash
+		case "acc":
+			accHash = info.CommitId.Hash
+		}
+	}
+	require.Equal(t, fmt.Sprintf("acc version: 2 hash: %X\nbank version: 2 hash: %X\nmem version: 0 hash: \n", accHash, bankHash), out.String())
+
+	out.Reset()
+	require.NoError(t, listStores(out, db, 1, "json"))
+	lines := bytes.Split(bytes.TrimSpace(out.Bytes()), []byte("\n"))
+	require.Len(t, lines, 3)
+	require.Contains(t, string(lines[1]), `"name":"bank","version":1`)
+
+	require.Error(t, listStores(out, db, 3, "text"))
+}
+
+func TestIterateStore(t *testing.T) {
+	db := makeAppDB(t)
+
+	testCases := []struct {
+		name     string
+		version  int64
+		prefix   []byte
+		limit    int
+		decoder  valueDecoder
+		output   string
+		expected string
+	}{
+		{
+			"latest version", 2, nil, 0, nil, "text",
+			"key: 0101\nvalue: 41\nkey: 0102\nvalue: 62\n",
+		},
+		{
+			"past version", 1, nil, 0, nil, "text",
+			"key: 0101\nvalue: 61\nkey: 0102\nvalue: 62\nkey: 0201\nvalue: 63\n",
+		},
+		{
+			"prefix", 1, []byte{0x02}, 0, nil, "text",
+			"key: 0201\nvalue: 63\n",
+		},
+		{
+			"limit", 1, nil, 1, nil, "text",
+			"key: 0101\nvalue: 61\n",
+		},
+		{
+			"decoded", 1, nil, 0, testDecoder, "text",
+			"key: 0101\nvalue: 61\ndecoded: a\nkey: 0102\nvalue: 62\ndecoded: b\nkey: 0201\nvalue: 63\n",
+		},
+		{
+			"json", 2, []byte{0x01}, 1, testDecoder, "json",
+			`{"key":"0101","value":"41","decoded":"A"}` + "\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			require.NoError(t, iterateStore(out, db, tc.version, "bank", tc.prefix, tc.limit, tc.decoder, tc.output))
+			require.Equal(t, tc.expected, out.String())
+		})
+	}
+
+	require.Error(t, iterateStore(&bytes.Buffer{}, db, 2, "mem", nil, 0, nil, "text"))
+	require.Error(t, iterateStore(&bytes.Buffer{}, db, 2, "staking", nil, 0, nil, "text"))
+	require.Error(t, iterateStore(&bytes.Buffer{}, db, 3, "bank", nil, 0, nil, "text"))
+}
+
+func TestGetStoreValue(t *testing.T) {
+	db := makeAppDB(t)
+
+	out := &bytes.Buffer{}
+	require.NoError(t, getStoreValue(out, db, 1, "bank", []byte{0x02, 0x01}, nil, "text"))
+	require.Equal(t, "key: 0201\nvalue: 63\n", out.String())
+
+	out.Reset()
+	require.NoError(t, getStoreValue(out, db, 2, "acc", []byte{0x01}, nil, "json"))
+	require.Equal(t, `{"key":"01","value":"616363"}`+"\n", out.String())
+
+	require.Error(t, getStoreValue(out, db, 2, "bank", []byte{0x02, 0x01}, nil, "text"))
+}
+
+func TestStoreDecoders(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	// bankDecoder decodes values like the store decoders of the modules
+	bankDecoder := func(kvA, kvB kv.Pair) string {
+		switch kvA.Key[0] {
+		case 0x01:
+			var coinA, coinB sdk.Coin
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &coinA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &coinB)
+			return fmt.Sprintf("%v\n%v", coinA, coinB)
+		case 0x03:
+			return string(kvA.Value)
+		default:
+			panic(fmt.Sprintf("invalid bank key prefix %X", kvA.Key[:1]))
+		}
+	}
+	decoders := newStoreDecoders(sdk.StoreDecoderRegistry{"bank": bankDecoder})
+	require.Len(t, decoders, 1)
+
+	// the value is printed once
+	coin := sdk.NewInt64Coin("stake", 10)
+	require.Equal(t, "10stake", decoders["bank"]([]byte{0x01, 0x01}, cdc.MustMarshalBinaryBare(&coin)))
+
+	// decoders printing a single value are supported
+	require.Equal(t, "value", decoders["bank"]([]byte{0x03}, []byte("value")))
+
+	// unknown keys and invalid values aren't decoded
+	require.Equal(t, "", decoders["bank"]([]byte{0x02, 0x01}, []byte("c")))
+	require.Equal(t, "", decoders["bank"]([]byte{0x01, 0x01}, []byte{0xff}))
+	require.Equal(t, "", decoders["bank"](nil, []byte("c")))
+}
+
+func TestOpenDBReadOnly(t *testing.T) {
+	home := t.TempDir()
+	dataDir := filepath.Join(home, "data")
+	ldb, err := goleveldb.NewDB("application", dataDir)
+	require.NoError(t, err)
+	require.NoError(t, ldb.Set([]byte("key"), []byte("value")))
+	require.NoError(t, ldb.Close())
+
+	// goleveldb DBs are opened read-only
+	db, err := openDBReadOnly(home, "goleveldb")
+	require.NoError(t, err)
+	value, err := db.Get([]byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+	require.Error(t, db.Set([]byte("key"), []byte("other")))
+	require.NoError(t, db.Close())
+
+	// a missing DB isn't created, even by backends without a read-only mode
+	_, err = openDBReadOnly(t.TempDir(), "goleveldb")
+	require.Error(t, err)
+	_, err = openDBReadOnly(t.TempDir(), "memdb")
+	require.Error(t, err)
+
+	// with --copy-db, the DB is copied, and changes to the copy are dropped
+	db, err = openDBCopy(dataDir, "goleveldb")
+	require.NoError(t, err)
+	require.NoError(t, db.Set([]byte("key"), []byte("other")))
+	copyDir := db.(dbCopy).dir
+	require.NoError(t, db.Close())
+	_, err = os.Stat(copyDir)
+	require.True(t, os.IsNotExist(err))
+
+	ldb, err = goleveldb.NewDB("application", dataDir)
+	require.NoError(t, err)
+	value, err = ldb.Get([]byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+	require.NoError(t, ldb.Close())
+
+	_, err = openDBCopy(t.TempDir(), "goleveldb")
+	require.Error(t, err)
+}
+
+func TestDiffStores(t *testing.T) {
+	db := makeAppDB(t)
+
+	out := &bytes.Buffer{}
+	require.NoError(t, diffStores(out, db, 1, db, 2, nil, "text"))
+	require.Equal(t, "bank: changed key 0101\nA: 61\nB: 41\nbank: removed key 0201\nA: 63\nB: \n", out.String())
+
+	out.Reset()
+	require.NoError(t, diffStores(out, db, 2, db, 1, map[string]valueDecoder{"bank": testDecoder}, "json"))
+	require.Equal(t,
+		`{"store":"bank","type":"changed","key":"0101","value_a":"41","value_b":"61","decoded":"A\na"}`+"\n"+
+			`{"store":"bank","type":"added","key":"0201","value_b":"63"}`+"\n",
+		out.String())
+
+	out.Reset()
+	require.NoError(t, diffStores(out, db, 2, db, 2, nil, "text"))
+	require.Equal(t, "The app states are identical\n", out.String())
+	out.Reset()
+	require.NoError(t, diffStores(out, db, 2, db, 2, nil, "json"))
+	require.Empty(t, out.String())
+
+	require.Error(t, diffStores(out, db, 2, db, 3, nil, "text"))
+}
+
+func TestDiffStores_OtherDB(t *testing.T) {
+	db := makeAppDB(t)
+
+	// another node has an additional store, and a different value in the acc store
+	other := memdb.NewDB()
+	rs := rootmulti.NewStore(other)
+	bankKey, accKey, extraKey := storetypes.NewKVStoreKey("bank"), storetypes.NewKVStoreKey("acc"), storetypes.NewKVStoreKey("extra")
+	rs.MountStoreWithDB(bankKey, storetypes.StoreTypeIAVL, nil)
+	rs.MountStoreWithDB(accKey, storetypes.StoreTypeIAVL, nil)
+	rs.MountStoreWithDB(extraKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+	bank := rs.GetKVStore(bankKey)
+	bank.Set([]byte{0x01, 0x01}, []byte("a"))
+	bank.Set([]byte{0x01, 0x02}, []byte("b"))
+	bank.Set([]byte{0x02, 0x01}, []byte("c"))
+	rs.GetKVStore(accKey).Set([]byte{0x01}, []byte("acc2"))
+	rs.GetKVStore(extraKey).Set([]byte{0x01}, []byte("extra"))
+	rs.Commit()
+
+	out := &bytes.Buffer{}
+	require.NoError(t, diffStores(out, db, 1, other, 1, nil, "text"))
+	require.Equal(t, "acc: changed key 01\nA: 616363\nB: 61636332\nextra: store added\nmem: store removed\n", out.String())
+}
@@ -0,0 +1,40 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/spf13/cast"
+
+	"github.com/line/lfb-sdk/baseapp"
+	"github.com/line/lfb-sdk/server/types"
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+// GetDynamicMinGasPricesFromFlags parses the dynamic minimum gas prices
+// configuration from the command flags. It returns nil if dynamic minimum gas
+// prices are disabled.
+func GetDynamicMinGasPricesFromFlags(appOpts types.AppOptions) (*baseapp.DynamicMinGasPrices, error) {
+	if !cast.ToBool(appOpts.Get(FlagDynamicMinGasPrices)) {
+		return nil, nil
+	}
+
+	var (
+		d   baseapp.DynamicMinGasPrices
+		err error
+	)
+	if d.TargetBlockFullness, err = sdk.NewDecFromStr(cast.ToString(appOpts.Get(FlagTargetBlockFullness))); err != nil {
+		return nil, fmt.Errorf("invalid target block fullness: %w", err)
+	}
+	if d.MaxChangeRate, err = sdk.NewDecFromStr(cast.ToString(appOpts.Get(FlagMaxChangeRate))); err != nil {
+		return nil, fmt.Errorf("invalid max change rate: %w", err)
+	}
+	if d.MaxMultiplier, err = sdk.NewDecFromStr(cast.ToString(appOpts.Get(FlagMaxMultiplier))); err != nil {
+		return nil, fmt.Errorf("invalid max multiplier: %w", err)
+	}
+
+	if err := d.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid dynamic minimum gas prices: %w", err)
+	}
+
+	return &d, nil
+}
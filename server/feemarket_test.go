@@ -0,0 +1,79 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/baseapp"
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+func TestGetDynamicMinGasPricesFromFlags(t *testing.T) {
+	tests := []struct {
+		name     string
+		params   map[string]interface{}
+		expected *baseapp.DynamicMinGasPrices
+		wantErr  bool
+	}{
+		{
+			name:   "disabled",
+			params: map[string]interface{}{FlagTargetBlockFullness: "invalid"},
+		},
+		{
+			name: "enabled",
+			params: map[string]interface{}{
+				FlagDynamicMinGasPrices: true,
+				FlagTargetBlockFullness: "0.5",
+				FlagMaxChangeRate:       "0.125",
+				FlagMaxMultiplier:       "10",
+			},
+			expected: &baseapp.DynamicMinGasPrices{
+				TargetBlockFullness: sdk.NewDecWithPrec(5, 1),
+				MaxChangeRate:       sdk.NewDecWithPrec(125, 3),
+				MaxMultiplier:       sdk.NewDec(10),
+			},
+		},
+		{
+			name: "invalid decimal",
+			params: map[string]interface{}{
+				FlagDynamicMinGasPrices: true,
+				FlagTargetBlockFullness: "half",
+				FlagMaxChangeRate:       "0.125",
+				FlagMaxMultiplier:       "10",
+			},
+			wantErr: true,
+		},
+		{
+			name: "out of bounds",
+			params: map[string]interface{}{
+				FlagDynamicMinGasPrices: true,
+				FlagTargetBlockFullness: "0.5",
+				FlagMaxChangeRate:       "0.125",
+				FlagMaxMultiplier:       "0.5",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			v := viper.New()
+			for key, value := range tt.params {
+				v.Set(key, value)
+			}
+
+			d, err := GetDynamicMinGasPricesFromFlags(v)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, d)
+		})
+	}
+}
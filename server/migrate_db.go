@@ -0,0 +1,185 @@
+package server
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	tmdb "github.com/line/tm-db/v2"
+	"github.com/line/tm-db/v2/metadb"
+	"github.com/spf13/cobra"
+
+	"github.com/line/lfb-sdk/client/flags"
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+const (
+	flagDBs       = "dbs"
+	flagOutputDir = "output-dir"
+
+	// migrateBatchSize is the number of pairs written per batch when migrating a DB.
+	migrateBatchSize = 10000
+)
+
+// MigrateDBCmd returns a command that copies the DBs of a stopped node to another
+// DB backend.
+func MigrateDBCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-db [target-backend]",
+		Short: "Copy the DBs of a stopped node to another DB backend",
+		Long: `Copy the DBs of a stopped node to another DB backend, e.g. from goleveldb to badgerdb.
+The app DB is read with the db-backend of app.toml, and the Ostracon DBs (blockstore,
+state, evidence, tx_index) with the db_backend of config.toml. Both backends must have
+been compiled in with their build tags.
+
+The copies are written to --output-dir, and the original DBs are left untouched. To
+switch to them, replace the DBs of the data directory with the copies, and set the
+backend in app.toml, and in config.toml if the Ostracon DBs were migrated too.`,
+		Example: "migrate-db badgerdb --dbs application,blockstore,state",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			target := args[0]
+			names, _ := cmd.Flags().GetStringSlice(flagDBs)
+			backends := []string{target}
+			for _, name := range names {
+				backends = append(backends, sourceDBBackend(serverCtx, name))
+			}
+			// nothing is opened or created before the backends are known to be compiled in
+			if err := checkDBBackends(backends...); err != nil {
+				return err
+			}
+
+			outputDir, _ := cmd.Flags().GetString(flagOutputDir)
+			if outputDir == "" {
+				outputDir = filepath.Join(config.RootDir, "data-"+target)
+			}
+			if filepath.Clean(outputDir) == filepath.Clean(config.DBDir()) {
+				return fmt.Errorf("the output directory must not be the data directory")
+			}
+			if files, err := ioutil.ReadDir(outputDir); err == nil && len(files) > 0 {
+				return fmt.Errorf("the output directory %s already exists and isn't empty", outputDir)
+			}
+			if err := os.MkdirAll(outputDir, 0755); err != nil {
+				return err
+			}
+
+			for _, name := range names {
+				srcDB, err := sdk.NewDB(name, sourceDBBackend(serverCtx, name), config.DBDir())
+				if err != nil {
+					return err
+				}
+				dstDB, err := sdk.NewDB(name, target, outputDir)
+				if err != nil {
+					srcDB.Close()
+					return err
+				}
+
+				count, err := migrateDB(srcDB, dstDB)
+				srcDB.Close()
+				dstDB.Close()
+				if err != nil {
+					return fmt.Errorf("failed to migrate %s: %w", name, err)
+				}
+				cmd.Printf("Migrated %d pairs of %s\n", count, name)
+			}
+
+			cmd.Printf(`The DBs were written to %s. To use them, move them to %s once the node is
+stopped, and set db-backend = %q in app.toml, and db_backend = %q in config.toml if
+the Ostracon DBs were migrated too.
+`, outputDir, config.DBDir(), target, target)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().StringSlice(flagDBs, []string{"application"}, "Names of the DBs of the data directory to migrate")
+	cmd.Flags().String(flagOutputDir, "", "Directory the migrated DBs are written to, defaults to data-<target-backend> in the home directory")
+
+	return cmd
+}
+
+// sourceDBBackend returns the backend of a DB of the data directory, the one of
+// app.toml for the app DB, and the one of config.toml for the Ostracon DBs.
+func sourceDBBackend(serverCtx *Context, name string) string {
+	if name == "application" {
+		return serverCtx.Viper.GetString(FlagDBBackend)
+	}
+	return serverCtx.Config.DBBackend
+}
+
+// defaultDBBackend returns the backend used by sdk.NewDB for an empty backend.
+func defaultDBBackend(backend string) string {
+	if backend == "" {
+		backend = sdk.DBBackend
+	}
+	if backend == "" {
+		backend = string(metadb.GoLevelDBBackend)
+	}
+	return backend
+}
+
+// checkDBBackends returns an error if a backend wasn't compiled in with its build tag.
+func checkDBBackends(backends ...string) error {
+	available := metadb.AvailableDBBackends()
+	names := make([]string, len(available))
+	for i, b := range available {
+		names[i] = string(b)
+	}
+
+	for _, backend := range backends {
+		backend = defaultDBBackend(backend)
+		found := false
+		for _, name := range names {
+			if name == backend {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("the %s DB backend isn't compiled in, build with -tags %s (compiled in: %s)",
+				backend, backend, strings.Join(names, ","))
+		}
+	}
+	return nil
+}
+
+// migrateDB copies all the pairs of src to dst in batches, and returns the number of
+// pairs copied.
+func migrateDB(src, dst tmdb.DB) (int, error) {
+	iter, err := src.Iterator(nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	batch := dst.NewBatch()
+	defer func() { batch.Close() }()
+
+	count := 0
+	for ; iter.Valid(); iter.Next() {
+		// iterators may reuse the keys and values, which batches may keep until written
+		if err := batch.Set(sdk.CopyBytes(iter.Key()), sdk.CopyBytes(iter.Value())); err != nil {
+			return count, err
+		}
+		count++
+		if count%migrateBatchSize == 0 {
+			if err := batch.Write(); err != nil {
+				return count, err
+			}
+			batch.Close()
+			batch = dst.NewBatch()
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return count, err
+	}
+	return count, batch.WriteSync()
+}
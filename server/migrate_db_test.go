@@ -0,0 +1,41 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/line/tm-db/v2/memdb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateDB(t *testing.T) {
+	// more pairs than a batch holds
+	n := migrateBatchSize + 10
+	src := memdb.NewDB()
+	for i := 0; i < n; i++ {
+		require.NoError(t, src.Set([]byte(fmt.Sprintf("key%06d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+
+	dst := memdb.NewDB()
+	count, err := migrateDB(src, dst)
+	require.NoError(t, err)
+	require.Equal(t, n, count)
+	require.Equal(t, fmt.Sprint(n), dst.Stats()["database.size"])
+	for _, i := range []int{0, migrateBatchSize - 1, migrateBatchSize, n - 1} {
+		value, err := dst.Get([]byte(fmt.Sprintf("key%06d", i)))
+		require.NoError(t, err)
+		require.Equal(t, fmt.Sprintf("value%d", i), string(value))
+	}
+
+	count, err = migrateDB(memdb.NewDB(), memdb.NewDB())
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func TestCheckDBBackends(t *testing.T) {
+	require.NoError(t, checkDBBackends("goleveldb", ""))
+
+	err := checkDBBackends("goleveldb", "unknown")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "-tags unknown")
+}
@@ -0,0 +1,246 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/line/ostracon/node"
+	oststateproto "github.com/line/ostracon/proto/ostracon/state"
+	oststoreproto "github.com/line/ostracon/proto/ostracon/store"
+	sm "github.com/line/ostracon/state"
+	oststore "github.com/line/ostracon/store"
+	tmdb "github.com/line/tm-db/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/line/lfb-sdk/client/flags"
+	"github.com/line/lfb-sdk/store/rootmulti"
+)
+
+// RollbackCmd returns a command that rolls back the app state and the Ostracon
+// state of a stopped node to an earlier height, so that the node replays the
+// blocks after it when it restarts.
+func RollbackCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Roll back the app and Ostracon state to an earlier height",
+		Long: `Roll back the app state and the Ostracon state to an earlier height, by default
+one height below the latest one, to recover from a bad app hash (e.g. after a
+non-deterministic upgrade) without a full resync. The node must be stopped.
+
+The versions of the app state after the height are deleted, and the block following
+the height is replayed when the node restarts. Blocks after it are deleted from the
+block store, and are fetched again from peers. The app state of the height must not
+have been pruned.
+
+If rolling back the app state fails partway, e.g. as the node is out of disk
+space, the node can't start until the command is run again and completes the
+rollback to the same height.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			db, err := openDB(config.RootDir, serverCtx.Viper.GetString(FlagDBBackend))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: config})
+			if err != nil {
+				return err
+			}
+			defer blockStoreDB.Close()
+
+			stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: config})
+			if err != nil {
+				return err
+			}
+			defer stateDB.Close()
+
+			latest := rootmulti.GetLatestVersion(db)
+			height, _ := cmd.Flags().GetInt64(FlagHeight)
+			// an interrupted rollback may have rolled back some stores already, so
+			// it must be completed before rolling back to another height
+			if rollback := rootmulti.GetRollbackVersion(db); rollback != 0 {
+				if height != 0 && height != rollback {
+					return fmt.Errorf("a rollback to height %d was interrupted and must be run again first", rollback)
+				}
+				height = rollback
+			}
+			if height == 0 {
+				height = latest - 1
+			}
+
+			// the Ostracon state is built before anything is deleted, so that
+			// nothing is rolled back if it can't be
+			blockStore := oststore.NewBlockStore(blockStoreDB)
+			state, err := rollbackOstraconState(stateDB, blockStore, height)
+			if err != nil {
+				return fmt.Errorf("failed to roll back Ostracon state: %w", err)
+			}
+
+			ms, err := rootmulti.NewStoreFromCommitInfo(db, latest)
+			if err != nil {
+				return err
+			}
+			// the stores are loaded at the height, as the ones rolled back by an
+			// interrupted rollback no longer have the latest version
+			if err := ms.LoadVersion(height); err != nil {
+				return err
+			}
+			if err := ms.RollbackToVersion(height); err != nil {
+				return fmt.Errorf("failed to roll back app state: %w", err)
+			}
+
+			// if this fails, the app state is behind the Ostracon state, and the
+			// blocks after the height are replayed anyway
+			if err := sm.NewStore(stateDB).Save(state); err != nil {
+				return err
+			}
+			if err := truncateBlockStore(blockStoreDB, blockStore, height+1); err != nil {
+				return err
+			}
+
+			fmt.Printf("Rolled back state to height %d and hash %X\n", height, state.AppHash)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(FlagHeight, 0, "Height to roll back to, defaults to one below the latest height")
+
+	return cmd
+}
+
+// rollbackOstraconState rebuilds the Ostracon state after the block of the
+// given height from the state and block stores, without saving it.
+func rollbackOstraconState(stateDB tmdb.DB, blockStore *oststore.BlockStore, height int64) (sm.State, error) {
+	stateStore := sm.NewStore(stateDB)
+	latest, err := stateStore.Load()
+	if err != nil {
+		return sm.State{}, err
+	}
+	if latest.IsEmpty() {
+		return sm.State{}, errors.New("no state found")
+	}
+
+	switch {
+	case height == latest.LastBlockHeight:
+		return latest, nil
+	case height > latest.LastBlockHeight:
+		return sm.State{}, fmt.Errorf("cannot roll back to height %d, the state is at height %d", height, latest.LastBlockHeight)
+	case height < latest.InitialHeight || height < blockStore.Base():
+		return sm.State{}, fmt.Errorf("cannot roll back to height %d, the earliest block is at height %d",
+			height, blockStore.Base())
+	}
+
+	// the app hash and the results hash of a block are in the header of the
+	// following block
+	meta, nextMeta := blockStore.LoadBlockMeta(height), blockStore.LoadBlockMeta(height+1)
+	if meta == nil || nextMeta == nil {
+		return sm.State{}, fmt.Errorf("blocks %d and %d must be in the block store", height, height+1)
+	}
+
+	lastValidators, err := stateStore.LoadValidators(height)
+	if err != nil {
+		return sm.State{}, err
+	}
+	validators, err := stateStore.LoadValidators(height + 1)
+	if err != nil {
+		return sm.State{}, err
+	}
+	nextValidators, err := stateStore.LoadValidators(height + 2)
+	if err != nil {
+		return sm.State{}, err
+	}
+	params, err := stateStore.LoadConsensusParams(height + 1)
+	if err != nil {
+		return sm.State{}, err
+	}
+
+	// the heights of the last changes are only stored along with the validators
+	// and params, which are saved for the following heights
+	valInfo := &oststateproto.ValidatorsInfo{}
+	if err := loadStateInfo(stateDB, fmt.Sprintf("validatorsKey:%d", height+2), valInfo); err != nil {
+		return sm.State{}, err
+	}
+	paramsInfo := &oststateproto.ConsensusParamsInfo{}
+	if err := loadStateInfo(stateDB, fmt.Sprintf("consensusParamsKey:%d", height+1), paramsInfo); err != nil {
+		return sm.State{}, err
+	}
+
+	return sm.State{
+		Version:       latest.Version,
+		ChainID:       latest.ChainID,
+		InitialHeight: latest.InitialHeight,
+
+		LastBlockHeight: height,
+		LastBlockID:     meta.BlockID,
+		LastBlockTime:   meta.Header.Time,
+
+		NextValidators:              nextValidators,
+		Validators:                  validators,
+		LastValidators:              lastValidators,
+		LastHeightValidatorsChanged: valInfo.LastHeightChanged,
+
+		ConsensusParams:                  params,
+		LastHeightConsensusParamsChanged: paramsInfo.LastHeightChanged,
+
+		LastResultsHash: nextMeta.Header.LastResultsHash,
+		AppHash:         nextMeta.Header.AppHash,
+	}, nil
+}
+
+func loadStateInfo(stateDB tmdb.DB, key string, info interface{ Unmarshal([]byte) error }) error {
+	bz, err := stateDB.Get([]byte(key))
+	if err != nil {
+		return err
+	}
+	if len(bz) == 0 {
+		return fmt.Errorf("%s not found in the state store", key)
+	}
+
+	return info.Unmarshal(bz)
+}
+
+// truncateBlockStore deletes the blocks after the given height from the block
+// store.
+func truncateBlockStore(db tmdb.DB, blockStore *oststore.BlockStore, height int64) error {
+	if blockStore.Height() <= height {
+		return nil
+	}
+
+	batch := db.NewBatch()
+	defer batch.Close()
+
+	for h := blockStore.Height(); h > height; h-- {
+		keys := [][]byte{
+			[]byte(fmt.Sprintf("H:%d", h)),
+			[]byte(fmt.Sprintf("C:%d", h-1)),
+			[]byte(fmt.Sprintf("SC:%d", h)),
+		}
+		if meta := blockStore.LoadBlockMeta(h); meta != nil {
+			keys = append(keys, []byte(fmt.Sprintf("BH:%x", meta.BlockID.Hash)))
+			for i := 0; i < int(meta.BlockID.PartSetHeader.Total); i++ {
+				keys = append(keys, []byte(fmt.Sprintf("P:%d:%d", h, i)))
+			}
+		}
+
+		for _, key := range keys {
+			if err := batch.Delete(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := batch.Write(); err != nil {
+		return err
+	}
+
+	oststore.SaveBlockStoreState(&oststoreproto.BlockStoreState{Base: blockStore.Base(), Height: height}, db)
+	return nil
+}
@@ -0,0 +1,131 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/line/ostracon/crypto/ed25519"
+	"github.com/line/ostracon/crypto/tmhash"
+	sm "github.com/line/ostracon/state"
+	oststore "github.com/line/ostracon/store"
+	osttypes "github.com/line/ostracon/types"
+	tmdb "github.com/line/tm-db/v2"
+	"github.com/line/tm-db/v2/memdb"
+	"github.com/stretchr/testify/require"
+)
+
+// makeChain saves the blocks and the states of a chain of the given height,
+// updating them like Ostracon does, and returns the state after each block.
+func makeChain(t *testing.T, stateDB, blockStoreDB tmdb.DB, height int64) []sm.State {
+	genesisTime := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	state, err := sm.MakeGenesisState(&osttypes.GenesisDoc{
+		ChainID:     "test-chain",
+		GenesisTime: genesisTime,
+		Validators: []osttypes.GenesisValidator{
+			{PubKey: ed25519.GenPrivKey().PubKey(), Power: 10},
+			{PubKey: ed25519.GenPrivKey().PubKey(), Power: 5},
+		},
+	})
+	require.NoError(t, err)
+
+	stateStore := sm.NewStore(stateDB)
+	blockStore := oststore.NewBlockStore(blockStoreDB)
+	require.NoError(t, stateStore.Save(state))
+
+	states := []sm.State{state}
+	lastCommit := &osttypes.Commit{}
+	for h := int64(1); h <= height; h++ {
+		block := osttypes.MakeBlock(h, nil, lastCommit, nil)
+		block.Header.Populate(
+			state.Version.Consensus, state.ChainID, genesisTime.Add(time.Duration(h)*time.Second), state.LastBlockID,
+			state.Validators.Hash(), state.NextValidators.Hash(),
+			osttypes.HashConsensusParams(state.ConsensusParams), state.AppHash, state.LastResultsHash,
+			state.Validators.Validators[0].Address,
+		)
+		parts := block.MakePartSet(osttypes.BlockPartSizeBytes)
+		blockID := osttypes.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+		lastCommit = &osttypes.Commit{Height: h, BlockID: blockID}
+		blockStore.SaveBlock(block, parts, lastCommit)
+
+		next := state.Copy()
+		next.LastBlockHeight = h
+		next.LastBlockID = blockID
+		next.LastBlockTime = block.Time
+		next.LastValidators = state.Validators.Copy()
+		next.Validators = state.NextValidators.Copy()
+		next.NextValidators = state.NextValidators.CopyIncrementProposerPriority(1)
+		// the consensus params change after the second block
+		if h == 2 {
+			next.ConsensusParams.Block.MaxGas = 1000
+			next.LastHeightConsensusParamsChanged = h + 1
+		}
+		next.AppHash = tmhash.Sum([]byte{byte(h)})
+		next.LastResultsHash = tmhash.Sum([]byte{byte(h), byte(h)})
+		require.NoError(t, stateStore.Save(next))
+
+		state = next
+		states = append(states, state)
+	}
+
+	return states
+}
+
+func TestRollbackOstraconState(t *testing.T) {
+	stateDB, blockStoreDB := memdb.NewDB(), memdb.NewDB()
+	states := makeChain(t, stateDB, blockStoreDB, 5)
+	blockStore := oststore.NewBlockStore(blockStoreDB)
+
+	for _, height := range []int64{1, 2, 3, 4, 5} {
+		state, err := rollbackOstraconState(stateDB, blockStore, height)
+		require.NoError(t, err)
+		require.True(t, states[height].Equals(state), "height %d", height)
+	}
+
+	_, err := rollbackOstraconState(stateDB, blockStore, 0)
+	require.Error(t, err)
+	_, err = rollbackOstraconState(stateDB, blockStore, 6)
+	require.Error(t, err)
+	_, err = rollbackOstraconState(memdb.NewDB(), blockStore, 3)
+	require.Error(t, err)
+
+	// the rolled back state can be saved, and the state after the following
+	// block is the same as before
+	state, err := rollbackOstraconState(stateDB, blockStore, 2)
+	require.NoError(t, err)
+	require.NoError(t, sm.NewStore(stateDB).Save(state))
+	loaded, err := sm.NewStore(stateDB).Load()
+	require.NoError(t, err)
+	require.True(t, states[2].Equals(loaded))
+	require.NoError(t, sm.NewStore(stateDB).Save(states[3]))
+	state, err = rollbackOstraconState(stateDB, blockStore, 2)
+	require.NoError(t, err)
+	require.True(t, states[2].Equals(state))
+}
+
+func TestTruncateBlockStore(t *testing.T) {
+	stateDB, blockStoreDB := memdb.NewDB(), memdb.NewDB()
+	makeChain(t, stateDB, blockStoreDB, 5)
+	blockStore := oststore.NewBlockStore(blockStoreDB)
+	hash := blockStore.LoadBlockMeta(4).BlockID.Hash
+
+	require.NoError(t, truncateBlockStore(blockStoreDB, blockStore, 3))
+
+	blockStore = oststore.NewBlockStore(blockStoreDB)
+	require.Equal(t, int64(1), blockStore.Base())
+	require.Equal(t, int64(3), blockStore.Height())
+	require.NotNil(t, blockStore.LoadBlockMeta(3))
+	require.NotNil(t, blockStore.LoadBlockPart(3, 0))
+	require.Nil(t, blockStore.LoadBlockMeta(4))
+	require.Nil(t, blockStore.LoadBlockPart(4, 0))
+	require.Nil(t, blockStore.LoadBlockByHash(hash))
+	// the commits are checked raw, as the test blocks have no signatures
+	for key, exists := range map[string]bool{"SC:3": true, "C:2": true, "C:3": false, "SC:4": false, "SC:5": false} {
+		has, err := blockStoreDB.Has([]byte(key))
+		require.NoError(t, err)
+		require.Equal(t, exists, has, key)
+	}
+
+	// truncating above the height is a no-op
+	require.NoError(t, truncateBlockStore(blockStoreDB, blockStore, 4))
+	require.Equal(t, int64(3), oststore.NewBlockStore(blockStoreDB).Height())
+}
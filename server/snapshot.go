@@ -0,0 +1,490 @@
+package server
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/line/ostracon/light"
+	"github.com/line/ostracon/node"
+	sm "github.com/line/ostracon/state"
+	"github.com/line/ostracon/statesync"
+	oststore "github.com/line/ostracon/store"
+	"github.com/spf13/cobra"
+
+	"github.com/line/lfb-sdk/client/flags"
+	"github.com/line/lfb-sdk/server/types"
+	"github.com/line/lfb-sdk/snapshots"
+	snapshottypes "github.com/line/lfb-sdk/snapshots/types"
+	"github.com/line/lfb-sdk/store/rootmulti"
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+const (
+	flagOutput = "output"
+
+	// snapshotMetadataName is the name of the snapshot metadata in a snapshot archive
+	snapshotMetadataName = "metadata"
+)
+
+// GetSnapshotStore opens the snapshot store in the data directory of the given home
+// directory, where apps keep their state sync snapshots.
+func GetSnapshotStore(rootDir string) (*snapshots.Store, error) {
+	snapshotDir := filepath.Join(rootDir, "data", "snapshots")
+	if err := os.MkdirAll(snapshotDir, 0755); err != nil {
+		return nil, err
+	}
+	snapshotDB, err := sdk.NewLevelDB("metadata", snapshotDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshots.NewStore(snapshotDB, snapshotDir)
+}
+
+// SnapshotCmd returns the command group to manage the state sync snapshots of a stopped
+// node offline.
+func SnapshotCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Manage the state sync snapshots of a stopped node",
+	}
+
+	cmd.AddCommand(
+		ListSnapshotsCmd(),
+		CreateSnapshotCmd(appCreator),
+		DumpSnapshotCmd(),
+		LoadSnapshotCmd(),
+		RestoreSnapshotCmd(appCreator),
+	)
+	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// ListSnapshotsCmd returns a command that lists the local snapshots.
+func ListSnapshotsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the local snapshots",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			store, err := snapshotStoreFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			list, err := store.List()
+			if err != nil {
+				return err
+			}
+			for _, snapshot := range list {
+				fmt.Printf("height: %d format: %d chunks: %d hash: %X\n",
+					snapshot.Height, snapshot.Format, snapshot.Chunks, snapshot.Hash)
+			}
+			return nil
+		},
+	}
+}
+
+// CreateSnapshotCmd returns a command that creates a snapshot of the app state.
+func CreateSnapshotCmd(appCreator types.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a snapshot of the app state at a height, by default the latest one",
+		Long: `Create a snapshot of the app state at a height, by default the latest one, in the
+local snapshot store. The app state of the height must not have been pruned, and the
+height must be above the ones of the existing snapshots.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			serverCtx.Config.SetRoot(homeDir)
+			serverCtx.Viper.Set(flags.FlagHome, homeDir)
+
+			db, err := openDB(homeDir, serverCtx.Viper.GetString(FlagDBBackend))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			height, _ := cmd.Flags().GetInt64(FlagHeight)
+			if height == 0 {
+				height = rootmulti.GetLatestVersion(db)
+			}
+			if height <= 0 {
+				return errors.New("the app state is empty")
+			}
+
+			manager, err := snapshotManager(appCreator(serverCtx.Logger, db, nil, serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			snapshot, err := manager.Create(uint64(height))
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Created snapshot at height %d format %d with %d chunks\n",
+				snapshot.Height, snapshot.Format, snapshot.Chunks)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(FlagHeight, 0, "Height of the snapshot, defaults to the latest height")
+
+	return cmd
+}
+
+// DumpSnapshotCmd returns a command that writes a local snapshot to an archive.
+func DumpSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump [height] [format]",
+		Short: "Dump a local snapshot to a tar.gz archive",
+		Long: `Dump a local snapshot to a tar.gz archive, by default <height>-<format>.tar.gz in the
+current directory. The archive can be loaded into the snapshot store of another node.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+			store, err := snapshotStoreFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			output, _ := cmd.Flags().GetString(flagOutput)
+			if output == "" {
+				output = fmt.Sprintf("%d-%d.tar.gz", height, format)
+			}
+			file, err := os.Create(output)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			if err := dumpSnapshot(store, height, format, file); err != nil {
+				_ = os.Remove(output)
+				return err
+			}
+			if err := file.Close(); err != nil {
+				return err
+			}
+
+			fmt.Printf("Dumped snapshot at height %d format %d to %s\n", height, format, output)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP(flagOutput, "o", "", "Path of the archive, defaults to <height>-<format>.tar.gz")
+
+	return cmd
+}
+
+// LoadSnapshotCmd returns a command that loads a snapshot from an archive into the local
+// snapshot store.
+func LoadSnapshotCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "load [archive]",
+		Short: "Load a snapshot from a tar.gz archive into the local snapshot store",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := snapshotStoreFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			snapshot, err := loadSnapshot(store, file)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Loaded snapshot at height %d format %d\n", snapshot.Height, snapshot.Format)
+			return nil
+		},
+	}
+}
+
+// RestoreSnapshotCmd returns a command that restores the state of an empty node from a local
+// snapshot.
+func RestoreSnapshotCmd(appCreator types.AppCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "restore [height] [format]",
+		Short: "Restore the state of an empty node from a local snapshot",
+		Long: `Restore the app state of an empty node from a local snapshot, and bootstrap the
+Ostracon state at the height of the snapshot, so that the node continues from the
+height when it starts.
+
+The Ostracon state is fetched and verified with a light client, using the rpc_servers,
+trust_height, trust_hash and trust_period of the [statesync] section of the Ostracon
+config, like state sync does. The app hash of the restored state must match the
+verified one.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+
+			serverCtx := GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+			serverCtx.Viper.Set(flags.FlagHome, homeDir)
+
+			db, err := openDB(homeDir, serverCtx.Viper.GetString(FlagDBBackend))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			if rootmulti.GetLatestVersion(db) != 0 {
+				return errors.New("the app state is not empty")
+			}
+
+			blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: config})
+			if err != nil {
+				return err
+			}
+			defer blockStoreDB.Close()
+			stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: config})
+			if err != nil {
+				return err
+			}
+			defer stateDB.Close()
+
+			stateStore := sm.NewStore(stateDB)
+			state, err := stateStore.Load()
+			if err != nil {
+				return err
+			}
+			if !state.IsEmpty() {
+				return errors.New("the Ostracon state is not empty")
+			}
+			state, err = sm.MakeGenesisStateFromFile(config.GenesisFile())
+			if err != nil {
+				return err
+			}
+
+			// the light client is set up first, as it fails if the state sync config is
+			// missing or wrong
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			stateProvider, err := statesync.NewLightClientStateProvider(
+				ctx, state.ChainID, state.Version, state.InitialHeight, config.StateSync.RPCServers,
+				light.TrustOptions{
+					Period: config.StateSync.TrustPeriod,
+					Height: config.StateSync.TrustHeight,
+					Hash:   config.StateSync.TrustHashBytes(),
+				}, serverCtx.Logger.With("module", "light"))
+			if err != nil {
+				return fmt.Errorf("failed to set up light client state provider: %w", err)
+			}
+
+			manager, err := snapshotManager(appCreator(serverCtx.Logger, db, nil, serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			if err := manager.RestoreLocalSnapshot(height, format); err != nil {
+				return err
+			}
+
+			state, err = stateProvider.State(context.Background(), height)
+			if err != nil {
+				return err
+			}
+			commit, err := stateProvider.Commit(context.Background(), height)
+			if err != nil {
+				return err
+			}
+			cInfo, err := rootmulti.GetCommitInfo(db, int64(height))
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(cInfo.Hash(), state.AppHash) {
+				return fmt.Errorf("the app hash of the restored state %X doesn't match the verified one %X",
+					cInfo.Hash(), state.AppHash)
+			}
+
+			if err := stateStore.Bootstrap(state); err != nil {
+				return err
+			}
+			if err := oststore.NewBlockStore(blockStoreDB).SaveSeenCommit(state.LastBlockHeight, commit); err != nil {
+				return err
+			}
+
+			fmt.Printf("Restored state at height %d and hash %X\n", height, state.AppHash)
+			return nil
+		},
+	}
+}
+
+func snapshotStoreFromCmd(cmd *cobra.Command) (*snapshots.Store, error) {
+	homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+	return GetSnapshotStore(homeDir)
+}
+
+func snapshotManager(app types.Application) (*snapshots.Manager, error) {
+	snapshotApp, ok := app.(interface{ SnapshotManager() *snapshots.Manager })
+	if !ok || snapshotApp.SnapshotManager() == nil {
+		return nil, errors.New("the app has no snapshot store configured")
+	}
+	return snapshotApp.SnapshotManager(), nil
+}
+
+func parseSnapshotArgs(args []string) (uint64, uint32, error) {
+	height, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid height %s: %w", args[0], err)
+	}
+	format, err := strconv.ParseUint(args[1], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid format %s: %w", args[1], err)
+	}
+	return height, uint32(format), nil
+}
+
+// dumpSnapshot writes a snapshot of the store to a tar.gz archive, which contains the
+// metadata of the snapshot followed by its chunks, named by their indexes.
+func dumpSnapshot(store *snapshots.Store, height uint64, format uint32, w io.Writer) error {
+	snapshot, chunks, err := store.Load(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return fmt.Errorf("snapshot at height %d format %d not found", height, format)
+	}
+	defer snapshots.DrainChunks(chunks)
+
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	metadata, err := proto.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	if err := writeTarEntry(tarWriter, snapshotMetadataName, metadata); err != nil {
+		return err
+	}
+
+	index := 0
+	for chunk := range chunks {
+		bz, err := ioutil.ReadAll(chunk)
+		chunk.Close()
+		if err != nil {
+			return err
+		}
+		if err := writeTarEntry(tarWriter, strconv.Itoa(index), bz); err != nil {
+			return err
+		}
+		index++
+	}
+	if index != int(snapshot.Chunks) {
+		return fmt.Errorf("snapshot has %d chunks, but %d were found", snapshot.Chunks, index)
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	return gzipWriter.Close()
+}
+
+func writeTarEntry(tarWriter *tar.Writer, name string, bz []byte) error {
+	err := tarWriter.WriteHeader(&tar.Header{
+		Name: name,
+		Mode: 0644,
+		Size: int64(len(bz)),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = tarWriter.Write(bz)
+	return err
+}
+
+// loadSnapshot saves a snapshot from a tar.gz archive written by dumpSnapshot to the store,
+// and checks it against the metadata of the archive.
+func loadSnapshot(store *snapshots.Store, r io.Reader) (*snapshottypes.Snapshot, error) {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gzipReader.Close()
+	tarReader := tar.NewReader(gzipReader)
+
+	header, err := tarReader.Next()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot metadata: %w", err)
+	}
+	if header.Name != snapshotMetadataName {
+		return nil, fmt.Errorf("the archive must start with the snapshot metadata, got %s", header.Name)
+	}
+	bz, err := ioutil.ReadAll(tarReader)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &snapshottypes.Snapshot{}
+	if err := proto.Unmarshal(bz, snapshot); err != nil {
+		return nil, fmt.Errorf("invalid snapshot metadata: %w", err)
+	}
+
+	chunks := make(chan io.ReadCloser)
+	chErr := make(chan error, 1)
+	go func() {
+		defer close(chunks)
+		for index := 0; ; index++ {
+			header, err := tarReader.Next()
+			if err == io.EOF {
+				chErr <- nil
+				return
+			} else if err != nil {
+				chErr <- err
+				return
+			}
+			if header.Name != strconv.Itoa(index) {
+				chErr <- fmt.Errorf("expected chunk %d in the archive, got %s", index, header.Name)
+				return
+			}
+			// the chunk must be consumed before the next entry is read
+			pr, pw := io.Pipe()
+			chunks <- pr
+			if _, err := io.Copy(pw, tarReader); err != nil {
+				pw.CloseWithError(err)
+				chErr <- err
+				return
+			}
+			pw.Close()
+		}
+	}()
+
+	saved, err := store.Save(snapshot.Height, snapshot.Format, chunks)
+	if err != nil {
+		return nil, err
+	}
+	if err := <-chErr; err != nil {
+		_ = store.Delete(saved.Height, saved.Format)
+		return nil, err
+	}
+	if saved.Chunks != snapshot.Chunks || !bytes.Equal(saved.Hash, snapshot.Hash) {
+		_ = store.Delete(saved.Height, saved.Format)
+		return nil, fmt.Errorf("the snapshot doesn't match its metadata, expected hash %X, got %X",
+			snapshot.Hash, saved.Hash)
+	}
+	return saved, nil
+}
@@ -0,0 +1,148 @@
+package server
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/line/tm-db/v2/memdb"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/snapshots"
+)
+
+func setupSnapshotStore(t *testing.T) *snapshots.Store {
+	store, err := snapshots.NewStore(memdb.NewDB(), t.TempDir())
+	require.NoError(t, err)
+	return store
+}
+
+func makeSnapshotChunks(chunks ...[]byte) <-chan io.ReadCloser {
+	ch := make(chan io.ReadCloser, len(chunks))
+	for _, chunk := range chunks {
+		ch <- ioutil.NopCloser(bytes.NewReader(chunk))
+	}
+	close(ch)
+	return ch
+}
+
+func TestDumpAndLoadSnapshot(t *testing.T) {
+	store := setupSnapshotStore(t)
+	snapshot, err := store.Save(3, 1, makeSnapshotChunks([]byte{3, 1, 0}, []byte{3, 1, 1}, []byte{3, 1, 2}))
+	require.NoError(t, err)
+
+	archive := &bytes.Buffer{}
+	require.NoError(t, dumpSnapshot(store, 3, 1, archive))
+	require.Error(t, dumpSnapshot(store, 3, 2, &bytes.Buffer{}))
+
+	target := setupSnapshotStore(t)
+	loaded, err := loadSnapshot(target, bytes.NewReader(archive.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, snapshot, loaded)
+
+	got, chunks, err := target.Load(3, 1)
+	require.NoError(t, err)
+	require.Equal(t, snapshot, got)
+	var bodies [][]byte
+	for chunk := range chunks {
+		bz, err := ioutil.ReadAll(chunk)
+		require.NoError(t, err)
+		bodies = append(bodies, bz)
+	}
+	require.Equal(t, [][]byte{{3, 1, 0}, {3, 1, 1}, {3, 1, 2}}, bodies)
+
+	// loading the same snapshot again fails
+	_, err = loadSnapshot(target, bytes.NewReader(archive.Bytes()))
+	require.Error(t, err)
+}
+
+func TestLoadSnapshot_Invalid(t *testing.T) {
+	store := setupSnapshotStore(t)
+	_, err := store.Save(3, 1, makeSnapshotChunks([]byte{3, 1, 0}, []byte{3, 1, 1}))
+	require.NoError(t, err)
+	archive := &bytes.Buffer{}
+	require.NoError(t, dumpSnapshot(store, 3, 1, archive))
+
+	// rewrites the entries of the archive
+	rewrite := func(f func(name string, bz []byte) (string, []byte)) io.Reader {
+		gzipReader, err := gzip.NewReader(bytes.NewReader(archive.Bytes()))
+		require.NoError(t, err)
+		tarReader := tar.NewReader(gzipReader)
+
+		out := &bytes.Buffer{}
+		gzipWriter := gzip.NewWriter(out)
+		tarWriter := tar.NewWriter(gzipWriter)
+		for {
+			header, err := tarReader.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			bz, err := ioutil.ReadAll(tarReader)
+			require.NoError(t, err)
+			if name, bz := f(header.Name, bz); name != "" {
+				require.NoError(t, writeTarEntry(tarWriter, name, bz))
+			}
+		}
+		require.NoError(t, tarWriter.Close())
+		require.NoError(t, gzipWriter.Close())
+		return out
+	}
+
+	testCases := map[string]io.Reader{
+		"not an archive": bytes.NewReader([]byte("foo")),
+		"corrupted chunk": rewrite(func(name string, bz []byte) (string, []byte) {
+			if name == "1" {
+				return name, []byte{9, 9, 9}
+			}
+			return name, bz
+		}),
+		"missing chunk": rewrite(func(name string, bz []byte) (string, []byte) {
+			if name == "1" {
+				return "", nil
+			}
+			return name, bz
+		}),
+		"missing metadata": rewrite(func(name string, bz []byte) (string, []byte) {
+			if name == snapshotMetadataName {
+				return "", nil
+			}
+			return name, bz
+		}),
+		"misnamed chunk": rewrite(func(name string, bz []byte) (string, []byte) {
+			if name == "1" {
+				return "2", bz
+			}
+			return name, bz
+		}),
+	}
+
+	for name, archive := range testCases {
+		archive := archive
+		t.Run(name, func(t *testing.T) {
+			target := setupSnapshotStore(t)
+			_, err := loadSnapshot(target, archive)
+			require.Error(t, err)
+
+			// nothing is left in the store
+			list, err := target.List()
+			require.NoError(t, err)
+			require.Empty(t, list)
+		})
+	}
+}
+
+func TestParseSnapshotArgs(t *testing.T) {
+	height, format, err := parseSnapshotArgs([]string{"100", "1"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), height)
+	require.Equal(t, uint32(1), format)
+
+	_, _, err = parseSnapshotArgs([]string{"-1", "1"})
+	require.Error(t, err)
+	_, _, err = parseSnapshotArgs([]string{"100", "4294967296"})
+	require.Error(t, err)
+}
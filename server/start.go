@@ -16,7 +16,6 @@ import (
 	"github.com/line/ostracon/node"
 	"github.com/line/ostracon/p2p"
 	pvm "github.com/line/ostracon/privval"
-	"github.com/line/ostracon/proxy"
 	"github.com/line/ostracon/rpc/client/local"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
@@ -48,6 +47,8 @@ const (
 	FlagTrace               = "trace"
 	FlagInvCheckPeriod      = "inv-check-period"
 	FlagPrometheus          = "prometheus"
+	FlagDBBackend           = "db-backend"
+	FlagDeliverTxWorkers    = "deliver-tx-workers"
 
 	FlagPruning           = "pruning"
 	FlagPruningKeepRecent = "pruning-keep-recent"
@@ -69,6 +70,15 @@ const (
 	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
 )
 
+// Fee market-related flags.
+const (
+	FlagDynamicMinGasPrices = "fee-market.dynamic-min-gas-prices"
+	FlagTargetBlockFullness = "fee-market.target-block-fullness"
+	FlagMaxChangeRate       = "fee-market.max-change-rate"
+	FlagMaxMultiplier       = "fee-market.max-multiplier"
+	FlagMsgPriorityWeights  = "fee-market.msg-priority-weights"
+)
+
 // StartCmd runs the service passed in, either stand-alone or in-process with
 // Ostracon.
 func StartCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
@@ -139,6 +149,7 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().String(flagAddress, "tcp://0.0.0.0:26658", "Listen address")
 	cmd.Flags().String(flagTransport, "socket", "Transport protocol: socket, grpc")
 	cmd.Flags().String(flagTraceStore, "", "Enable KVStore tracing to an output file")
+	cmd.Flags().String(FlagDBBackend, "", "Backend of the app DB (e.g. goleveldb|badgerdb), defaults to the one the binary was built with")
 	cmd.Flags().String(FlagMinGasPrices, "", "Minimum gas prices to accept for transactions; Any fee in a tx must meet this minimum (e.g. 0.01photino;0.0001stake)")
 	cmd.Flags().IntSlice(FlagUnsafeSkipUpgrades, []int{}, "Skip a set of upgrade heights to continue the old binary")
 	cmd.Flags().Uint64(FlagHaltHeight, 0, "Block height at which to gracefully halt the chain and shutdown the node")
@@ -154,6 +165,7 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
 	cmd.Flags().Uint64(FlagMinRetainBlocks, 0, "Minimum block height offset during ABCI commit to prune Ostracon blocks")
+	cmd.Flags().Int(FlagDeliverTxWorkers, 0, "Number of lanes of a block executed concurrently, below 2 executes blocks serially (in-process only)")
 
 	cmd.Flags().Bool(flagGRPCEnable, true, "Define if the gRPC server should be enabled")
 	cmd.Flags().String(flagGRPCAddress, config.DefaultGRPCAddress, "the gRPC server address to listen on")
@@ -161,6 +173,12 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
 
+	cmd.Flags().Bool(FlagDynamicMinGasPrices, false, "Adjust the minimum gas prices to the fullness of the recent blocks")
+	cmd.Flags().String(FlagTargetBlockFullness, "0.5", "Ratio of the maximum block gas used by a block which keeps the dynamic minimum gas prices unchanged")
+	cmd.Flags().String(FlagMaxChangeRate, "0.125", "Maximum relative change of the dynamic minimum gas prices after a block")
+	cmd.Flags().String(FlagMaxMultiplier, "10", "Maximum ratio of the dynamic minimum gas prices to the configured ones")
+	cmd.Flags().StringSlice(FlagMsgPriorityWeights, []string{}, "Weights of the advisory tx priority reported by CheckTx, by Msg type (e.g. /lfb.bank.v1beta1.MsgSend=2)")
+
 	cmd.Flags().Bool(FlagPrometheus, false, "Enable prometheus metric for app")
 
 	// add support for all Ostracon-specific command line options
@@ -173,7 +191,7 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 	transport := ctx.Viper.GetString(flagTransport)
 	home := ctx.Viper.GetString(flags.FlagHome)
 
-	db, err := openDB(home)
+	db, err := openDB(home, ctx.Viper.GetString(FlagDBBackend))
 	if err != nil {
 		return err
 	}
@@ -233,7 +251,7 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 	}
 
 	traceWriterFile := ctx.Viper.GetString(flagTraceStore)
-	db, err := openDB(home)
+	db, err := openDB(home, ctx.Viper.GetString(FlagDBBackend))
 	if err != nil {
 		return err
 	}
@@ -255,7 +273,7 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 		cfg,
 		pvm.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile()),
 		nodeKey,
-		proxy.NewLocalClientCreator(app),
+		newLocalClientCreator(app, ctx.Viper.GetInt(FlagDeliverTxWorkers)),
 		genDocProvider,
 		node.DefaultDBProvider,
 		node.DefaultMetricsProvider(cfg.Instrumentation),
@@ -272,6 +272,9 @@ func AddCommands(rootCmd *cobra.Command, defaultNodeHome string, appCreator type
 	rootCmd.AddCommand(
 		startCmd,
 		UnsafeResetAllCmd(),
+		RollbackCmd(defaultNodeHome),
+		SnapshotCmd(appCreator, defaultNodeHome),
+		MigrateDBCmd(defaultNodeHome),
 		flags.LineBreak,
 		ostraconCmd,
 		ExportCmd(appExport, defaultNodeHome),
@@ -368,9 +371,9 @@ func addrToIP(addr net.Addr) net.IP {
 	return ip
 }
 
-func openDB(rootDir string) (tmdb.DB, error) {
+func openDB(rootDir, dbBackend string) (tmdb.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
-	return sdk.NewLevelDB("application", dataDir)
+	return sdk.NewDB("application", dbBackend, dataDir)
 }
 
 func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
@@ -26,6 +26,7 @@ import (
 	"github.com/line/lfb-sdk/server/config"
 	servertypes "github.com/line/lfb-sdk/server/types"
 	simappparams "github.com/line/lfb-sdk/simapp/params"
+	"github.com/line/lfb-sdk/streaming"
 	"github.com/line/lfb-sdk/testutil/testdata"
 	sdk "github.com/line/lfb-sdk/types"
 	"github.com/line/lfb-sdk/types/module"
@@ -38,6 +39,9 @@ import (
 	authtx "github.com/line/lfb-sdk/x/auth/tx"
 	authtypes "github.com/line/lfb-sdk/x/auth/types"
 	"github.com/line/lfb-sdk/x/auth/vesting"
+	"github.com/line/lfb-sdk/x/authz"
+	authzkeeper "github.com/line/lfb-sdk/x/authz/keeper"
+	authztypes "github.com/line/lfb-sdk/x/authz/types"
 	"github.com/line/lfb-sdk/x/bank"
 	bankkeeper "github.com/line/lfb-sdk/x/bank/keeper"
 	banktypes "github.com/line/lfb-sdk/x/bank/types"
@@ -54,6 +58,9 @@ import (
 	"github.com/line/lfb-sdk/x/evidence"
 	evidencekeeper "github.com/line/lfb-sdk/x/evidence/keeper"
 	evidencetypes "github.com/line/lfb-sdk/x/evidence/types"
+	"github.com/line/lfb-sdk/x/feegrant"
+	feegrantkeeper "github.com/line/lfb-sdk/x/feegrant/keeper"
+	feegranttypes "github.com/line/lfb-sdk/x/feegrant/types"
 	"github.com/line/lfb-sdk/x/genutil"
 	genutiltypes "github.com/line/lfb-sdk/x/genutil/types"
 	"github.com/line/lfb-sdk/x/gov"
@@ -118,6 +125,8 @@ var (
 		upgrade.AppModuleBasic{},
 		evidence.AppModuleBasic{},
 		transfer.AppModuleBasic{},
+		feegrant.AppModuleBasic{},
+		authz.AppModuleBasic{},
 		vesting.AppModuleBasic{},
 	)
 
@@ -151,6 +160,7 @@ type SimApp struct {
 
 	// keys to access the substores
 	keys    map[string]*sdk.KVStoreKey
+	tkeys   map[string]*sdk.TransientStoreKey
 	memKeys map[string]*sdk.MemoryStoreKey
 
 	// keepers
@@ -168,6 +178,8 @@ type SimApp struct {
 	IBCKeeper        *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
 	EvidenceKeeper   evidencekeeper.Keeper
 	TransferKeeper   ibctransferkeeper.Keeper
+	FeeGrantKeeper   feegrantkeeper.Keeper
+	AuthzKeeper      authzkeeper.Keeper
 
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
@@ -212,9 +224,16 @@ func NewSimApp(
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey,
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, capabilitytypes.StoreKey,
+		feegranttypes.StoreKey, authztypes.StoreKey,
 	)
+	tkeys := sdk.NewTransientStoreKeys(authtypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
+	// configure state listening capabilities using AppOptions
+	if _, err := streaming.LoadStreamingServices(bApp, appOpts, appCodec, keys); err != nil {
+		ostos.Exit(err.Error())
+	}
+
 	app := &SimApp{
 		BaseApp:           bApp,
 		legacyAmino:       legacyAmino,
@@ -222,6 +241,7 @@ func NewSimApp(
 		interfaceRegistry: interfaceRegistry,
 		invCheckPeriod:    invCheckPeriod,
 		keys:              keys,
+		tkeys:             tkeys,
 		memKeys:           memKeys,
 	}
 
@@ -262,6 +282,8 @@ func NewSimApp(
 	app.CrisisKeeper = crisiskeeper.NewKeeper(
 		app.GetSubspace(crisistypes.ModuleName), invCheckPeriod, app.BankKeeper, authtypes.FeeCollectorName,
 	)
+	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, keys[feegranttypes.StoreKey], app.AccountKeeper)
+	app.AuthzKeeper = authzkeeper.NewKeeper(keys[authztypes.StoreKey], appCodec, app.BaseApp.MsgServiceRouter())
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath)
 
 	// register the staking hooks
@@ -337,6 +359,8 @@ func NewSimApp(
 		staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
 		upgrade.NewAppModule(app.UpgradeKeeper),
 		evidence.NewAppModule(app.EvidenceKeeper),
+		feegrant.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
+		authz.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.AuthzKeeper, app.interfaceRegistry),
 		ibc.NewAppModule(app.IBCKeeper),
 		params.NewAppModule(app.ParamsKeeper),
 		transferModule,
@@ -361,11 +385,12 @@ func NewSimApp(
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, stakingtypes.ModuleName,
 		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName, crisistypes.ModuleName,
 		ibchost.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, ibctransfertypes.ModuleName,
+		feegranttypes.ModuleName, authztypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
-	app.mm.RegisterServices(module.NewConfigurator(app.MsgServiceRouter(), app.GRPCQueryRouter()))
+	app.mm.RegisterServices(module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter()))
 
 	// add test gRPC service for testing gRPC queries in isolation
 	testdata.RegisterQueryServer(app.GRPCQueryRouter(), testdata.QueryImpl{})
@@ -385,6 +410,8 @@ func NewSimApp(
 		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		params.NewAppModule(app.ParamsKeeper),
 		evidence.NewAppModule(app.EvidenceKeeper),
+		feegrant.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
+		authz.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.AuthzKeeper, app.interfaceRegistry),
 		ibc.NewAppModule(app.IBCKeeper),
 		transferModule,
 	)
@@ -393,6 +420,7 @@ func NewSimApp(
 
 	// initialize stores
 	app.MountKVStores(keys)
+	app.MountTransientStores(tkeys)
 	app.MountMemoryStores(memKeys)
 
 	// initialize BaseApp
@@ -400,11 +428,20 @@ func NewSimApp(
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetAnteHandler(
 		ante.NewAnteHandler(
-			app.AccountKeeper, app.BankKeeper, ante.DefaultSigVerificationGasConsumer,
+			app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, tkeys[authtypes.TStoreKey],
+			ante.DefaultSigVerificationGasConsumer,
 			encodingConfig.TxConfig.SignModeHandler(),
 		),
 	)
 	app.SetEndBlocker(app.EndBlocker)
+	// the txs paying fees of a block executed in parallel all credit the fee collector
+	app.SetAccumulator(
+		keys[banktypes.StoreKey],
+		banktypes.CreateAccountBalancesPrefix(app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)),
+		bankkeeper.NewBalanceAccumulator(appCodec),
+	)
+	// and add to the gas used by the Msg types with a gas limit
+	app.SetAccumulator(tkeys[authtypes.TStoreKey], authtypes.MsgGasUsedKeyPrefix, ante.NewMsgGasAccumulator(app.AccountKeeper))
 
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
@@ -459,6 +496,7 @@ func (app *SimApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.
 	if err := ostjson.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
 		panic(err)
 	}
+	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
 	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
 }
 
@@ -505,6 +543,13 @@ func (app *SimApp) GetKey(storeKey string) *sdk.KVStoreKey {
 	return app.keys[storeKey]
 }
 
+// GetTKey returns the TransientStoreKey for the provided store key.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *SimApp) GetTKey(storeKey string) *sdk.TransientStoreKey {
+	return app.tkeys[storeKey]
+}
+
 // GetMemKey returns the MemStoreKey for the provided mem key.
 //
 // NOTE: This is solely used for testing purposes.
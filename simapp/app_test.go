@@ -6,10 +6,16 @@ import (
 	"testing"
 
 	"github.com/line/ostracon/libs/log"
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
 	"github.com/line/tm-db/v2/memdb"
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/line/ostracon/abci/types"
+
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/types/module"
+	authztypes "github.com/line/lfb-sdk/x/authz/types"
+	banktypes "github.com/line/lfb-sdk/x/bank/types"
 )
 
 func TestSimAppExportAndBlockedAddrs(t *testing.T) {
@@ -48,3 +54,34 @@ func TestGetMaccPerms(t *testing.T) {
 	dup := GetMaccPerms()
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
 }
+
+func TestRunMigrations(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, ostproto.Header{})
+
+	// the version map stored at genesis has every module at its current version
+	fromVM := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.Equal(t, app.mm.GetVersionMap(), fromVM)
+
+	// a configurator is needed to register migrations, so create a new one
+	cfg := module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
+
+	called := 0
+	require.NoError(t, cfg.RegisterMigration(banktypes.ModuleName, 1, func(sdk.Context) error {
+		called++
+		return nil
+	}))
+
+	// bank is unchanged, nothing runs
+	toVM, err := app.mm.RunMigrations(ctx, cfg, fromVM)
+	require.NoError(t, err)
+	require.Equal(t, app.mm.GetVersionMap(), toVM)
+	require.Equal(t, 0, called)
+
+	// modules missing from the version map are initialized from their default
+	// genesis
+	delete(fromVM, authztypes.ModuleName)
+	toVM, err = app.mm.RunMigrations(ctx, cfg, fromVM)
+	require.NoError(t, err)
+	require.Equal(t, app.mm.GetVersionMap(), toVM)
+}
@@ -24,3 +24,13 @@ func TestNewApp(t *testing.T) {
 	app := a.newApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, ctx.Viper)
 	require.NotNil(t, app)
 }
+
+func TestStoreDecoders(t *testing.T) {
+	a := appCreator{simapp.MakeTestEncodingConfig()}
+	decoders, err := a.storeDecoders()
+	require.NoError(t, err)
+
+	for _, storeName := range []string{"acc", "bank", "staking", "feegrant", "authz", "upgrade", "evidence", "capability", "ibc"} {
+		require.Contains(t, decoders, storeName)
+	}
+}
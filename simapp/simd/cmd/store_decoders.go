@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+
+	"github.com/line/ostracon/libs/log"
+	"github.com/line/tm-db/v2/memdb"
+
+	"github.com/line/lfb-sdk/simapp"
+	sdk "github.com/line/lfb-sdk/types"
+	upgradesim "github.com/line/lfb-sdk/x/upgrade/simulation"
+	upgradetypes "github.com/line/lfb-sdk/x/upgrade/types"
+)
+
+// storeDecoders is a server.StoreDecodersFn returning the store decoders registered
+// by the modules of the simulation manager of simapp, which the debug store commands
+// decode values with. The app is created on an in-memory DB and a temporary home
+// directory, so that nothing of the node is opened.
+func (a appCreator) storeDecoders() (sdk.StoreDecoderRegistry, error) {
+	homePath, err := ioutil.TempDir("", "simd-store-decoders")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(homePath)
+
+	simApp := simapp.NewSimApp(log.NewNopLogger(), memdb.NewDB(), nil, false, map[int64]bool{}, homePath, 0, a.encCfg, simapp.EmptyAppOptions{})
+	decoders := simApp.SimulationManager().StoreDecoders
+	// the upgrade module isn't simulated
+	decoders[upgradetypes.StoreKey] = upgradesim.NewDecodeStore(a.encCfg.Marshaler)
+
+	return decoders, nil
+}
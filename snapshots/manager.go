@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"io"
 	"io/ioutil"
+	"sort"
 	"sync"
 
 	"github.com/line/lfb-sdk/snapshots/types"
@@ -33,6 +34,11 @@ type restoreDone struct {
 // long-running operation is in progress at any given time, and provides convenience methods
 // mirroring the ABCI interface.
 //
+// A snapshot is a stream of SnapshotItem Protobuf messages, compressed and split into chunks by
+// the manager. The multistore writes its items first, followed by the items of each registered
+// extension snapshotter in the order of their names: a SnapshotExtensionMeta item with the
+// name and format of the extension, then its SnapshotExtensionPayload items.
+//
 // Although the ABCI interface (and this manager) passes chunks as byte slices, the internal
 // snapshot/restore APIs use IO streams (i.e. chan io.ReadCloser), for two reasons:
 //
@@ -43,8 +49,9 @@ type restoreDone struct {
 // 2) io.ReadCloser streams automatically propagate IO errors, and can pass arbitrary
 //    errors via io.Pipe.CloseWithError().
 type Manager struct {
-	store  *Store
-	target types.Snapshotter
+	store      *Store
+	multistore types.Snapshotter
+	extensions map[string]types.ExtensionSnapshotter
 
 	mtx                sync.Mutex
 	operation          operation
@@ -55,11 +62,51 @@ type Manager struct {
 }
 
 // NewManager creates a new manager.
-func NewManager(store *Store, target types.Snapshotter) *Manager {
+func NewManager(store *Store, multistore types.Snapshotter) *Manager {
 	return &Manager{
-		store:  store,
-		target: target,
+		store:      store,
+		multistore: multistore,
+		extensions: make(map[string]types.ExtensionSnapshotter),
+	}
+}
+
+// RegisterExtensions registers extension snapshotters to the manager. The names of the
+// extensions must be unique.
+func (m *Manager) RegisterExtensions(extensions ...types.ExtensionSnapshotter) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	for _, extension := range extensions {
+		name := extension.SnapshotName()
+		if _, ok := m.extensions[name]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "duplicated snapshotter name: %s", name)
+		}
+		if !IsFormatSupported(extension, extension.SnapshotFormat()) {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "snapshotter %s does not support its own format %v",
+				name, extension.SnapshotFormat())
+		}
+		m.extensions[name] = extension
+	}
+	return nil
+}
+
+// sortedExtensionNames sorts extension names for deterministic iteration.
+func (m *Manager) sortedExtensionNames() []string {
+	names := make([]string, 0, len(m.extensions))
+	for name := range m.extensions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// IsFormatSupported returns true if the extension snapshotter supports the format.
+func IsFormatSupported(snapshotter types.ExtensionSnapshotter, format uint32) bool {
+	for _, i := range snapshotter.SupportedFormats() {
+		if i == format {
+			return true
+		}
 	}
+	return false
 }
 
 // begin starts an operation, or errors if one is in progress. It manages the mutex itself.
@@ -120,11 +167,54 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
-	chunks, err := m.target.Snapshot(height, types.CurrentFormat)
-	if err != nil {
-		return nil, err
+	ch := make(chan io.ReadCloser)
+	go m.createSnapshot(height, ch)
+
+	return m.store.Save(height, types.CurrentFormat, ch)
+}
+
+// createSnapshot writes the multistore and the extensions to the snapshot stream. Errors are
+// passed to the reader of the chunks.
+func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
+	streamWriter := NewStreamWriter(ch)
+	if streamWriter == nil {
+		return
+	}
+	defer streamWriter.Close()
+
+	if err := m.multistore.Snapshot(height, streamWriter); err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+	for _, name := range m.sortedExtensionNames() {
+		extension := m.extensions[name]
+		// write extension metadata
+		err := streamWriter.WriteMsg(&types.SnapshotItem{
+			Item: &types.SnapshotItem_Extension{
+				Extension: &types.SnapshotExtensionMeta{
+					Name:   name,
+					Format: extension.SnapshotFormat(),
+				},
+			},
+		})
+		if err != nil {
+			streamWriter.CloseWithError(err)
+			return
+		}
+		payloadWriter := func(payload []byte) error {
+			return streamWriter.WriteMsg(&types.SnapshotItem{
+				Item: &types.SnapshotItem_ExtensionPayload{
+					ExtensionPayload: &types.SnapshotExtensionPayload{
+						Payload: payload,
+					},
+				},
+			})
+		}
+		if err := extension.Snapshot(height, payloadWriter); err != nil {
+			streamWriter.CloseWithError(err)
+			return
+		}
 	}
-	return m.store.Save(height, types.CurrentFormat, chunks)
 }
 
 // List lists snapshots, mirroring ABCI ListSnapshots. It can be concurrent with other operations.
@@ -160,6 +250,9 @@ func (m *Manager) Prune(retain uint32) (uint64, error) {
 // Restore begins an async snapshot restoration, mirroring ABCI OfferSnapshot. Chunks must be fed
 // via RestoreChunk() until the restore is complete or a chunk fails.
 func (m *Manager) Restore(snapshot types.Snapshot) error {
+	if !types.IsSupportedFormat(snapshot.Format) {
+		return sdkerrors.Wrapf(types.ErrUnknownFormat, "format %v", snapshot.Format)
+	}
 	if snapshot.Chunks == 0 {
 		return sdkerrors.Wrap(types.ErrInvalidMetadata, "no chunks")
 	}
@@ -180,7 +273,7 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	chReady := make(chan struct{}, 1)
 	chDone := make(chan restoreDone, 1)
 	go func() {
-		err := m.target.Restore(snapshot.Height, snapshot.Format, chChunks, chReady)
+		err := m.doRestoreSnapshot(snapshot, chChunks, chReady)
 		chDone <- restoreDone{
 			complete: err == nil,
 			err:      err,
@@ -206,6 +299,103 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	return nil
 }
 
+// RestoreLocalSnapshot restores the state from a snapshot in the local snapshot store, e.g. one
+// loaded from an archive, without going through ABCI. It blocks until the restore is complete.
+func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
+	if m == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "no snapshot store configured")
+	}
+	snapshot, chunks, err := m.store.Load(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "snapshot for height %v format %v", height, format)
+	}
+	defer DrainChunks(chunks)
+
+	if !types.IsSupportedFormat(snapshot.Format) {
+		return sdkerrors.Wrapf(types.ErrUnknownFormat, "format %v", snapshot.Format)
+	}
+	err = m.begin(opRestore)
+	if err != nil {
+		return err
+	}
+	defer m.end()
+
+	return m.doRestoreSnapshot(*snapshot, chunks, nil)
+}
+
+// doRestoreSnapshot restores the multistore and then the extensions from the snapshot stream.
+func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.ReadCloser, chReady chan<- struct{}) error {
+	// Signal readiness. Must be done before the readers below are set up, since the zlib
+	// reader reads from the stream on initialization, potentially causing deadlocks.
+	if chReady != nil {
+		close(chReady)
+	}
+
+	streamReader, err := NewStreamReader(chChunks)
+	if err != nil {
+		return err
+	}
+	defer streamReader.Close()
+
+	next, err := m.multistore.Restore(snapshot.Height, snapshot.Format, streamReader)
+	if err != nil {
+		return sdkerrors.Wrap(err, "multistore restore")
+	}
+	for {
+		if next.Item == nil {
+			// end of stream
+			break
+		}
+		metadata := next.GetExtension()
+		if metadata == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unknown snapshot item %T", next.Item)
+		}
+		extension, ok := m.extensions[metadata.Name]
+		if !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "extension %s not registered", metadata.Name)
+		}
+		if !IsFormatSupported(extension, metadata.Format) {
+			return sdkerrors.Wrapf(types.ErrUnknownFormat, "format %v for extension %s", metadata.Format, metadata.Name)
+		}
+
+		next = types.SnapshotItem{}
+		payloadReader := func() ([]byte, error) {
+			if next.Item != nil {
+				return nil, io.EOF
+			}
+			item := types.SnapshotItem{}
+			err := streamReader.ReadMsg(&item)
+			if err == io.EOF {
+				return nil, io.EOF
+			} else if err != nil {
+				return nil, sdkerrors.Wrap(err, "invalid protobuf message")
+			}
+			payload := item.GetExtensionPayload()
+			if payload == nil {
+				// the payloads of this extension are exhausted
+				next = item
+				return nil, io.EOF
+			}
+			return payload.Payload, nil
+		}
+		if err := extension.Restore(snapshot.Height, metadata.Format, payloadReader); err != nil {
+			return sdkerrors.Wrapf(err, "extension %s restore", metadata.Name)
+		}
+		// skip the payloads the extension didn't read
+		for {
+			if _, err := payloadReader(); err == io.EOF {
+				break
+			} else if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // RestoreChunk adds a chunk to an active snapshot restoration, mirroring ABCI ApplySnapshotChunk.
 // Chunks must be given until the restore is complete, returning true, or a chunk errors.
 func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
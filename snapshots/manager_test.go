@@ -9,6 +9,7 @@ import (
 
 	"github.com/line/lfb-sdk/snapshots"
 	"github.com/line/lfb-sdk/snapshots/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
 )
 
 func TestManager_List(t *testing.T) {
@@ -53,13 +54,12 @@ func TestManager_LoadChunk(t *testing.T) {
 
 func TestManager_Take(t *testing.T) {
 	store := setupStore(t)
-	snapshotter := &mockSnapshotter{
-		chunks: [][]byte{
-			{1, 2, 3},
-			{4, 5, 6},
-			{7, 8, 9},
-		},
+	items := [][]byte{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
 	}
+	snapshotter := &mockSnapshotter{items: items}
 	manager := snapshots.NewManager(store, snapshotter)
 
 	// nil manager should return error
@@ -73,21 +73,21 @@ func TestManager_Take(t *testing.T) {
 	// creating a snapshot at a higher height should be fine, and should return it
 	snapshot, err := manager.Create(5)
 	require.NoError(t, err)
+	expected := snapshotChunks(t, items, 1)
 	assert.Equal(t, &types.Snapshot{
 		Height: 5,
 		Format: types.CurrentFormat,
-		Chunks: 3,
-		Hash:   []uint8{0x47, 0xe4, 0xee, 0x7f, 0x21, 0x1f, 0x73, 0x26, 0x5d, 0xd1, 0x76, 0x58, 0xf6, 0xe2, 0x1c, 0x13, 0x18, 0xbd, 0x6c, 0x81, 0xf3, 0x75, 0x98, 0xe2, 0xa, 0x27, 0x56, 0x29, 0x95, 0x42, 0xef, 0xcf},
+		Chunks: 1,
+		Hash:   hash(expected),
 		Metadata: types.Metadata{
-			ChunkHashes: checksums([][]byte{
-				{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}),
+			ChunkHashes: checksums(expected),
 		},
 	}, snapshot)
 
 	storeSnapshot, chunks, err := store.Load(snapshot.Height, snapshot.Format)
 	require.NoError(t, err)
 	assert.Equal(t, snapshot, storeSnapshot)
-	assert.Equal(t, [][]byte{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}, readChunks(chunks))
+	assert.Equal(t, expected, readChunks(chunks))
 
 	// creating a snapshot while a different snapshot is being created should error
 	manager = setupBusyManager(t)
@@ -95,6 +95,91 @@ func TestManager_Take(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestManager_RegisterExtensions(t *testing.T) {
+	manager := snapshots.NewManager(setupStore(t), &mockSnapshotter{})
+
+	require.NoError(t, manager.RegisterExtensions(&mockExtension{name: "a"}, &mockExtension{name: "b"}))
+	require.Error(t, manager.RegisterExtensions(&mockExtension{name: "a"}))
+}
+
+func TestManager_TakeAndRestoreWithExtensions(t *testing.T) {
+	store := setupStore(t)
+	multistore := &mockSnapshotter{items: [][]byte{{1, 2, 3}}}
+	extB := &mockExtension{name: "b", items: [][]byte{{4}, {5, 6}}}
+	extA := &mockExtension{name: "a", items: [][]byte{{7, 8, 9}}}
+	extEmpty := &mockExtension{name: "c"}
+	manager := snapshots.NewManager(store, multistore)
+	require.NoError(t, manager.RegisterExtensions(extB, extA, extEmpty))
+
+	snapshot, err := manager.Create(5)
+	require.NoError(t, err)
+
+	_, chunks, err := store.Load(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	chunkBodies := readChunks(chunks)
+
+	// restore into a new manager with the same extensions
+	target := &mockSnapshotter{}
+	targetB, targetA, targetEmpty := &mockExtension{name: "b"}, &mockExtension{name: "a"}, &mockExtension{name: "c"}
+	targetManager := snapshots.NewManager(setupStore(t), target)
+	require.NoError(t, targetManager.RegisterExtensions(targetA, targetB, targetEmpty))
+
+	require.NoError(t, targetManager.Restore(*snapshot))
+	for _, chunk := range chunkBodies {
+		_, err := targetManager.RestoreChunk(chunk)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, multistore.items, target.items)
+	assert.Equal(t, extA.items, targetA.items)
+	assert.Equal(t, extB.items, targetB.items)
+	assert.Equal(t, [][]byte{}, targetEmpty.items)
+
+	// restoring fails if an extension of the snapshot is not registered
+	target = &mockSnapshotter{}
+	targetManager = snapshots.NewManager(setupStore(t), target)
+	require.NoError(t, targetManager.RegisterExtensions(&mockExtension{name: "a"}))
+	require.NoError(t, targetManager.Restore(*snapshot))
+	_, err = targetManager.RestoreChunk(chunkBodies[0])
+	require.Error(t, err)
+}
+
+func TestManager_RestoreLocalSnapshot(t *testing.T) {
+	store := setupStore(t)
+	multistore := &mockSnapshotter{items: [][]byte{{1, 2, 3}, {4, 5, 6}}}
+	ext := &mockExtension{name: "a", items: [][]byte{{7, 8, 9}}}
+	manager := snapshots.NewManager(store, multistore)
+	require.NoError(t, manager.RegisterExtensions(ext))
+
+	snapshot, err := manager.Create(5)
+	require.NoError(t, err)
+
+	// nil manager should return error
+	require.Error(t, (*snapshots.Manager)(nil).RestoreLocalSnapshot(snapshot.Height, snapshot.Format))
+
+	// restore from the same store into a new manager
+	target := &mockSnapshotter{}
+	targetExt := &mockExtension{name: "a"}
+	targetManager := snapshots.NewManager(store, target)
+	require.NoError(t, targetManager.RegisterExtensions(targetExt))
+
+	err = targetManager.RestoreLocalSnapshot(snapshot.Height, 9)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, sdkerrors.ErrNotFound))
+
+	require.NoError(t, targetManager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format))
+	assert.Equal(t, multistore.items, target.items)
+	assert.Equal(t, ext.items, targetExt.items)
+
+	// restoring again fails, as the target already has contents, but ends the restore
+	require.Error(t, targetManager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format))
+	_, err = targetManager.Prune(1)
+	require.NoError(t, err)
+
+	// restoring errors while a different operation is in progress
+	manager = setupBusyManager(t)
+	require.Error(t, manager.RestoreLocalSnapshot(2, 1))
+}
+
 func TestManager_Prune(t *testing.T) {
 	store := setupStore(t)
 	manager := snapshots.NewManager(store, nil)
@@ -118,11 +203,12 @@ func TestManager_Restore(t *testing.T) {
 	target := &mockSnapshotter{}
 	manager := snapshots.NewManager(store, target)
 
-	chunks := [][]byte{
+	items := [][]byte{
 		{1, 2, 3},
 		{4, 5, 6},
 		{7, 8, 9},
 	}
+	chunks := snapshotChunks(t, items, 3)
 
 	// Restore errors on invalid format
 	err := manager.Restore(types.Snapshot{
@@ -133,7 +219,7 @@ func TestManager_Restore(t *testing.T) {
 		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
 	})
 	require.Error(t, err)
-	require.Equal(t, types.ErrUnknownFormat, err)
+	require.True(t, errors.Is(err, types.ErrUnknownFormat))
 
 	// Restore errors on no chunks
 	err = manager.Restore(types.Snapshot{Height: 3, Format: 1, Hash: []byte{1, 2, 3}})
@@ -182,9 +268,11 @@ func TestManager_Restore(t *testing.T) {
 		}
 	}
 
-	assert.Equal(t, chunks, target.chunks)
+	assert.Equal(t, items, target.items)
 
-	// Starting a new restore should fail now, because the target already has contents.
+	// Restoring again should fail now, because the target already has contents. The target is
+	// only called once the stream is set up, so the error is returned by the chunks and ends the
+	// restore.
 	err = manager.Restore(types.Snapshot{
 		Height:   3,
 		Format:   1,
@@ -192,12 +280,19 @@ func TestManager_Restore(t *testing.T) {
 		Chunks:   3,
 		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
 	})
-	require.Error(t, err)
+	require.NoError(t, err)
+	var restoreErr error
+	for _, chunk := range chunks {
+		if _, restoreErr = manager.RestoreChunk(chunk); restoreErr != nil {
+			break
+		}
+	}
+	require.Error(t, restoreErr)
 
 	// But if we clear out the target we should be able to start a new restore. This time we'll
 	// fail it with a checksum error. That error should stop the operation, so that we can do
 	// a prune operation right after.
-	target.chunks = nil
+	target.items = nil
 	err = manager.Restore(types.Snapshot{
 		Height:   3,
 		Format:   1,
@@ -3,4 +3,12 @@ package types
 // CurrentFormat is the currently used format for snapshots. Snapshots using the same format
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
-const CurrentFormat uint32 = 1
+//
+// Format 2 appends the items of the extension snapshotters to the multistore items. Format 1
+// snapshots contain the multistore items only and can still be restored.
+const CurrentFormat uint32 = 2
+
+// IsSupportedFormat returns true if snapshots of the given format can be restored.
+func IsSupportedFormat(format uint32) bool {
+	return format == 1 || format == CurrentFormat
+}
@@ -1,16 +1,51 @@
 package types
 
-import "io"
+import (
+	protoio "github.com/gogo/protobuf/io"
+)
 
-// Snapshotter is something that can create and restore snapshots, consisting of streamed binary
-// chunks - all of which must be read from the channel and closed. If an unsupported format is
-// given, it must return ErrUnknownFormat (possibly wrapped with fmt.Errorf).
+// Snapshotter is something that can create and restore snapshots, consisting of a stream of
+// SnapshotItem Protobuf messages. The stream is chunked and compressed by the snapshot manager.
+// If an unsupported format is given, it must return ErrUnknownFormat (possibly wrapped with
+// fmt.Errorf).
 type Snapshotter interface {
-	// Snapshot creates a state snapshot, returning a channel of snapshot chunk readers.
-	Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, error)
+	// Snapshot writes a state snapshot at the given height as a stream of SnapshotItem
+	// Protobuf messages.
+	Snapshot(height uint64, protoWriter protoio.Writer) error
 
-	// Restore restores a state snapshot, taking snapshot chunk readers as input.
-	// If the ready channel is non-nil, it returns a ready signal (by being closed) once the
-	// restorer is ready to accept chunks.
-	Restore(height uint64, format uint32, chunks <-chan io.ReadCloser, ready chan<- struct{}) error
+	// Restore restores a state snapshot from a stream of SnapshotItem Protobuf messages. It
+	// reads the items it knows about and returns the first item it doesn't, which is the
+	// start of the extension items, or an empty SnapshotItem if the stream ended.
+	Restore(height uint64, format uint32, protoReader protoio.Reader) (SnapshotItem, error)
+}
+
+// ExtensionPayloadReader reads the payloads of an extension from the snapshot stream. It
+// returns io.EOF once the payloads of the extension are exhausted.
+type ExtensionPayloadReader = func() ([]byte, error)
+
+// ExtensionPayloadWriter writes a payload of an extension to the snapshot stream.
+type ExtensionPayloadWriter = func([]byte) error
+
+// ExtensionSnapshotter is an extension Snapshotter that is appended to the snapshot stream.
+// An extension can snapshot state that is kept outside of the multistore, e.g. compiled
+// contract code. Extensions are snapshotted and restored in the order of their names, after
+// the multistore.
+type ExtensionSnapshotter interface {
+	// SnapshotName returns the name of the snapshotter, it should be unique in the manager.
+	SnapshotName() string
+
+	// SnapshotFormat returns the default format the extension snapshotter uses to encode the
+	// payloads when taking a snapshot.
+	// It's defined within the extension, different from the global format for the whole state-sync snapshot.
+	SnapshotFormat() uint32
+
+	// SupportedFormats returns a list of formats it can restore from.
+	SupportedFormats() []uint32
+
+	// Snapshot writes the payloads of the extension at the given height.
+	Snapshot(height uint64, payloadWriter ExtensionPayloadWriter) error
+
+	// Restore restores the extension state from the payloads of a snapshot. It is called
+	// after the multistore has been restored.
+	Restore(height uint64, format uint32, payloadReader ExtensionPayloadReader) error
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/line/lfb-sdk/store/cachekv"
 	"github.com/line/lfb-sdk/store/dbadapter"
+	"github.com/line/lfb-sdk/store/listenkv"
 	"github.com/line/lfb-sdk/store/types"
 )
 
@@ -25,6 +26,10 @@ type Store struct {
 
 	traceWriter  io.Writer
 	traceContext types.TraceContext
+
+	listeners map[types.StoreKey][]types.WriteListener
+	listened  map[types.StoreKey]types.KVStore // the stores with listeners, recording their writes in the journal
+	journal   *writeJournal
 }
 
 var _ types.CacheMultiStore = Store{}
@@ -32,9 +37,13 @@ var _ types.CacheMultiStore = Store{}
 // NewFromKVStore creates a new Store object from a mapping of store keys to
 // CacheWrapper objects and a KVStore as the database. Each CacheWrapper store
 // is a branched store.
+//
+// The writes to a store with listeners are recorded in the order they are
+// made, and passed to the listeners by FlushWriteListeners or Write.
 func NewFromKVStore(
 	store types.KVStore, stores map[types.StoreKey]types.CacheWrapper,
 	keys map[string]types.StoreKey, traceWriter io.Writer, traceContext types.TraceContext,
+	listeners map[types.StoreKey][]types.WriteListener,
 ) Store {
 	cms := Store{
 		db:           cachekv.NewStore(store),
@@ -42,6 +51,8 @@ func NewFromKVStore(
 		keys:         keys,
 		traceWriter:  traceWriter,
 		traceContext: traceContext,
+		listeners:    listeners,
+		listened:     make(map[types.StoreKey]types.KVStore),
 	}
 
 	for key, store := range stores {
@@ -50,6 +61,13 @@ func NewFromKVStore(
 		} else {
 			cms.stores[key] = store.CacheWrap()
 		}
+
+		if cms.ListeningEnabled(key) {
+			if cms.journal == nil {
+				cms.journal = &writeJournal{}
+			}
+			cms.listened[key] = listenkv.NewStore(cms.stores[key].(types.KVStore), key, []types.WriteListener{cms.journal})
+		}
 	}
 
 	return cms
@@ -59,19 +77,30 @@ func NewFromKVStore(
 // CacheWrapper objects. Each CacheWrapper store is a branched store.
 func NewStore(
 	db tmdb.DB, stores map[types.StoreKey]types.CacheWrapper, keys map[string]types.StoreKey,
-	traceWriter io.Writer, traceContext types.TraceContext,
+	traceWriter io.Writer, traceContext types.TraceContext, listeners map[types.StoreKey][]types.WriteListener,
 ) Store {
 
-	return NewFromKVStore(dbadapter.Store{DB: db}, stores, keys, traceWriter, traceContext)
+	return NewFromKVStore(dbadapter.Store{DB: db}, stores, keys, traceWriter, traceContext, listeners)
 }
 
+// newCacheMultiStoreFromCMS branches a Store. The writes to the listened
+// stores of the branch are passed to the journal of cms when the branch is
+// written, so that the writes of a discarded branch are never reported.
 func newCacheMultiStoreFromCMS(cms Store) Store {
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 	for k, v := range cms.stores {
 		stores[k] = v
 	}
 
-	return NewFromKVStore(cms.db, stores, nil, cms.traceWriter, cms.traceContext)
+	var listeners map[types.StoreKey][]types.WriteListener
+	if cms.journal != nil {
+		listeners = make(map[types.StoreKey][]types.WriteListener, len(cms.listened))
+		for key := range cms.listened {
+			listeners[key] = []types.WriteListener{cms.journal}
+		}
+	}
+
+	return NewFromKVStore(cms.db, stores, nil, cms.traceWriter, cms.traceContext, listeners)
 }
 
 // SetTracer sets the tracer for the MultiStore that the underlying
@@ -102,17 +131,43 @@ func (cms Store) TracingEnabled() bool {
 	return cms.traceWriter != nil
 }
 
+// ListeningEnabled returns if listening is enabled for a specific KVStore
+func (cms Store) ListeningEnabled(key types.StoreKey) bool {
+	if ls, ok := cms.listeners[key]; ok {
+		return len(ls) != 0
+	}
+	return false
+}
+
 // GetStoreType returns the type of the store.
 func (cms Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
 }
 
-// Write calls Write on each underlying store.
+// Write calls Write on each underlying store, and then passes the writes to
+// the listened stores to their listeners.
 func (cms Store) Write() {
 	cms.db.Write()
 	for _, store := range cms.stores {
 		store.Write()
 	}
+	cms.FlushWriteListeners()
+}
+
+// FlushWriteListeners passes the writes made to the listened stores since the
+// last flush to their listeners, in the order they were made.
+func (cms Store) FlushWriteListeners() {
+	if cms.journal == nil {
+		return
+	}
+
+	for _, w := range cms.journal.writes {
+		for _, l := range cms.listeners[w.storeKey] {
+			// as in listenkv, listeners report their errors themselves
+			_ = l.OnWrite(w.storeKey, w.key, w.value, w.delete)
+		}
+	}
+	cms.journal.writes = nil
 }
 
 // Implements CacheWrapper.
@@ -141,14 +196,46 @@ func (cms Store) CacheMultiStoreWithVersion(_ int64) (types.CacheMultiStore, err
 
 // GetStore returns an underlying Store by key.
 func (cms Store) GetStore(key types.StoreKey) types.Store {
+	if store, ok := cms.listened[key]; ok {
+		return store
+	}
 	return cms.stores[key].(types.Store)
 }
 
 // GetKVStore returns an underlying KVStore by key.
 func (cms Store) GetKVStore(key types.StoreKey) types.KVStore {
+	if store, ok := cms.listened[key]; ok {
+		return store
+	}
 	store := cms.stores[key]
 	if store == nil {
 		panic(fmt.Sprintf("kv store with key %v has not been registered in stores", key))
 	}
 	return store.(types.KVStore)
 }
+
+// write is a Set or Delete made to a listened store.
+type write struct {
+	storeKey   types.StoreKey
+	key, value []byte
+	delete     bool
+}
+
+// writeJournal is a WriteListener recording the writes to the listened stores
+// of a Store in the order they are made.
+type writeJournal struct {
+	writes []write
+}
+
+var _ types.WriteListener = (*writeJournal)(nil)
+
+// OnWrite implements types.WriteListener.
+func (j *writeJournal) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	j.writes = append(j.writes, write{
+		storeKey: storeKey,
+		key:      append([]byte(nil), key...),
+		value:    value,
+		delete:   delete,
+	})
+	return nil
+}
@@ -207,6 +207,17 @@ func (st *Store) VersionExists(version int64) bool {
 	return st.tree.VersionExists(version)
 }
 
+// LoadVersionForOverwriting loads the tree at the given version, or the latest
+// one below it, and deletes the versions after it.
+func (st *Store) LoadVersionForOverwriting(targetVersion int64) (int64, error) {
+	tree, ok := st.tree.(*iavl.MutableTree)
+	if !ok {
+		return 0, errors.New("unable to overwrite versions of an immutable IAVL tree")
+	}
+
+	return tree.LoadVersionForOverwriting(targetVersion)
+}
+
 // Implements Store.
 func (st *Store) GetStoreType() types.StoreType {
 	return types.StoreTypeIAVL
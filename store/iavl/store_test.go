@@ -9,6 +9,7 @@ import (
 	abci "github.com/line/ostracon/abci/types"
 	tmdb "github.com/line/tm-db/v2"
 	"github.com/line/tm-db/v2/memdb"
+	"github.com/line/tm-db/v2/metadb"
 	"github.com/stretchr/testify/require"
 
 	"github.com/line/lfb-sdk/store/types"
@@ -634,3 +635,35 @@ func TestSetInitialVersion(t *testing.T) {
 		})
 	}
 }
+
+// BenchmarkDBBackends compares the DB backends compiled in with their build tags, e.g.
+// go test -tags 'goleveldb badgerdb' -run=^$ -bench=DBBackends ./store/iavl
+func BenchmarkDBBackends(b *testing.B) {
+	const blockSize = 100
+
+	backends := metadb.AvailableDBBackends()
+	if len(backends) < 2 {
+		b.Skipf("only %v compiled in, run with -tags 'goleveldb badgerdb' to compare DB backends", backends)
+	}
+	for _, backend := range backends {
+		backend := backend
+		b.Run(string(backend), func(b *testing.B) {
+			db, err := metadb.NewDB("bench", backend, b.TempDir())
+			require.NoError(b, err)
+			defer db.Close()
+
+			tree, err := iavl.NewMutableTree(db, cacheSize)
+			require.NoError(b, err)
+			store := UnsafeNewStore(tree)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				store.Set(randBytes(32), randBytes(100))
+				if i%blockSize == blockSize-1 {
+					store.Commit()
+				}
+			}
+			store.Commit()
+		})
+	}
+}
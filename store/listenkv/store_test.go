@@ -0,0 +1,148 @@
+package listenkv_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/line/tm-db/v2/memdb"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/codec"
+	codecTypes "github.com/line/lfb-sdk/codec/types"
+	"github.com/line/lfb-sdk/store/dbadapter"
+	"github.com/line/lfb-sdk/store/listenkv"
+	"github.com/line/lfb-sdk/store/types"
+)
+
+func bz(s string) []byte { return []byte(s) }
+
+func keyFmt(i int) []byte { return bz(fmt.Sprintf("key%0.8d", i)) }
+func valFmt(i int) []byte { return bz(fmt.Sprintf("value%0.8d", i)) }
+
+var kvPairs = []types.KVPair{
+	{Key: keyFmt(1), Value: valFmt(1)},
+	{Key: keyFmt(2), Value: valFmt(2)},
+	{Key: keyFmt(3), Value: valFmt(3)},
+}
+
+var testStoreKey = types.NewKVStoreKey("listen_test")
+var interfaceRegistry = codecTypes.NewInterfaceRegistry()
+var testMarshaller = codec.NewProtoCodec(interfaceRegistry)
+
+func newListenKVStore(w *bytes.Buffer) *listenkv.Store {
+	store := newEmptyListenKVStore(w)
+
+	for _, kvPair := range kvPairs {
+		store.Set(kvPair.Key, kvPair.Value)
+	}
+
+	return store
+}
+
+func newEmptyListenKVStore(w *bytes.Buffer) *listenkv.Store {
+	listener := types.NewStoreKVPairWriteListener(w, testMarshaller)
+	memDB := dbadapter.Store{DB: memdb.NewDB()}
+
+	return listenkv.NewStore(memDB, testStoreKey, []types.WriteListener{listener})
+}
+
+func TestListenKVStoreGet(t *testing.T) {
+	var buf bytes.Buffer
+	store := newListenKVStore(&buf)
+	buf.Reset()
+
+	require.Equal(t, kvPairs[0].Value, store.Get(kvPairs[0].Key))
+	require.Nil(t, store.Get(bz("does-not-exist")))
+	require.True(t, store.Has(kvPairs[1].Key))
+
+	itr := store.Iterator(nil, nil)
+	for ; itr.Valid(); itr.Next() {
+		_ = itr.Value()
+	}
+	require.NoError(t, itr.Close())
+
+	// reads are not reported
+	require.Zero(t, buf.Len())
+}
+
+func TestListenKVStoreSetAndDelete(t *testing.T) {
+	testCases := []struct {
+		key         []byte
+		value       []byte
+		delete      bool
+		expectedOut *types.StoreKVPair
+	}{
+		{
+			key:   kvPairs[0].Key,
+			value: kvPairs[0].Value,
+			expectedOut: &types.StoreKVPair{
+				Key:      kvPairs[0].Key,
+				Value:    kvPairs[0].Value,
+				StoreKey: testStoreKey.Name(),
+			},
+		},
+		{
+			key:    kvPairs[1].Key,
+			delete: true,
+			expectedOut: &types.StoreKVPair{
+				Key:      kvPairs[1].Key,
+				StoreKey: testStoreKey.Name(),
+				Delete:   true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		var buf bytes.Buffer
+		store := newEmptyListenKVStore(&buf)
+
+		if tc.delete {
+			store.Delete(tc.key)
+			require.False(t, store.Has(tc.key))
+		} else {
+			store.Set(tc.key, tc.value)
+			require.Equal(t, tc.value, store.Get(tc.key))
+		}
+
+		var kvPair types.StoreKVPair
+		require.NoError(t, testMarshaller.UnmarshalBinaryLengthPrefixed(buf.Bytes(), &kvPair))
+		require.Equal(t, tc.expectedOut, &kvPair)
+	}
+
+	store := newEmptyListenKVStore(nil)
+	require.Panics(t, func() { store.Set(nil, []byte("value")) }, "setting a nil key should panic")
+	require.Panics(t, func() { store.Set([]byte(""), []byte("value")) }, "setting an empty key should panic")
+}
+
+type failingWriteListener struct{}
+
+func (failingWriteListener) OnWrite(types.StoreKey, []byte, []byte, bool) error {
+	return errors.New("failed")
+}
+
+func TestListenKVStoreFailingListener(t *testing.T) {
+	var buf bytes.Buffer
+	listeners := []types.WriteListener{failingWriteListener{}, types.NewStoreKVPairWriteListener(&buf, testMarshaller)}
+	store := listenkv.NewStore(dbadapter.Store{DB: memdb.NewDB()}, testStoreKey, listeners)
+
+	require.NotPanics(t, func() { store.Set(kvPairs[0].Key, kvPairs[0].Value) })
+	require.Equal(t, kvPairs[0].Value, store.Get(kvPairs[0].Key))
+
+	var kvPair types.StoreKVPair
+	require.NoError(t, testMarshaller.UnmarshalBinaryLengthPrefixed(buf.Bytes(), &kvPair))
+	require.Equal(t, kvPairs[0].Key, kvPair.Key)
+}
+
+func TestListenKVStoreGetStoreType(t *testing.T) {
+	memDB := dbadapter.Store{DB: memdb.NewDB()}
+	store := newEmptyListenKVStore(nil)
+	require.Equal(t, memDB.GetStoreType(), store.GetStoreType())
+}
+
+func TestListenKVStoreCacheWrap(t *testing.T) {
+	store := newEmptyListenKVStore(nil)
+	require.Panics(t, func() { store.CacheWrap() })
+	require.Panics(t, func() { store.CacheWrapWithTrace(nil, nil) })
+}
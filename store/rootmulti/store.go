@@ -1,8 +1,6 @@
 package rootmulti
 
 import (
-	"bufio"
-	"compress/zlib"
 	"encoding/binary"
 	"fmt"
 	"io"
@@ -19,26 +17,23 @@ import (
 	"github.com/line/tm-db/v2/prefixdb"
 	"github.com/pkg/errors"
 
-	"github.com/line/lfb-sdk/snapshots"
 	snapshottypes "github.com/line/lfb-sdk/snapshots/types"
 	"github.com/line/lfb-sdk/store/cachemulti"
 	"github.com/line/lfb-sdk/store/dbadapter"
 	"github.com/line/lfb-sdk/store/iavl"
+	"github.com/line/lfb-sdk/store/listenkv"
 	"github.com/line/lfb-sdk/store/mem"
 	"github.com/line/lfb-sdk/store/tracekv"
+	"github.com/line/lfb-sdk/store/transient"
 	"github.com/line/lfb-sdk/store/types"
 	sdkerrors "github.com/line/lfb-sdk/types/errors"
 )
 
 const (
-	latestVersionKey = "s/latest"
-	pruneHeightsKey  = "s/pruneheights"
-	commitInfoKeyFmt = "s/%d" // s/<version>
-
-	// Do not change chunk size without new snapshot format (must be uniform across nodes)
-	snapshotChunkSize   = uint64(10e6)
-	snapshotBufferSize  = int(snapshotChunkSize)
-	snapshotMaxItemSize = int(64e6) // SDK has no key/value size limit, so we set an arbitrary limit
+	latestVersionKey   = "s/latest"
+	rollbackVersionKey = "s/rollback"
+	pruneHeightsKey    = "s/pruneheights"
+	commitInfoKeyFmt   = "s/%d" // s/<version>
 )
 
 // Store is composed of many CommitStores. Name contrasts with
@@ -58,6 +53,8 @@ type Store struct {
 	traceWriter  io.Writer
 	traceContext types.TraceContext
 
+	listeners map[types.StoreKey][]types.WriteListener
+
 	interBlockCache  types.MultiStorePersistentCache
 	iavlCacheManager types.CacheManager
 }
@@ -78,10 +75,32 @@ func NewStore(db tmdb.DB) *Store {
 		storesParams: make(map[types.StoreKey]storeParams),
 		stores:       make(map[types.StoreKey]types.CommitKVStore),
 		keysByName:   make(map[string]types.StoreKey),
+		listeners:    make(map[types.StoreKey][]types.WriteListener),
 		pruneHeights: make([]int64, 0),
 	}
 }
 
+// NewStoreFromCommitInfo returns a Store with an IAVL store mounted for each
+// persisted store of the commit info of the given version, so that the state
+// of an app can be opened offline without the app. The stores aren't loaded.
+func NewStoreFromCommitInfo(db tmdb.DB, version int64) (*Store, error) {
+	cInfo, err := GetCommitInfo(db, version)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get commit info of version %d", version)
+	}
+
+	rs := NewStore(db)
+	for _, si := range cInfo.StoreInfos {
+		// memory stores are part of the commit info, but aren't versioned
+		if si.CommitId.Version == 0 {
+			continue
+		}
+		rs.MountStoreWithDB(types.NewKVStoreKey(si.Name), types.StoreTypeIAVL, nil)
+	}
+
+	return rs, nil
+}
+
 // GetPruning fetches the pruning strategy from the root store.
 func (rs *Store) GetPruning() types.PruningOptions {
 	return rs.pruningOpts
@@ -150,7 +169,7 @@ func (rs *Store) GetCommitKVStore(key types.StoreKey) types.CommitKVStore {
 
 // LoadLatestVersionAndUpgrade implements CommitMultiStore
 func (rs *Store) LoadLatestVersionAndUpgrade(upgrades *types.StoreUpgrades) error {
-	ver := getLatestVersion(rs.db)
+	ver := GetLatestVersion(rs.db)
 	return rs.loadVersion(ver, upgrades)
 }
 
@@ -161,7 +180,7 @@ func (rs *Store) LoadVersionAndUpgrade(ver int64, upgrades *types.StoreUpgrades)
 
 // LoadLatestVersion implements CommitMultiStore.
 func (rs *Store) LoadLatestVersion() error {
-	ver := getLatestVersion(rs.db)
+	ver := GetLatestVersion(rs.db)
 	return rs.loadVersion(ver, nil)
 }
 
@@ -170,7 +189,105 @@ func (rs *Store) LoadVersion(ver int64) error {
 	return rs.loadVersion(ver, nil)
 }
 
+// RollbackToVersion deletes the versions of the IAVL stores after the target
+// version, and makes the target version the latest one, so that the next commit
+// is at target+1. The stores must be loaded, and must not be used by a running
+// app. Nothing is deleted if a store doesn't have the target version, e.g. as it
+// was pruned.
+//
+// The stores are rolled back one after the other. If rolling back a store fails,
+// the stores rolled back before it have already lost their later versions, so the
+// target version is recorded before anything is deleted. Until the rollback to it
+// is run again and succeeds, only the target version can be loaded, see
+// GetRollbackVersion.
+func (rs *Store) RollbackToVersion(target int64) error {
+	if rollback := GetRollbackVersion(rs.db); rollback != 0 && rollback != target {
+		return fmt.Errorf("a rollback to version %d was interrupted and must be run again first", rollback)
+	}
+
+	latest := GetLatestVersion(rs.db)
+	if target <= 0 || target > latest {
+		return fmt.Errorf("invalid rollback version %d, the latest version is %d", target, latest)
+	}
+
+	cInfo, err := GetCommitInfo(rs.db, target)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get commit info of version %d", target)
+	}
+
+	keys := make([]types.StoreKey, 0, len(rs.stores))
+	for key, store := range rs.stores {
+		if store.GetStoreType() == types.StoreTypeIAVL {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name() < keys[j].Name() })
+
+	iavlStores := make([]*iavl.Store, len(keys))
+	for i, key := range keys {
+		// If the store is wrapped with an inter-block cache, we must first unwrap
+		// it to get the underlying IAVL store.
+		iavlStore := rs.GetCommitKVStore(key).(*iavl.Store)
+		if !iavlStore.VersionExists(target) {
+			return fmt.Errorf("version %d of store %s doesn't exist", target, key.Name())
+		}
+		iavlStores[i] = iavlStore
+	}
+
+	bz, err := gogotypes.StdInt64Marshal(target)
+	if err != nil {
+		return err
+	}
+	if err := rs.db.SetSync([]byte(rollbackVersionKey), bz); err != nil {
+		return errors.Wrap(err, "failed to record rollback version")
+	}
+
+	rolledBack := make([]string, 0, len(keys))
+	for i, store := range iavlStores {
+		if _, err := store.LoadVersionForOverwriting(target); err != nil {
+			return errors.Wrapf(err, "failed to roll back store %s after rolling back stores %v, "+
+				"the rollback to version %d must be run again", keys[i].Name(), rolledBack, target)
+		}
+		rolledBack = append(rolledBack, keys[i].Name())
+	}
+
+	pruneHeights := make([]int64, 0, len(rs.pruneHeights))
+	for _, h := range rs.pruneHeights {
+		if h <= target {
+			pruneHeights = append(pruneHeights, h)
+		}
+	}
+
+	batch := rs.db.NewBatch()
+	defer batch.Close()
+
+	for ver := target + 1; ver <= latest; ver++ {
+		if err := batch.Delete([]byte(fmt.Sprintf(commitInfoKeyFmt, ver))); err != nil {
+			return err
+		}
+	}
+	setLatestVersion(batch, target)
+	setPruningHeights(batch, pruneHeights)
+	if err := batch.Delete([]byte(rollbackVersionKey)); err != nil {
+		return err
+	}
+
+	if err := batch.WriteSync(); err != nil {
+		return errors.Wrapf(err, "failed to write rollback metadata, the rollback to version %d must be run again", target)
+	}
+
+	rs.lastCommitInfo = cInfo
+	rs.pruneHeights = pruneHeights
+
+	return nil
+}
+
 func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
+	if rollback := GetRollbackVersion(rs.db); rollback != 0 && ver != rollback {
+		return fmt.Errorf("a rollback to version %d was interrupted, "+
+			"it must be run again before version %d can be loaded", rollback, ver)
+	}
+
 	infos := make(map[string]types.StoreInfo)
 
 	cInfo := &types.CommitInfo{}
@@ -178,7 +295,7 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 	// load old data if we are not version 0
 	if ver != 0 {
 		var err error
-		cInfo, err = getCommitInfo(rs.db, ver)
+		cInfo, err = GetCommitInfo(rs.db, ver)
 		if err != nil {
 			return err
 		}
@@ -318,11 +435,28 @@ func (rs *Store) TracingEnabled() bool {
 	return rs.traceWriter != nil
 }
 
+// AddListeners adds listeners for a specific KVStore
+func (rs *Store) AddListeners(key types.StoreKey, listeners []types.WriteListener) {
+	if ls, ok := rs.listeners[key]; ok {
+		rs.listeners[key] = append(ls, listeners...)
+	} else {
+		rs.listeners[key] = listeners
+	}
+}
+
+// ListeningEnabled returns if listening is enabled for a specific KVStore
+func (rs *Store) ListeningEnabled(key types.StoreKey) bool {
+	if ls, ok := rs.listeners[key]; ok {
+		return len(ls) != 0
+	}
+	return false
+}
+
 // LastCommitID implements Committer/CommitStore.
 func (rs *Store) LastCommitID() types.CommitID {
 	if rs.lastCommitInfo == nil {
 		return types.CommitID{
-			Version: getLatestVersion(rs.db),
+			Version: GetLatestVersion(rs.db),
 		}
 	}
 
@@ -418,7 +552,7 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 		stores[k] = v
 	}
 
-	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.traceContext)
+	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.traceContext, rs.listeners)
 }
 
 // CacheMultiStoreWithVersion is analogous to CacheMultiStore except that it
@@ -448,7 +582,13 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 		}
 	}
 
-	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.traceContext), nil
+	// the branch is only used for queries, so it has no listeners
+	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.traceContext, nil), nil
+}
+
+// StoreKeysByName returns the keys of the mounted stores by their names.
+func (rs *Store) StoreKeysByName() map[string]types.StoreKey {
+	return rs.keysByName
 }
 
 // GetStore returns a mounted Store for a given StoreKey. If the StoreKey does
@@ -468,7 +608,9 @@ func (rs *Store) GetStore(key types.StoreKey) types.Store {
 
 // GetKVStore returns a mounted KVStore for a given StoreKey. If tracing is
 // enabled on the KVStore, a wrapped TraceKVStore will be returned with the root
-// store's tracer, otherwise, the original KVStore will be returned.
+// store's tracer, otherwise, the original KVStore will be returned. If
+// listening is enabled on the KVStore, it is additionally wrapped in a
+// ListenKVStore notifying its listeners.
 //
 // NOTE: The returned KVStore may be wrapped in an inter-block cache if it is
 // set on the root store.
@@ -478,6 +620,9 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 	if rs.TracingEnabled() {
 		store = tracekv.NewStore(store, rs.traceWriter, rs.traceContext)
 	}
+	if rs.ListeningEnabled(key) {
+		store = listenkv.NewStore(store, key, rs.listeners[key])
+	}
 
 	return store
 }
@@ -536,7 +681,7 @@ func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 	if res.Height == rs.lastCommitInfo.Version {
 		commitInfo = rs.lastCommitInfo
 	} else {
-		commitInfo, err = getCommitInfo(rs.db, res.Height)
+		commitInfo, err = GetCommitInfo(rs.db, res.Height)
 		if err != nil {
 			return sdkerrors.QueryResult(err)
 		}
@@ -591,15 +736,12 @@ func parsePath(path string) (storeName string, subpath string, err error) {
 // identical across nodes such that chunks from different sources fit together. If the output for a
 // given format changes (at the byte level), the snapshot format must be bumped - see
 // TestMultistoreSnapshot_Checksum test.
-func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, error) {
-	if format != snapshottypes.CurrentFormat {
-		return nil, sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
-	}
+func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 	if height == 0 {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot snapshot height 0")
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot snapshot height 0")
 	}
 	if height > uint64(rs.LastCommitID().Version) {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot future height %v", height)
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot future height %v", height)
 	}
 
 	// Collect stores to snapshot (only IAVL stores are supported)
@@ -612,11 +754,11 @@ func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, e
 		switch store := rs.GetCommitKVStore(key).(type) {
 		case *iavl.Store:
 			stores = append(stores, namedStore{name: key.Name(), Store: store})
-		case *mem.Store:
+		case *mem.Store, *transient.Store:
 			// Non-persisted stores shouldn't be snapshotted
 			continue
 		default:
-			return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic,
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic,
 				"don't know how to snapshot store %q of type %T", key.Name(), store)
 		}
 	}
@@ -624,70 +766,39 @@ func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, e
 		return strings.Compare(stores[i].name, stores[j].name) == -1
 	})
 
-	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
-	ch := make(chan io.ReadCloser)
-	go func() {
-		// Set up a stream pipeline to serialize snapshot nodes:
-		// ExportNode -> delimited Protobuf -> zlib -> buffer -> chunkWriter -> chan io.ReadCloser
-		chunkWriter := snapshots.NewChunkWriter(ch, snapshotChunkSize)
-		defer chunkWriter.Close()
-		bufWriter := bufio.NewWriterSize(chunkWriter, snapshotBufferSize)
-		defer func() {
-			if err := bufWriter.Flush(); err != nil {
-				chunkWriter.CloseWithError(err)
-			}
-		}()
-		zWriter, err := zlib.NewWriterLevel(bufWriter, 7)
-		if err != nil {
-			chunkWriter.CloseWithError(sdkerrors.Wrap(err, "zlib failure"))
-			return
-		}
-		defer func() {
-			if err := zWriter.Close(); err != nil {
-				chunkWriter.CloseWithError(err)
-			}
-		}()
-		protoWriter := protoio.NewDelimitedWriter(zWriter)
-		defer func() {
-			if err := protoWriter.Close(); err != nil {
-				chunkWriter.CloseWithError(err)
-			}
-		}()
-
-		// Export each IAVL store. Stores are serialized as a stream of SnapshotItem Protobuf
-		// messages. The first item contains a SnapshotStore with store metadata (i.e. name),
-		// and the following messages contain a SnapshotNode (i.e. an ExportNode). Store changes
-		// are demarcated by new SnapshotStore items.
-		for _, store := range stores {
+	// Export each IAVL store. Stores are serialized as a stream of SnapshotItem Protobuf
+	// messages. The first item contains a SnapshotStore with store metadata (i.e. name),
+	// and the following messages contain a SnapshotNode (i.e. an ExportNode). Store changes
+	// are demarcated by new SnapshotStore items.
+	for _, store := range stores {
+		err := func() error {
 			exporter, err := store.Export(int64(height))
 			if err != nil {
-				chunkWriter.CloseWithError(err)
-				return
+				return err
 			}
 			defer exporter.Close()
-			err = protoWriter.WriteMsg(&types.SnapshotItem{
-				Item: &types.SnapshotItem_Store{
-					Store: &types.SnapshotStoreItem{
+
+			err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+				Item: &snapshottypes.SnapshotItem_Store{
+					Store: &snapshottypes.SnapshotStoreItem{
 						Name: store.name,
 					},
 				},
 			})
 			if err != nil {
-				chunkWriter.CloseWithError(err)
-				return
+				return err
 			}
 
 			for {
 				node, err := exporter.Next()
 				if err == iavltree.ExportDone {
-					break
+					return nil
 				} else if err != nil {
-					chunkWriter.CloseWithError(err)
-					return
+					return err
 				}
-				err = protoWriter.WriteMsg(&types.SnapshotItem{
-					Item: &types.SnapshotItem_IAVL{
-						IAVL: &types.SnapshotIAVLItem{
+				err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+					Item: &snapshottypes.SnapshotItem_IAVL{
+						IAVL: &snapshottypes.SnapshotIAVLItem{
 							Key:     node.Key,
 							Value:   node.Value,
 							Height:  int32(node.Height),
@@ -696,88 +807,74 @@ func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, e
 					},
 				})
 				if err != nil {
-					chunkWriter.CloseWithError(err)
-					return
+					return err
 				}
 			}
-			exporter.Close()
+		}()
+		if err != nil {
+			return err
 		}
-	}()
+	}
 
-	return ch, nil
+	return nil
 }
 
 // Restore implements snapshottypes.Snapshotter.
+// returns next snapshot item and error.
 func (rs *Store) Restore(
-	height uint64, format uint32, chunks <-chan io.ReadCloser, ready chan<- struct{},
-) error {
-	if format != snapshottypes.CurrentFormat {
-		return sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	height uint64, format uint32, protoReader protoio.Reader,
+) (snapshottypes.SnapshotItem, error) {
+	if !snapshottypes.IsSupportedFormat(format) {
+		return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
 	}
 	if height == 0 {
-		return sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot restore snapshot at height 0")
+		return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot restore snapshot at height 0")
 	}
 	if height > uint64(math.MaxInt64) {
-		return sdkerrors.Wrapf(snapshottypes.ErrInvalidMetadata,
+		return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(snapshottypes.ErrInvalidMetadata,
 			"snapshot height %v cannot exceed %v", height, int64(math.MaxInt64))
 	}
 
-	// Signal readiness. Must be done before the readers below are set up, since the zlib
-	// reader reads from the stream on initialization, potentially causing deadlocks.
-	if ready != nil {
-		close(ready)
-	}
-
-	// Set up a restore stream pipeline
-	// chan io.ReadCloser -> chunkReader -> zlib -> delimited Protobuf -> ExportNode
-	chunkReader := snapshots.NewChunkReader(chunks)
-	defer chunkReader.Close()
-	zReader, err := zlib.NewReader(chunkReader)
-	if err != nil {
-		return sdkerrors.Wrap(err, "zlib failure")
-	}
-	defer zReader.Close()
-	protoReader := protoio.NewDelimitedReader(zReader, snapshotMaxItemSize)
-	defer protoReader.Close()
-
 	// Import nodes into stores. The first item is expected to be a SnapshotItem containing
 	// a SnapshotStoreItem, telling us which store to import into. The following items will contain
 	// SnapshotNodeItem (i.e. ExportNode) until we reach the next SnapshotStoreItem or EOF.
 	var importer *iavltree.Importer
+	var snapshotItem snapshottypes.SnapshotItem
+loop:
 	for {
-		item := &types.SnapshotItem{}
-		err := protoReader.ReadMsg(item)
+		snapshotItem = snapshottypes.SnapshotItem{}
+		err := protoReader.ReadMsg(&snapshotItem)
 		if err == io.EOF {
 			break
 		} else if err != nil {
-			return sdkerrors.Wrap(err, "invalid protobuf message")
+			return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "invalid protobuf message")
 		}
 
-		switch item := item.Item.(type) {
-		case *types.SnapshotItem_Store:
+		switch item := snapshotItem.Item.(type) {
+		case *snapshottypes.SnapshotItem_Store:
 			if importer != nil {
 				err = importer.Commit()
 				if err != nil {
-					return sdkerrors.Wrap(err, "IAVL commit failed")
+					return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "IAVL commit failed")
 				}
 				importer.Close()
 			}
 			store, ok := rs.getStoreByName(item.Store.Name).(*iavl.Store)
 			if !ok || store == nil {
-				return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot import into non-IAVL store %q", item.Store.Name)
+				return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot import into non-IAVL store %q", item.Store.Name)
 			}
 			importer, err = store.Import(int64(height))
 			if err != nil {
-				return sdkerrors.Wrap(err, "import failed")
+				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "import failed")
 			}
 			defer importer.Close()
 
-		case *types.SnapshotItem_IAVL:
+		case *snapshottypes.SnapshotItem_IAVL:
 			if importer == nil {
-				return sdkerrors.Wrap(sdkerrors.ErrLogic, "received IAVL node item before store item")
+				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(sdkerrors.ErrLogic, "received IAVL node item before store item")
 			}
 			if item.IAVL.Height > math.MaxInt8 {
-				return sdkerrors.Wrapf(sdkerrors.ErrLogic, "node height %v cannot exceed %v",
+				return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(sdkerrors.ErrLogic, "node height %v cannot exceed %v",
 					item.IAVL.Height, math.MaxInt8)
 			}
 			node := &iavltree.ExportNode{
@@ -796,24 +893,25 @@ func (rs *Store) Restore(
 			}
 			err := importer.Add(node)
 			if err != nil {
-				return sdkerrors.Wrap(err, "IAVL node import failed")
+				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "IAVL node import failed")
 			}
 
 		default:
-			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unknown snapshot item %T", item)
+			// the remaining items belong to the extension snapshotters
+			break loop
 		}
 	}
 
 	if importer != nil {
 		err := importer.Commit()
 		if err != nil {
-			return sdkerrors.Wrap(err, "IAVL commit failed")
+			return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "IAVL commit failed")
 		}
 		importer.Close()
 	}
 
 	flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)), []int64{})
-	return rs.LoadLatestVersion()
+	return snapshotItem, rs.LoadLatestVersion()
 }
 
 func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
@@ -864,6 +962,13 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 
 		return mem.NewStore(), nil
 
+	case types.StoreTypeTransient:
+		if _, ok := key.(*types.TransientStoreKey); !ok {
+			return nil, fmt.Errorf("unexpected key type for a TransientStoreKey; got: %s", key.String())
+		}
+
+		return transient.NewStore(), nil
+
 	default:
 		panic(fmt.Sprintf("unrecognized store type %v", params.typ))
 	}
@@ -872,6 +977,9 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 func (rs *Store) buildCommitInfo(version int64) *types.CommitInfo {
 	storeInfos := []types.StoreInfo{}
 	for key, store := range rs.stores {
+		if store.GetStoreType() == types.StoreTypeTransient {
+			continue
+		}
 		storeInfos = append(storeInfos, types.StoreInfo{
 			Name:     key.Name(),
 			CommitId: store.LastCommitID(),
@@ -890,7 +998,8 @@ type storeParams struct {
 	initialVersion uint64
 }
 
-func getLatestVersion(db tmdb.DB) int64 {
+// GetLatestVersion returns the latest version committed to the DB.
+func GetLatestVersion(db tmdb.DB) int64 {
 	bz, err := db.Get([]byte(latestVersionKey))
 	if err != nil {
 		panic(err)
@@ -907,6 +1016,27 @@ func getLatestVersion(db tmdb.DB) int64 {
 	return latestVersion
 }
 
+// GetRollbackVersion returns the target version of a rollback which was
+// interrupted, or 0 if there is none. The stores of the DB may have been rolled
+// back partially, so only the target version can be loaded until the rollback is
+// run again.
+func GetRollbackVersion(db tmdb.DB) int64 {
+	bz, err := db.Get([]byte(rollbackVersionKey))
+	if err != nil {
+		panic(err)
+	} else if bz == nil {
+		return 0
+	}
+
+	var version int64
+
+	if err := gogotypes.StdInt64Unmarshal(&version, bz); err != nil {
+		panic(err)
+	}
+
+	return version
+}
+
 // Commits each store and returns a new commitInfo.
 func commitStores(version int64, storeMap map[types.StoreKey]types.CommitKVStore) *types.CommitInfo {
 	storeInfos := make([]types.StoreInfo, len(storeMap))
@@ -914,6 +1044,12 @@ func commitStores(version int64, storeMap map[types.StoreKey]types.CommitKVStore
 	var wg sync.WaitGroup
 	ix := 0
 	for key, store := range storeMap {
+		// transient stores are reset on commit, and aren't part of the app hash
+		if store.GetStoreType() == types.StoreTypeTransient {
+			store.Commit()
+			continue
+		}
+
 		wg.Add(1)
 		go func(i int, k types.StoreKey, s types.CommitKVStore) {
 			commitID := s.Commit()
@@ -930,12 +1066,12 @@ func commitStores(version int64, storeMap map[types.StoreKey]types.CommitKVStore
 
 	return &types.CommitInfo{
 		Version:    version,
-		StoreInfos: storeInfos,
+		StoreInfos: storeInfos[:ix],
 	}
 }
 
-// Gets commitInfo from disk.
-func getCommitInfo(db tmdb.DB, ver int64) (*types.CommitInfo, error) {
+// GetCommitInfo gets the commitInfo of a version from disk.
+func GetCommitInfo(db tmdb.DB, ver int64) (*types.CommitInfo, error) {
 	cInfoKey := fmt.Sprintf(commitInfoKeyFmt, ver)
 
 	bz, err := db.Get([]byte(cInfoKey))
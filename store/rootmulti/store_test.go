@@ -1,6 +1,7 @@
 package rootmulti
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
@@ -18,6 +19,7 @@ import (
 	tmdb "github.com/line/tm-db/v2"
 	"github.com/line/tm-db/v2/memdb"
 
+	"github.com/line/lfb-sdk/snapshots"
 	snapshottypes "github.com/line/lfb-sdk/snapshots/types"
 	"github.com/line/lfb-sdk/store/iavl"
 	sdkmaps "github.com/line/lfb-sdk/store/internal/maps"
@@ -125,6 +127,190 @@ func TestHashStableWithEmptyCommit(t *testing.T) {
 	require.Equal(t, hash, cID.Hash)
 }
 
+func TestTransientStoreNotCommitted(t *testing.T) {
+	var db tmdb.DB = memdb.NewDB()
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+	withTransient := newMultiStoreWithMounts(memdb.NewDB(), types.PruneNothing)
+	tkey := types.NewTransientStoreKey("transient")
+	withTransient.MountStoreWithDB(tkey, types.StoreTypeTransient, nil)
+	require.NoError(t, withTransient.LoadLatestVersion())
+
+	k, v := []byte("wind"), []byte("blows")
+	ms.getStoreByName("store1").(types.KVStore).Set(k, v)
+	withTransient.getStoreByName("store1").(types.KVStore).Set(k, v)
+	tstore := withTransient.GetKVStore(tkey)
+	tstore.Set(k, v)
+
+	// the transient store doesn't change the app hash, and is reset on commit
+	cID := withTransient.Commit()
+	require.Equal(t, ms.Commit(), cID)
+	require.Nil(t, tstore.Get(k))
+	for _, si := range withTransient.lastCommitInfo.StoreInfos {
+		require.NotEqual(t, "transient", si.Name)
+	}
+
+	// it is still loaded after a restart
+	withTransient = newMultiStoreWithMounts(withTransient.db, types.PruneNothing)
+	withTransient.MountStoreWithDB(tkey, types.StoreTypeTransient, nil)
+	require.NoError(t, withTransient.LoadLatestVersion())
+	require.Equal(t, cID, withTransient.LastCommitID())
+	require.Equal(t, types.StoreTypeTransient, withTransient.GetCommitKVStore(tkey).GetStoreType())
+}
+
+func TestRollbackToVersion(t *testing.T) {
+	var db tmdb.DB = memdb.NewDB()
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	k := []byte("height")
+	commitIDs := make([]types.CommitID, 0, 5)
+	for i := byte(1); i <= 5; i++ {
+		ms.getStoreByName("store1").(types.KVStore).Set(k, []byte{i})
+		commitIDs = append(commitIDs, ms.Commit())
+	}
+
+	require.Error(t, ms.RollbackToVersion(0))
+	require.Error(t, ms.RollbackToVersion(6))
+
+	require.NoError(t, ms.RollbackToVersion(3))
+	require.Equal(t, commitIDs[2], ms.LastCommitID())
+	require.Equal(t, []byte{3}, ms.getStoreByName("store1").(types.KVStore).Get(k))
+	require.False(t, ms.getStoreByName("store1").(*iavl.Store).VersionExists(4))
+	_, err := GetCommitInfo(db, 4)
+	require.Error(t, err)
+
+	// the rolled back versions are committed again
+	ms.getStoreByName("store1").(types.KVStore).Set(k, []byte{4})
+	require.Equal(t, commitIDs[3], ms.Commit())
+
+	ms = newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Equal(t, commitIDs[3], ms.LastCommitID())
+}
+
+// failingDB is a DB whose batches fail to be written once they delete a key with
+// the given prefix.
+type failingDB struct {
+	tmdb.DB
+	prefix []byte
+}
+
+func (db failingDB) NewBatch() tmdb.Batch {
+	return &failingBatch{Batch: db.DB.NewBatch(), prefix: db.prefix}
+}
+
+type failingBatch struct {
+	tmdb.Batch
+	prefix []byte
+	failed bool
+}
+
+func (b *failingBatch) Delete(key []byte) error {
+	if bytes.HasPrefix(key, b.prefix) {
+		b.failed = true
+	}
+	return b.Batch.Delete(key)
+}
+
+func (b *failingBatch) Write() error {
+	if b.failed {
+		return errors.New("write failed")
+	}
+	return b.Batch.Write()
+}
+
+func (b *failingBatch) WriteSync() error {
+	if b.failed {
+		return errors.New("write failed")
+	}
+	return b.Batch.WriteSync()
+}
+
+func TestRollbackToVersionInterrupted(t *testing.T) {
+	var db tmdb.DB = memdb.NewDB()
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	k := []byte("height")
+	commitIDs := make([]types.CommitID, 0, 5)
+	for i := byte(1); i <= 5; i++ {
+		for _, name := range []string{"store1", "store2", "store3"} {
+			ms.getStoreByName(name).(types.KVStore).Set(k, []byte{i})
+		}
+		commitIDs = append(commitIDs, ms.Commit())
+	}
+
+	// rolling back store2 fails after store1 was rolled back
+	ms = newMultiStoreWithMounts(failingDB{DB: db, prefix: []byte("s/k:store2/")}, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+	err := ms.RollbackToVersion(3)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to roll back store store2 after rolling back stores [store1]")
+	require.Equal(t, int64(3), GetRollbackVersion(db))
+	require.Equal(t, int64(5), GetLatestVersion(db))
+
+	// the app can't be loaded, and another rollback can't be started
+	ms = newMultiStoreWithMounts(db, types.PruneNothing)
+	require.Error(t, ms.LoadLatestVersion())
+	require.NoError(t, ms.LoadVersion(3))
+	require.Error(t, ms.RollbackToVersion(2))
+
+	// running the rollback again completes it
+	require.NoError(t, ms.RollbackToVersion(3))
+	require.Equal(t, int64(0), GetRollbackVersion(db))
+	require.Equal(t, commitIDs[2], ms.LastCommitID())
+	for _, name := range []string{"store1", "store2", "store3"} {
+		require.False(t, ms.getStoreByName(name).(*iavl.Store).VersionExists(4), name)
+	}
+
+	ms = newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Equal(t, commitIDs[2], ms.LastCommitID())
+	require.Equal(t, []byte{3}, ms.getStoreByName("store2").(types.KVStore).Get(k))
+}
+
+func TestRollbackToPrunedVersion(t *testing.T) {
+	var db tmdb.DB = memdb.NewDB()
+	ms := newMultiStoreWithMounts(db, types.PruningOptions{KeepRecent: 1, KeepEvery: 0, Interval: 1})
+	require.NoError(t, ms.LoadLatestVersion())
+	for i := 0; i < 3; i++ {
+		ms.Commit()
+	}
+
+	// nothing is deleted if a store doesn't have the version
+	require.Error(t, ms.RollbackToVersion(1))
+	require.Equal(t, int64(3), GetLatestVersion(db))
+	require.True(t, ms.getStoreByName("store1").(*iavl.Store).VersionExists(3))
+}
+
+func TestNewStoreFromCommitInfo(t *testing.T) {
+	var db tmdb.DB = memdb.NewDB()
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	ms.MountStoreWithDB(types.NewMemoryStoreKey("mem"), types.StoreTypeMemory, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+	ms.getStoreByName("store2").(types.KVStore).Set([]byte("key"), []byte("value"))
+	cID := ms.Commit()
+
+	_, err := NewStoreFromCommitInfo(db, 2)
+	require.Error(t, err)
+
+	opened, err := NewStoreFromCommitInfo(db, 1)
+	require.NoError(t, err)
+	require.NoError(t, opened.LoadLatestVersion())
+	require.Equal(t, cID, opened.LastCommitID())
+	require.Len(t, opened.stores, 3)
+	require.Nil(t, opened.getStoreByName("mem"))
+	require.Equal(t, []byte("value"), opened.getStoreByName("store2").(types.KVStore).Get([]byte("key")))
+
+	keys := opened.StoreKeysByName()
+	require.Len(t, keys, 3)
+	require.NotContains(t, keys, "mem")
+	cms, err := opened.CacheMultiStoreWithVersion(1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), cms.GetKVStore(keys["store2"]).Get([]byte("key")))
+}
+
 func TestMultistoreCommitLoad(t *testing.T) {
 	var db tmdb.DB = memdb.NewDB()
 	store := newMultiStoreWithMounts(db, types.PruneNothing)
@@ -202,7 +388,7 @@ func TestMultistoreLoadWithUpgrade(t *testing.T) {
 	expectedCommitID := getExpectedCommitID(store, 1)
 	checkStore(t, store, expectedCommitID, commitID)
 
-	ci, err := getCommitInfo(db, 1)
+	ci, err := GetCommitInfo(db, 1)
 	require.NoError(t, err)
 	require.Equal(t, int64(1), ci.Version)
 	require.Equal(t, 3, len(ci.StoreInfos))
@@ -286,7 +472,7 @@ func TestMultistoreLoadWithUpgrade(t *testing.T) {
 	require.Equal(t, v4, rl4.Get(k4))
 
 	// check commitInfo in storage
-	ci, err = getCommitInfo(db, 2)
+	ci, err = GetCommitInfo(db, 2)
 	require.NoError(t, err)
 	require.Equal(t, int64(2), ci.Version)
 	require.Equal(t, 4, len(ci.StoreInfos), ci.StoreInfos)
@@ -346,7 +532,7 @@ func TestMultiStoreRestart(t *testing.T) {
 
 		multi.Commit()
 
-		cinfo, err := getCommitInfo(multi.db, int64(i))
+		cinfo, err := GetCommitInfo(multi.db, int64(i))
 		require.NoError(t, err)
 		require.Equal(t, int64(i), cinfo.Version)
 	}
@@ -361,7 +547,7 @@ func TestMultiStoreRestart(t *testing.T) {
 
 	multi.Commit()
 
-	flushedCinfo, err := getCommitInfo(multi.db, 3)
+	flushedCinfo, err := GetCommitInfo(multi.db, 3)
 	require.Nil(t, err)
 	require.NotEqual(t, initCid, flushedCinfo, "CID is different after flush to disk")
 
@@ -371,7 +557,7 @@ func TestMultiStoreRestart(t *testing.T) {
 
 	multi.Commit()
 
-	postFlushCinfo, err := getCommitInfo(multi.db, 4)
+	postFlushCinfo, err := GetCommitInfo(multi.db, 4)
 	require.NoError(t, err)
 	require.Equal(t, int64(4), postFlushCinfo.Version, "Commit changed after in-memory commit")
 
@@ -565,8 +751,14 @@ func TestMultistoreSnapshot_Checksum(t *testing.T) {
 	for _, tc := range testcases {
 		tc := tc
 		t.Run(fmt.Sprintf("Format %v", tc.format), func(t *testing.T) {
-			chunks, err := store.Snapshot(version, tc.format)
-			require.NoError(t, err)
+			chunks := make(chan io.ReadCloser)
+			go func() {
+				streamWriter := snapshots.NewStreamWriter(chunks)
+				require.NotNil(t, streamWriter)
+				defer streamWriter.Close()
+				err := store.Snapshot(version, streamWriter)
+				require.NoError(t, err)
+			}()
 			hashes := []string{}
 			hasher := sha256.New()
 			for chunk := range chunks {
@@ -586,18 +778,15 @@ func TestMultistoreSnapshot_Errors(t *testing.T) {
 
 	testcases := map[string]struct {
 		height     uint64
-		format     uint32
 		expectType error
 	}{
-		"0 height":       {0, snapshottypes.CurrentFormat, nil},
-		"0 format":       {1, 0, snapshottypes.ErrUnknownFormat},
-		"unknown height": {9, snapshottypes.CurrentFormat, nil},
-		"unknown format": {1, 9, snapshottypes.ErrUnknownFormat},
+		"0 height":       {0, nil},
+		"unknown height": {9, nil},
 	}
 	for name, tc := range testcases {
 		tc := tc
 		t.Run(name, func(t *testing.T) {
-			_, err := store.Snapshot(tc.height, tc.format)
+			err := store.Snapshot(tc.height, nil)
 			require.Error(t, err)
 			if tc.expectType != nil {
 				assert.True(t, errors.Is(err, tc.expectType))
@@ -621,7 +810,7 @@ func TestMultistoreRestore_Errors(t *testing.T) {
 	for name, tc := range testcases {
 		tc := tc
 		t.Run(name, func(t *testing.T) {
-			err := store.Restore(tc.height, tc.format, nil, nil)
+			_, err := store.Restore(tc.height, tc.format, nil)
 			require.Error(t, err)
 			if tc.expectType != nil {
 				assert.True(t, errors.Is(err, tc.expectType))
@@ -636,12 +825,29 @@ func TestMultistoreSnapshotRestore(t *testing.T) {
 	version := uint64(source.LastCommitID().Version)
 	require.EqualValues(t, 3, version)
 
-	chunks, err := source.Snapshot(version, snapshottypes.CurrentFormat)
+	// the multistore returns the first item it doesn't know about, which
+	// belongs to the extension snapshotters
+	extensionItem := snapshottypes.SnapshotItem{
+		Item: &snapshottypes.SnapshotItem_Extension{
+			Extension: &snapshottypes.SnapshotExtensionMeta{Name: "test", Format: 1},
+		},
+	}
+	chunks := make(chan io.ReadCloser, 100)
+	go func() {
+		streamWriter := snapshots.NewStreamWriter(chunks)
+		require.NotNil(t, streamWriter)
+		defer streamWriter.Close()
+		err := source.Snapshot(version, streamWriter)
+		require.NoError(t, err)
+		err = streamWriter.WriteMsg(&extensionItem)
+		require.NoError(t, err)
+	}()
+
+	streamReader, err := snapshots.NewStreamReader(chunks)
 	require.NoError(t, err)
-	ready := make(chan struct{})
-	err = target.Restore(version, snapshottypes.CurrentFormat, chunks, ready)
+	next, err := target.Restore(version, snapshottypes.CurrentFormat, streamReader)
 	require.NoError(t, err)
-	assert.EqualValues(t, struct{}{}, <-ready)
+	require.Equal(t, *extensionItem.GetExtension(), *next.GetExtension())
 
 	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
 	for key, sourceStore := range source.stores {
@@ -668,6 +874,99 @@ func TestSetInitialVersion(t *testing.T) {
 	require.True(t, iavlStore.VersionExists(5))
 }
 
+func TestAddListenersAndListeningEnabled(t *testing.T) {
+	db := memdb.NewDB()
+	multi := newMultiStoreWithMounts(db, types.PruneNothing)
+	testKey := types.NewKVStoreKey("listening_test_key")
+	enabled := multi.ListeningEnabled(testKey)
+	require.False(t, enabled)
+
+	multi.AddListeners(testKey, []types.WriteListener{})
+	enabled = multi.ListeningEnabled(testKey)
+	require.False(t, enabled)
+
+	mockListener := types.NewStoreKVPairWriteListener(nil, nil)
+	multi.AddListeners(testKey, []types.WriteListener{mockListener})
+	wrongTestKey := types.NewKVStoreKey("wrong_listening_test_key")
+	enabled = multi.ListeningEnabled(wrongTestKey)
+	require.False(t, enabled)
+
+	enabled = multi.ListeningEnabled(testKey)
+	require.True(t, enabled)
+}
+
+type recordingListener struct {
+	pairs []types.StoreKVPair
+}
+
+func (l *recordingListener) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	l.pairs = append(l.pairs, types.StoreKVPair{StoreKey: storeKey.Name(), Key: key, Value: value, Delete: delete})
+	return nil
+}
+
+func TestCacheMultiStoreListeners(t *testing.T) {
+	db := memdb.NewDB()
+	multi := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, multi.LoadLatestVersion())
+
+	key1, key2, key3 := multi.keysByName["store1"], multi.keysByName["store2"], multi.keysByName["store3"]
+	listener := &recordingListener{}
+	multi.AddListeners(key3, []types.WriteListener{listener})
+	multi.AddListeners(key1, []types.WriteListener{listener})
+
+	multi.GetKVStore(key1).Set([]byte("existing"), []byte("value"))
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "store1", Key: []byte("existing"), Value: []byte("value")},
+	}, listener.pairs)
+	listener.pairs = nil
+
+	cms := multi.CacheMultiStore()
+	require.True(t, cms.ListeningEnabled(key1))
+	require.False(t, cms.ListeningEnabled(key2))
+
+	// writes of a branch of the branch are reported once the branch is written
+	branch := cms.CacheMultiStore()
+	branch.GetKVStore(key3).Set([]byte("b"), []byte("3"))
+	branch.GetKVStore(key2).Set([]byte("a"), []byte("2"))
+	branch.GetKVStore(key1).Set([]byte("b"), []byte("1"))
+	branch.GetKVStore(key1).Delete([]byte("existing"))
+	branch.GetKVStore(key1).Set([]byte("b"), []byte("2"))
+	cms.FlushWriteListeners()
+	require.Empty(t, listener.pairs)
+
+	// a discarded branch is never reported
+	discarded := cms.CacheMultiStore()
+	discarded.GetKVStore(key1).Set([]byte("c"), []byte("1"))
+
+	branch.Write()
+	cms.GetKVStore(key1).Set([]byte("a"), []byte("1"))
+	require.Empty(t, listener.pairs)
+
+	// the writes are reported in the order they were made, without being
+	// written back to the root store
+	cms.FlushWriteListeners()
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "store3", Key: []byte("b"), Value: []byte("3")},
+		{StoreKey: "store1", Key: []byte("b"), Value: []byte("1")},
+		{StoreKey: "store1", Key: []byte("existing"), Delete: true},
+		{StoreKey: "store1", Key: []byte("b"), Value: []byte("2")},
+		{StoreKey: "store1", Key: []byte("a"), Value: []byte("1")},
+	}, listener.pairs)
+	require.True(t, multi.GetKVStore(key1).Has([]byte("existing")))
+	listener.pairs = nil
+
+	// writing the branch reports nothing more
+	cms.Write()
+	require.Empty(t, listener.pairs)
+	require.False(t, multi.GetKVStore(key1).Has([]byte("existing")))
+
+	// branches loaded at a version are never written and have no listeners
+	multi.Commit()
+	cms, err := multi.CacheMultiStoreWithVersion(1)
+	require.NoError(t, err)
+	require.False(t, cms.ListeningEnabled(key1))
+}
+
 func BenchmarkMultistoreSnapshot100K(b *testing.B) {
 	benchmarkMultistoreSnapshot(b, 10, 10000)
 }
@@ -700,8 +999,14 @@ func benchmarkMultistoreSnapshot(b *testing.B, stores uint8, storeKeys uint64) {
 		require.NoError(b, err)
 		require.EqualValues(b, 0, target.LastCommitID().Version)
 
-		chunks, err := source.Snapshot(uint64(version), snapshottypes.CurrentFormat)
-		require.NoError(b, err)
+		chunks := make(chan io.ReadCloser)
+		go func() {
+			streamWriter := snapshots.NewStreamWriter(chunks)
+			require.NotNil(b, streamWriter)
+			err := source.Snapshot(uint64(version), streamWriter)
+			require.NoError(b, err)
+			require.NoError(b, streamWriter.Close())
+		}()
 		for reader := range chunks {
 			_, err := io.Copy(ioutil.Discard, reader)
 			require.NoError(b, err)
@@ -727,9 +1032,17 @@ func benchmarkMultistoreSnapshotRestore(b *testing.B, stores uint8, storeKeys ui
 		require.NoError(b, err)
 		require.EqualValues(b, 0, target.LastCommitID().Version)
 
-		chunks, err := source.Snapshot(version, snapshottypes.CurrentFormat)
+		chunks := make(chan io.ReadCloser)
+		go func() {
+			streamWriter := snapshots.NewStreamWriter(chunks)
+			require.NotNil(b, streamWriter)
+			err := source.Snapshot(version, streamWriter)
+			require.NoError(b, err)
+			require.NoError(b, streamWriter.Close())
+		}()
+		streamReader, err := snapshots.NewStreamReader(chunks)
 		require.NoError(b, err)
-		err = target.Restore(version, snapshottypes.CurrentFormat, chunks, nil)
+		_, err = target.Restore(version, snapshottypes.CurrentFormat, streamReader)
 		require.NoError(b, err)
 		require.Equal(b, source.LastCommitID(), target.LastCommitID())
 	}
@@ -0,0 +1,58 @@
+package transient
+
+import (
+	"io"
+
+	"github.com/line/tm-db/v2/memdb"
+
+	"github.com/line/lfb-sdk/store/cachekv"
+	"github.com/line/lfb-sdk/store/dbadapter"
+	"github.com/line/lfb-sdk/store/tracekv"
+	"github.com/line/lfb-sdk/store/types"
+)
+
+var (
+	_ types.KVStore   = (*Store)(nil)
+	_ types.Committer = (*Store)(nil)
+)
+
+// Store is a wrapper for a MemDB with Commiter implementation. Entries are
+// reset on each commit, so that they only live for a block, and they are not
+// part of the app state.
+type Store struct {
+	dbadapter.Store
+}
+
+// NewStore constructs new MemDB adapter
+func NewStore() *Store {
+	return &Store{Store: dbadapter.Store{DB: memdb.NewDB()}}
+}
+
+// GetStoreType returns the Store's type.
+func (ts Store) GetStoreType() types.StoreType {
+	return types.StoreTypeTransient
+}
+
+// CacheWrap branches the underlying store.
+func (ts Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(ts)
+}
+
+// CacheWrapWithTrace implements KVStore.
+func (ts Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(ts, w, tc))
+}
+
+// Commit cleans up the Store.
+func (ts *Store) Commit() (id types.CommitID) {
+	ts.Store = dbadapter.Store{DB: memdb.NewDB()}
+	return
+}
+
+func (ts *Store) SetPruning(pruning types.PruningOptions) {}
+
+// GetPruning is a no-op as pruning options cannot be directly set on this store.
+// They must be set on the root commit multi-store.
+func (ts *Store) GetPruning() types.PruningOptions { return types.PruningOptions{} }
+
+func (ts Store) LastCommitID() (id types.CommitID) { return }
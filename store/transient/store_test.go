@@ -0,0 +1,30 @@
+package transient_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/store/transient"
+	"github.com/line/lfb-sdk/store/types"
+)
+
+var k, v = []byte("hello"), []byte("world")
+
+func TestTransientStore(t *testing.T) {
+	tstore := transient.NewStore()
+
+	require.Equal(t, types.StoreTypeTransient, tstore.GetStoreType())
+
+	require.Nil(t, tstore.Get(k))
+
+	tstore.Set(k, v)
+
+	require.Equal(t, v, tstore.Get(k))
+
+	id := tstore.Commit()
+	require.True(t, id.IsZero())
+	require.True(t, tstore.LastCommitID().IsZero())
+
+	require.Nil(t, tstore.Get(k))
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"io"
+
+	"github.com/line/lfb-sdk/codec"
+)
+
+// WriteListener interface for streaming data out from a listenkv.Store
+type WriteListener interface {
+	// OnWrite is called for every Set and Delete written to a listened store.
+	// storeKey indicates the source KVStore, to facilitate using the same
+	// WriteListener across separate KVStores. delete indicates whether the
+	// write is a Delete, in which case value is nil.
+	OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error
+}
+
+// StoreKVPairWriteListener is used to configure listening to a KVStore by
+// writing out length-prefixed protobuf encoded StoreKVPairs to an underlying
+// io.Writer.
+type StoreKVPairWriteListener struct {
+	writer     io.Writer
+	marshaller codec.BinaryMarshaler
+}
+
+var _ WriteListener = (*StoreKVPairWriteListener)(nil)
+
+// NewStoreKVPairWriteListener creates a StoreKVPairWriteListener with
+// the provided io.Writer and codec.BinaryMarshaler.
+func NewStoreKVPairWriteListener(w io.Writer, m codec.BinaryMarshaler) *StoreKVPairWriteListener {
+	return &StoreKVPairWriteListener{
+		writer:     w,
+		marshaller: m,
+	}
+}
+
+// OnWrite satisfies the WriteListener interface by writing length-prefixed
+// protobuf encoded StoreKVPairs.
+func (wl *StoreKVPairWriteListener) OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error {
+	kvPair := &StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      key,
+		Value:    value,
+	}
+
+	bz, err := wl.marshaller.MarshalBinaryLengthPrefixed(kvPair)
+	if err != nil {
+		return err
+	}
+
+	_, err = wl.writer.Write(bz)
+	return err
+}
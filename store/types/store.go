@@ -60,6 +60,8 @@ type StoreUpgrades struct {
 type UpgradeInfo struct {
 	Name   string `json:"name"`
 	Height int64  `json:"height"`
+	// Info is the plan info, which may hold the binaries to download
+	Info string `json:"info,omitempty"`
 }
 
 // StoreRename defines a name change of a sub-store.
@@ -135,12 +137,21 @@ type MultiStore interface {
 	// implied that the caller should update the context when necessary between
 	// tracing operations. The modified MultiStore is returned.
 	SetTracingContext(TraceContext) MultiStore
+
+	// ListeningEnabled returns if listening is enabled for the KVStore
+	// belonging to the provided StoreKey.
+	ListeningEnabled(key StoreKey) bool
 }
 
 // From MultiStore.CacheMultiStore()....
 type CacheMultiStore interface {
 	MultiStore
 	Write() // Writes operations to underlying KVStore
+
+	// FlushWriteListeners passes the writes made to the listened KVStores
+	// since the last flush to their WriteListeners, in the order they were
+	// made. Write flushes the listeners too.
+	FlushWriteListeners()
 }
 
 // CommitMultiStore is an interface for a MultiStore without cache capabilities.
@@ -183,6 +194,13 @@ type CommitMultiStore interface {
 	// StoreKeys to CommitKVStores.
 	SetInterBlockCache(MultiStorePersistentCache)
 
+	// AddListeners adds WriteListeners for the KVStore belonging to the
+	// provided StoreKey. The listeners are notified of the writes made to a
+	// branch of the CommitMultiStore, in the order they were made, when the
+	// listeners of the branch are flushed. The writes made to a branch of
+	// that branch are only included once it is written.
+	AddListeners(key StoreKey, listeners []WriteListener)
+
 	// SetInitialVersion sets the initial version of the IAVL tree. It is used when
 	// starting a new chain at an arbitrary height.
 	SetInitialVersion(version int64) error
@@ -291,6 +309,7 @@ const (
 	StoreTypeDB
 	StoreTypeIAVL
 	StoreTypeMemory
+	StoreTypeTransient
 )
 
 func (st StoreType) String() string {
@@ -306,6 +325,9 @@ func (st StoreType) String() string {
 
 	case StoreTypeMemory:
 		return "StoreTypeMemory"
+
+	case StoreTypeTransient:
+		return "StoreTypeTransient"
 	}
 
 	return "unknown store type"
@@ -368,6 +390,29 @@ func (key *MemoryStoreKey) String() string {
 	return fmt.Sprintf("MemoryStoreKey{%p, %s}", key, key.name)
 }
 
+// TransientStoreKey is used for indexing transient stores in a MultiStore
+type TransientStoreKey struct {
+	name string
+}
+
+// NewTransientStoreKey constructs new TransientStoreKey
+// Must return a pointer according to the ocap principle
+func NewTransientStoreKey(name string) *TransientStoreKey {
+	return &TransientStoreKey{
+		name: name,
+	}
+}
+
+// Name implements StoreKey
+func (key *TransientStoreKey) Name() string {
+	return key.name
+}
+
+// String implements StoreKey
+func (key *TransientStoreKey) String() string {
+	return fmt.Sprintf("TransientStoreKey{%p, %s}", key, key.name)
+}
+
 //----------------------------------------
 
 // key-value result for iterator queries
@@ -0,0 +1,145 @@
+package streaming
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cast"
+
+	"github.com/line/lfb-sdk/baseapp"
+	"github.com/line/lfb-sdk/client/flags"
+	"github.com/line/lfb-sdk/codec"
+	serverTypes "github.com/line/lfb-sdk/server/types"
+	"github.com/line/lfb-sdk/store/types"
+	"github.com/line/lfb-sdk/streaming/file"
+)
+
+// ServiceConstructor is used to construct a streaming service
+type ServiceConstructor func(opts serverTypes.AppOptions, keys []types.StoreKey, marshaller codec.BinaryMarshaler) (baseapp.StreamingService, error)
+
+// ServiceType enum for specifying the type of StreamingService
+type ServiceType int
+
+const (
+	Unknown ServiceType = iota
+	File
+	// add more in the future
+)
+
+// NewStreamingServiceType returns the streaming.ServiceType corresponding to the provided name
+func NewStreamingServiceType(name string) ServiceType {
+	switch strings.ToLower(name) {
+	case "file", "f":
+		return File
+	default:
+		return Unknown
+	}
+}
+
+// String returns the string name of a streaming.ServiceType
+func (sst ServiceType) String() string {
+	switch sst {
+	case File:
+		return "file"
+	default:
+		return "unknown"
+	}
+}
+
+// ServiceConstructorLookupTable is a mapping of streaming.ServiceTypes to streaming.ServiceConstructors
+var ServiceConstructorLookupTable = map[ServiceType]ServiceConstructor{
+	File: NewFileStreamingService,
+}
+
+// NewServiceConstructor returns the streaming.ServiceConstructor corresponding to the provided name
+func NewServiceConstructor(name string) (ServiceConstructor, error) {
+	ssType := NewStreamingServiceType(name)
+	if ssType == Unknown {
+		return nil, fmt.Errorf("unrecognized streaming service name %s", name)
+	}
+	if constructor, ok := ServiceConstructorLookupTable[ssType]; ok && constructor != nil {
+		return constructor, nil
+	}
+	return nil, fmt.Errorf("streaming service constructor of type %s not found", ssType.String())
+}
+
+// App options keys of the streaming services.
+const (
+	OptStoreStreamers        = "store.streamers"
+	OptStreamersFileWriteDir = "streamers.file.write-dir"
+	OptStreamersFilePrefix   = "streamers.file.prefix"
+)
+
+// NewFileStreamingService is the streaming.ServiceConstructor function for
+// creating a FileStreamingService. The write directory defaults to
+// data/file_streamer in the node home directory.
+func NewFileStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey, marshaller codec.BinaryMarshaler) (baseapp.StreamingService, error) {
+	filePrefix := cast.ToString(opts.Get(OptStreamersFilePrefix))
+	fileDir := cast.ToString(opts.Get(OptStreamersFileWriteDir))
+	if fileDir == "" {
+		fileDir = filepath.Join(cast.ToString(opts.Get(flags.FlagHome)), "data", "file_streamer")
+	}
+	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller)
+}
+
+// LoadStreamingServices is a function for loading StreamingServices onto the
+// BaseApp using the provided AppOptions and codec. The store keys to expose
+// are selected by name for every service, "*" selecting all of them.
+func LoadStreamingServices(
+	bApp *baseapp.BaseApp, appOpts serverTypes.AppOptions, appCodec codec.BinaryMarshaler, keys map[string]*types.KVStoreKey,
+) ([]baseapp.StreamingService, error) {
+	streamers := cast.ToStringSlice(appOpts.Get(OptStoreStreamers))
+	activeStreamers := make([]baseapp.StreamingService, 0, len(streamers))
+
+	for _, streamerName := range streamers {
+		// get the store keys allowed to be exposed for this streaming service
+		exposeKeyStrs := cast.ToStringSlice(appOpts.Get(fmt.Sprintf("streamers.%s.keys", streamerName)))
+		exposeStoreKeys, err := exposedStoreKeys(exposeKeyStrs, keys)
+		if err != nil {
+			return nil, err
+		}
+
+		constructor, err := NewServiceConstructor(streamerName)
+		if err != nil {
+			return nil, err
+		}
+
+		streamingService, err := constructor(appOpts, exposeStoreKeys, appCodec)
+		if err != nil {
+			return nil, err
+		}
+
+		// register the streaming service with the BaseApp
+		bApp.SetStreamingService(streamingService)
+		activeStreamers = append(activeStreamers, streamingService)
+	}
+
+	return activeStreamers, nil
+}
+
+// exposedStoreKeys returns the store keys named in exposeKeyStrs, or all the
+// keys if "*" is one of them.
+func exposedStoreKeys(exposeKeyStrs []string, keys map[string]*types.KVStoreKey) ([]types.StoreKey, error) {
+	for _, keyStr := range exposeKeyStrs {
+		if keyStr == "*" {
+			names := make([]string, 0, len(keys))
+			for name := range keys {
+				names = append(names, name)
+			}
+			exposeKeyStrs = names
+			break
+		}
+	}
+
+	storeKeys := make([]types.StoreKey, 0, len(exposeKeyStrs))
+	for _, keyStr := range exposeKeyStrs {
+		storeKey, ok := keys[keyStr]
+		if !ok {
+			return nil, fmt.Errorf("unknown store key %s", keyStr)
+		}
+		storeKeys = append(storeKeys, storeKey)
+	}
+
+	return storeKeys, nil
+}
@@ -0,0 +1,127 @@
+package streaming
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/line/ostracon/libs/log"
+	"github.com/line/tm-db/v2/memdb"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/baseapp"
+	"github.com/line/lfb-sdk/client/flags"
+	"github.com/line/lfb-sdk/codec"
+	codecTypes "github.com/line/lfb-sdk/codec/types"
+	"github.com/line/lfb-sdk/store/types"
+	"github.com/line/lfb-sdk/streaming/file"
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+type fakeOptions map[string]interface{}
+
+func (f fakeOptions) Get(key string) interface{} { return f[key] }
+
+var (
+	mockStoreKey1 = types.NewKVStoreKey("mockStore1")
+	mockStoreKey2 = types.NewKVStoreKey("mockStore2")
+
+	testMarshaller = codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+)
+
+func TestStreamingServiceConstructor(t *testing.T) {
+	_, err := NewServiceConstructor("unexpectedName")
+	require.NotNil(t, err)
+
+	constructor, err := NewServiceConstructor("file")
+	require.Nil(t, err)
+
+	testDir, err := ioutil.TempDir("", "streaming")
+	require.NoError(t, err)
+	defer os.RemoveAll(testDir)
+
+	opts := fakeOptions{OptStreamersFileWriteDir: testDir}
+	streamingService, err := constructor(opts, []types.StoreKey{mockStoreKey1, mockStoreKey2}, testMarshaller)
+	require.Nil(t, err)
+	require.IsType(t, &file.StreamingService{}, streamingService)
+
+	listeners := streamingService.Listeners()
+	require.Len(t, listeners, 2)
+	require.Len(t, listeners[mockStoreKey1], 1)
+	require.Len(t, listeners[mockStoreKey2], 1)
+}
+
+func TestLoadStreamingServices(t *testing.T) {
+	homeDir, err := ioutil.TempDir("", "streaming")
+	require.NoError(t, err)
+	defer os.RemoveAll(homeDir)
+
+	keys := sdk.NewKVStoreKeys("mockStore1", "mockStore2", "mockStore3")
+
+	testCases := []struct {
+		name      string
+		opts      fakeOptions
+		services  int
+		listening []string
+		expectErr bool
+	}{
+		{"no streamers", fakeOptions{}, 0, nil, false},
+		{
+			"file streamer of all stores",
+			fakeOptions{flags.FlagHome: homeDir, OptStoreStreamers: []string{"file"}, "streamers.file.keys": []string{"*"}},
+			1, []string{"mockStore1", "mockStore2", "mockStore3"}, false,
+		},
+		{
+			"file streamer of some stores",
+			fakeOptions{flags.FlagHome: homeDir, OptStoreStreamers: []string{"file"}, "streamers.file.keys": []string{"mockStore2"}},
+			1, []string{"mockStore2"}, false,
+		},
+		{
+			"unknown store key",
+			fakeOptions{flags.FlagHome: homeDir, OptStoreStreamers: []string{"file"}, "streamers.file.keys": []string{"unknown"}},
+			0, nil, true,
+		},
+		{
+			"unknown streamer",
+			fakeOptions{flags.FlagHome: homeDir, OptStoreStreamers: []string{"kafka"}},
+			0, nil, true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			bApp := baseapp.NewBaseApp("test", log.NewNopLogger(), memdb.NewDB(), nil)
+			services, err := LoadStreamingServices(bApp, tc.opts, testMarshaller, keys)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, services, tc.services)
+
+			if tc.services == 0 {
+				return
+			}
+			listeners := services[0].Listeners()
+			for name, key := range keys {
+				_, ok := listeners[key]
+				require.Equal(t, contains(tc.listening, name), ok, name)
+			}
+		})
+	}
+
+	// the file streamer writes to the data directory of the node by default
+	_, err = os.Stat(filepath.Join(homeDir, "data", "file_streamer"))
+	require.NoError(t, err)
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,173 @@
+package file
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	abci "github.com/line/ostracon/abci/types"
+
+	"github.com/line/lfb-sdk/baseapp"
+	"github.com/line/lfb-sdk/codec"
+	"github.com/line/lfb-sdk/store/types"
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+/*
+The file StreamingService writes the following files for every block to its
+write directory, named after the block height:
+
+	{prefix}block-{N}-meta:        the length-prefixed protobuf encoded
+	                               BlockMetadata holding the BeginBlock,
+	                               DeliverTx, EndBlock and Commit requests and
+	                               responses of the block
+	{prefix}block-{N}-begin-data:  the length-prefixed protobuf encoded
+	                               StoreKVPairs of the state changes of BeginBlock
+	{prefix}block-{N}-tx-{i}-data: the StoreKVPairs of the state changes of the
+	                               i-th DeliverTx of the block, from 0
+	{prefix}block-{N}-end-data:    the StoreKVPairs of the state changes of EndBlock
+
+The state changes of an ABCI message are in the order they were written. A tx
+that failed only has the state changes of its AnteHandler, if any. The state
+changes of InitChain are part of the BeginBlock of the first block.
+
+The files of a block are written when it is committed.
+*/
+
+var _ baseapp.StreamingService = (*StreamingService)(nil)
+
+// StreamingService is a concrete implementation of baseapp.StreamingService
+// that writes the state changes and the ABCI messages of every block to files.
+type StreamingService struct {
+	listeners  map[types.StoreKey][]types.WriteListener // the listeners that will be initialized with BaseApp
+	filePrefix string                                   // optional prefix for each of the generated files
+	writeDir   string                                   // directory to write files into
+	codec      codec.BinaryMarshaler                    // marshaller used for re-marshalling the ABCI messages to write them out to the destination files
+
+	changes bytes.Buffer        // the state changes of the current ABCI message
+	data    []blockData         // the state changes of the ABCI messages of the current block
+	meta    types.BlockMetadata // the ABCI messages of the current block
+}
+
+// blockData is the content of a data file of a block.
+type blockData struct {
+	suffix  string
+	changes []byte
+}
+
+// NewStreamingService creates a new StreamingService for the provided
+// writeDir, (optional) filePrefix, and storeKeys.
+func NewStreamingService(writeDir, filePrefix string, storeKeys []types.StoreKey, c codec.BinaryMarshaler) (*StreamingService, error) {
+	if err := os.MkdirAll(writeDir, 0755); err != nil {
+		return nil, err
+	}
+	// sanity check that the directory is writable
+	if err := isDirWriteable(writeDir); err != nil {
+		return nil, err
+	}
+
+	fss := &StreamingService{
+		listeners:  make(map[types.StoreKey][]types.WriteListener, len(storeKeys)),
+		filePrefix: filePrefix,
+		writeDir:   writeDir,
+		codec:      c,
+	}
+
+	// all the keys share the same listener, so the state changes of all the
+	// stores end up in a single file
+	listener := types.NewStoreKVPairWriteListener(&fss.changes, c)
+	for _, key := range storeKeys {
+		fss.listeners[key] = []types.WriteListener{listener}
+	}
+
+	return fss, nil
+}
+
+// Listeners satisfies the baseapp.StreamingService interface.
+func (fss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return fss.listeners
+}
+
+// ListenBeginBlock satisfies the baseapp.ABCIListener interface. It starts
+// the metadata of a new block.
+func (fss *StreamingService) ListenBeginBlock(_ sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	fss.meta = types.BlockMetadata{
+		RequestBeginBlock:  &req,
+		ResponseBeginBlock: &res,
+	}
+	fss.addData("begin-data")
+	return nil
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface.
+func (fss *StreamingService) ListenDeliverTx(_ sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	fss.meta.DeliverTxs = append(fss.meta.DeliverTxs, &types.BlockMetadata_DeliverTx{
+		Request:  &req,
+		Response: &res,
+	})
+	fss.addData(fmt.Sprintf("tx-%d-data", len(fss.meta.DeliverTxs)-1))
+	return nil
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface.
+func (fss *StreamingService) ListenEndBlock(_ sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	fss.meta.RequestEndBlock = &req
+	fss.meta.ResponseEndBlock = &res
+	fss.addData("end-data")
+	return nil
+}
+
+// ListenCommit satisfies the baseapp.ABCIListener interface. It writes out
+// the metadata and the state changes of the committed block.
+func (fss *StreamingService) ListenCommit(ctx sdk.Context, res abci.ResponseCommit) error {
+	fss.meta.ResponseCommit = &res
+	defer func() {
+		fss.meta = types.BlockMetadata{}
+		fss.data = nil
+		fss.changes.Reset()
+	}()
+
+	bz, err := fss.codec.MarshalBinaryLengthPrefixed(&fss.meta)
+	if err != nil {
+		return err
+	}
+
+	height := ctx.BlockHeight()
+	if err := ioutil.WriteFile(fss.fileName(height, "meta"), bz, 0600); err != nil {
+		return err
+	}
+
+	for _, d := range fss.data {
+		if err := ioutil.WriteFile(fss.fileName(height, d.suffix), d.changes, 0600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addData moves the state changes passed to the listeners since the last ABCI
+// message to a data file of the current block.
+func (fss *StreamingService) addData(suffix string) {
+	fss.data = append(fss.data, blockData{
+		suffix:  suffix,
+		changes: append([]byte(nil), fss.changes.Bytes()...),
+	})
+	fss.changes.Reset()
+}
+
+// fileName returns the path of a file of the block at the given height.
+func (fss *StreamingService) fileName(height int64, suffix string) string {
+	return filepath.Join(fss.writeDir, fmt.Sprintf("%sblock-%d-%s", fss.filePrefix, height, suffix))
+}
+
+// isDirWriteable checks if dir is writable by writing and removing a file
+// to dir. It returns nil if dir is writable.
+func isDirWriteable(dir string) error {
+	f := filepath.Join(dir, ".touch")
+	if err := ioutil.WriteFile(f, []byte(""), 0600); err != nil {
+		return err
+	}
+	return os.Remove(f)
+}
@@ -0,0 +1,132 @@
+package file
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	abci "github.com/line/ostracon/abci/types"
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/codec"
+	codecTypes "github.com/line/lfb-sdk/codec/types"
+	"github.com/line/lfb-sdk/store/types"
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+var (
+	interfaceRegistry = codecTypes.NewInterfaceRegistry()
+	testMarshaller    = codec.NewProtoCodec(interfaceRegistry)
+
+	mockStoreKey1 = types.NewKVStoreKey("mockStore1")
+	mockStoreKey2 = types.NewKVStoreKey("mockStore2")
+)
+
+func TestFileStreamingService(t *testing.T) {
+	testDir, err := ioutil.TempDir("", "file_streamer")
+	require.NoError(t, err)
+	defer os.RemoveAll(testDir)
+
+	writeDir := filepath.Join(testDir, "streams")
+	fss, err := NewStreamingService(writeDir, "test-", []types.StoreKey{mockStoreKey1, mockStoreKey2}, testMarshaller)
+	require.NoError(t, err)
+	require.Len(t, fss.Listeners(), 2)
+
+	ctx := sdk.Context{}.WithBlockHeight(1)
+	beginReq := abci.RequestBeginBlock{Header: ostproto.Header{Height: 1}}
+	beginRes := abci.ResponseBeginBlock{Events: []abci.Event{{Type: "begin"}}}
+	txReq := abci.RequestDeliverTx{Tx: []byte("tx")}
+	txRes := abci.ResponseDeliverTx{Code: 1, Log: "failed"}
+	endReq := abci.RequestEndBlock{Height: 1}
+	endRes := abci.ResponseEndBlock{Events: []abci.Event{{Type: "end"}}}
+	commitRes := abci.ResponseCommit{Data: []byte("apphash")}
+
+	pairs := []types.StoreKVPair{
+		{StoreKey: mockStoreKey2.Name(), Key: []byte("key1"), Value: []byte("value1")},
+		{StoreKey: mockStoreKey1.Name(), Key: []byte("key3"), Value: []byte("value3")},
+		{StoreKey: mockStoreKey1.Name(), Key: []byte("key2"), Delete: true},
+		{StoreKey: mockStoreKey1.Name(), Key: []byte("key4"), Value: []byte("value4")},
+	}
+	write := func(p types.StoreKVPair) {
+		key := mockStoreKey1
+		if p.StoreKey == mockStoreKey2.Name() {
+			key = mockStoreKey2
+		}
+		listener := fss.Listeners()[key][0]
+		require.NoError(t, listener.OnWrite(key, p.Key, p.Value, p.Delete))
+	}
+
+	// the state changes of an ABCI message are passed before its hook
+	write(pairs[0])
+	require.NoError(t, fss.ListenBeginBlock(ctx, beginReq, beginRes))
+	write(pairs[1])
+	write(pairs[2])
+	require.NoError(t, fss.ListenDeliverTx(ctx, txReq, txRes))
+	require.NoError(t, fss.ListenDeliverTx(ctx, txReq, txRes))
+	write(pairs[3])
+	require.NoError(t, fss.ListenEndBlock(ctx, endReq, endRes))
+
+	require.NoError(t, fss.ListenCommit(ctx, commitRes))
+
+	// the metadata holds the ABCI messages of the block
+	bz, err := ioutil.ReadFile(filepath.Join(writeDir, "test-block-1-meta"))
+	require.NoError(t, err)
+	var meta types.BlockMetadata
+	require.NoError(t, testMarshaller.UnmarshalBinaryLengthPrefixed(bz, &meta))
+	require.Equal(t, types.BlockMetadata{
+		RequestBeginBlock:  &beginReq,
+		ResponseBeginBlock: &beginRes,
+		DeliverTxs:         []*types.BlockMetadata_DeliverTx{{Request: &txReq, Response: &txRes}, {Request: &txReq, Response: &txRes}},
+		RequestEndBlock:    &endReq,
+		ResponseEndBlock:   &endRes,
+		ResponseCommit:     &commitRes,
+	}, meta)
+
+	// each data file holds the state changes of an ABCI message in order
+	for suffix, expected := range map[string][]types.StoreKVPair{
+		"begin-data": pairs[:1],
+		"tx-0-data":  pairs[1:3],
+		"tx-1-data":  nil,
+		"end-data":   pairs[3:],
+	} {
+		bz, err = ioutil.ReadFile(filepath.Join(writeDir, "test-block-1-"+suffix))
+		require.NoError(t, err)
+		require.Equal(t, expected, readStoreKVPairs(t, bz), suffix)
+	}
+
+	// the next block starts from scratch
+	ctx = ctx.WithBlockHeight(2)
+	require.NoError(t, fss.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: ostproto.Header{Height: 2}}, abci.ResponseBeginBlock{}))
+	require.NoError(t, fss.ListenEndBlock(ctx, abci.RequestEndBlock{Height: 2}, abci.ResponseEndBlock{}))
+	require.NoError(t, fss.ListenCommit(ctx, abci.ResponseCommit{}))
+
+	bz, err = ioutil.ReadFile(filepath.Join(writeDir, "test-block-2-meta"))
+	require.NoError(t, err)
+	meta = types.BlockMetadata{}
+	require.NoError(t, testMarshaller.UnmarshalBinaryLengthPrefixed(bz, &meta))
+	require.Empty(t, meta.DeliverTxs)
+
+	bz, err = ioutil.ReadFile(filepath.Join(writeDir, "test-block-2-begin-data"))
+	require.NoError(t, err)
+	require.Empty(t, bz)
+	_, err = os.Stat(filepath.Join(writeDir, "test-block-2-tx-0-data"))
+	require.True(t, os.IsNotExist(err))
+}
+
+// readStoreKVPairs decodes a sequence of length-prefixed StoreKVPairs.
+func readStoreKVPairs(t *testing.T, bz []byte) []types.StoreKVPair {
+	var pairs []types.StoreKVPair
+	for len(bz) > 0 {
+		size, n := binary.Uvarint(bz)
+		require.True(t, n > 0)
+
+		var pair types.StoreKVPair
+		require.NoError(t, testMarshaller.UnmarshalBinaryBare(bz[n:n+int(size)], &pair))
+		pairs = append(pairs, pair)
+		bz = bz[n+int(size):]
+	}
+	return pairs
+}
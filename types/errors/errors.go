@@ -139,6 +139,16 @@ var (
 	// less than (current block height - ValidSigBlockPeriod)
 	ErrInvalidSigBlockHeight = Register(RootCodespace, 38, "invalid sig block height")
 
+	// ErrNotFound defines an error when requested entity doesn't exist in the state.
+	ErrNotFound = Register(RootCodespace, 39, "not found")
+
+	// ErrMsgGasLimitExceeded is returned when the txs including a Msg type would
+	// use more gas in a block than the limit of the Msg type.
+	ErrMsgGasLimitExceeded = Register(RootCodespace, 40, "msg gas limit per block exceeded")
+
+	// ErrTooManyTxs is returned when an account signs more txs in a block than allowed.
+	ErrTooManyTxs = Register(RootCodespace, 41, "maximum number of txs per signer exceeded")
+
 	// ErrPanic is only set when we recover from a panic, so we know to
 	// redact potentially sensitive system info
 	ErrPanic = Register(UndefinedCodespace, 111222, "panic")
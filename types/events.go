@@ -224,11 +224,17 @@ func toBytes(i interface{}) []byte {
 // Common event types and attribute keys
 var (
 	EventTypeMessage = "message"
+	EventTypeTx      = "tx"
 
 	AttributeKeyAction = "action"
 	AttributeKeyModule = "module"
 	AttributeKeySender = "sender"
 	AttributeKeyAmount = "amount"
+
+	// AttributeKeyPriority is the attribute of the EventTypeTx event of
+	// ResponseCheckTx carrying the advisory priority of the tx. The mempool
+	// does not order txs by it.
+	AttributeKeyPriority = "priority"
 )
 
 type (
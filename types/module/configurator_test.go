@@ -0,0 +1,23 @@
+package module_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/codec"
+	"github.com/line/lfb-sdk/codec/types"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/types/module"
+)
+
+func TestConfigurator_RegisterMigration(t *testing.T) {
+	cfg := module.NewConfigurator(codec.NewProtoCodec(types.NewInterfaceRegistry()), nil, nil)
+	noop := func(sdk.Context) error { return nil }
+
+	require.Error(t, cfg.RegisterMigration("module1", 0, noop))
+	require.NoError(t, cfg.RegisterMigration("module1", 1, noop))
+	require.Error(t, cfg.RegisterMigration("module1", 1, noop))
+	require.NoError(t, cfg.RegisterMigration("module1", 2, noop))
+	require.NoError(t, cfg.RegisterMigration("module2", 1, noop))
+}
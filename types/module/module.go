@@ -30,6 +30,8 @@ package module
 
 import (
 	"encoding/json"
+	"fmt"
+	"sort"
 
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -40,6 +42,7 @@ import (
 	"github.com/line/lfb-sdk/codec"
 	codectypes "github.com/line/lfb-sdk/codec/types"
 	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
 )
 
 //__________________________________________________________________________________________
@@ -174,6 +177,12 @@ type AppModule interface {
 	// RegisterServices allows a module to register services
 	RegisterServices(Configurator)
 
+	// ConsensusVersion is a sequence number for state-breaking change of the
+	// module. It should be incremented on each consensus-breaking change
+	// introduced by the module. To avoid wrong/empty versions, the initial version
+	// should be set to 1.
+	ConsensusVersion() uint64
+
 	// ABCI
 	BeginBlock(sdk.Context, abci.RequestBeginBlock)
 	EndBlock(sdk.Context, abci.RequestEndBlock) []abci.ValidatorUpdate
@@ -208,6 +217,9 @@ func (gam GenesisOnlyAppModule) LegacyQuerierHandler(*codec.LegacyAmino) sdk.Que
 // RegisterServices registers all services.
 func (gam GenesisOnlyAppModule) RegisterServices(Configurator) {}
 
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (gam GenesisOnlyAppModule) ConsensusVersion() uint64 { return 1 }
+
 // BeginBlock returns an empty module begin-block
 func (gam GenesisOnlyAppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {}
 
@@ -369,3 +381,106 @@ func (m *Manager) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) abci.Respo
 		Events:           ctx.EventManager().ABCIEvents(),
 	}
 }
+
+// MigrationHandler is the migration function that each module registers.
+type MigrationHandler func(sdk.Context) error
+
+// VersionMap is a map of moduleName -> version, where version denotes the
+// version from which we should perform the migration for each module.
+type VersionMap map[string]uint64
+
+// RunMigrations performs in-place store migrations for all modules. It is
+// meant to be called from an x/upgrade UpgradeHandler, which receives the
+// version map stored by x/upgrade and returns the version map to store after
+// the upgrade:
+//
+//	cfg := module.NewConfigurator(...)
+//	app.UpgradeKeeper.SetUpgradeHandler("my-plan", func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+//		return app.mm.RunMigrations(ctx, cfg, fromVM)
+//	})
+//
+// For each module, in the order of OrderInitGenesis:
+// - if the module is in fromVM, the migrations registered in the configurator
+//   are run from its version in fromVM up to its ConsensusVersion.
+// - if the module is not in fromVM, it is a new module and InitGenesis is run
+//   with its default genesis state. To skip InitGenesis for a new module, set
+//   its version in fromVM to its ConsensusVersion before calling RunMigrations.
+//
+// The returned map holds the ConsensusVersion of every module.
+//
+// fromVM must not be empty. A chain started before module versions were stored
+// has no version map in x/upgrade, and treating all of its modules as new would
+// overwrite their state with the default genesis. The upgrade handler of such a
+// chain must supply the versions the modules had before the upgrade, which is 1
+// for every module that existed then:
+//
+//	if len(fromVM) == 0 {
+//		fromVM = module.VersionMap{authtypes.ModuleName: 1, banktypes.ModuleName: 1, ...}
+//	}
+func (m *Manager) RunMigrations(ctx sdk.Context, cfg Configurator, fromVM VersionMap) (VersionMap, error) {
+	c, ok := cfg.(configurator)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", configurator{}, cfg)
+	}
+	if len(fromVM) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			"empty module version map: the upgrade handler must supply the module versions before the upgrade")
+	}
+
+	updatedVM := make(VersionMap)
+	for _, moduleName := range m.migrationOrder() {
+		module := m.Modules[moduleName]
+		toVersion := module.ConsensusVersion()
+
+		if fromVersion, exists := fromVM[moduleName]; exists {
+			if err := c.runModuleMigrations(ctx, moduleName, fromVersion, toVersion); err != nil {
+				return nil, err
+			}
+		} else {
+			ctx.Logger().Info(fmt.Sprintf("adding a new module: %s", moduleName))
+			moduleValUpdates := module.InitGenesis(ctx, c.cdc, module.DefaultGenesis(c.cdc))
+			// the module manager assumes that new modules don't update the
+			// validator set
+			if len(moduleValUpdates) > 0 {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "validator InitGenesis update is not supported in a migration: %s", moduleName)
+			}
+		}
+
+		updatedVM[moduleName] = toVersion
+	}
+
+	return updatedVM, nil
+}
+
+// GetVersionMap gets consensus version from all modules
+func (m *Manager) GetVersionMap() VersionMap {
+	vermap := make(VersionMap)
+	for name, v := range m.Modules {
+		vermap[name] = v.ConsensusVersion()
+	}
+
+	return vermap
+}
+
+// migrationOrder returns the module names in the order of OrderInitGenesis,
+// followed by the modules missing from it sorted by name.
+func (m *Manager) migrationOrder() []string {
+	order := make([]string, 0, len(m.Modules))
+	seen := make(map[string]bool, len(m.Modules))
+	for _, name := range m.OrderInitGenesis {
+		if _, ok := m.Modules[name]; ok && !seen[name] {
+			order = append(order, name)
+			seen[name] = true
+		}
+	}
+
+	var rest []string
+	for name := range m.Modules {
+		if !seen[name] {
+			rest = append(rest, name)
+		}
+	}
+	sort.Strings(rest)
+
+	return append(order, rest...)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/gorilla/mux"
 	abci "github.com/line/ostracon/abci/types"
+	"github.com/line/ostracon/libs/log"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 
@@ -179,7 +180,8 @@ func TestManager_RegisterQueryServices(t *testing.T) {
 
 	msgRouter := mocks.NewMockServer(mockCtrl)
 	queryRouter := mocks.NewMockServer(mockCtrl)
-	cfg := module.NewConfigurator(msgRouter, queryRouter)
+	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+	cfg := module.NewConfigurator(cdc, msgRouter, queryRouter)
 	mockAppModule1.EXPECT().RegisterServices(cfg).Times(1)
 	mockAppModule2.EXPECT().RegisterServices(cfg).Times(1)
 
@@ -282,3 +284,74 @@ func TestManager_EndBlock(t *testing.T) {
 	mockAppModule2.EXPECT().EndBlock(gomock.Any(), gomock.Eq(req)).Times(1).Return([]abci.ValidatorUpdate{{}})
 	require.Panics(t, func() { mm.EndBlock(sdk.Context{}, req) })
 }
+
+func TestManager_RunMigrations(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	mm := module.NewManager(mockAppModule1, mockAppModule2)
+	require.NotNil(t, mm)
+
+	ctx := sdk.Context{}.WithLogger(log.NewNopLogger())
+	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+	cfg := module.NewConfigurator(cdc, nil, nil)
+
+	var migrated []uint64
+	for _, v := range []uint64{1, 2} {
+		v := v
+		require.NoError(t, cfg.RegisterMigration("module1", v, func(sdk.Context) error {
+			migrated = append(migrated, v)
+			return nil
+		}))
+	}
+
+	// module1 is migrated from 1 to 3 and module2 is a new module
+	mockAppModule1.EXPECT().ConsensusVersion().Times(1).Return(uint64(3))
+	mockAppModule2.EXPECT().ConsensusVersion().Times(1).Return(uint64(1))
+	mockAppModule2.EXPECT().DefaultGenesis(gomock.Eq(cdc)).Times(1).Return(json.RawMessage(`{"key": "value"}`))
+	mockAppModule2.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Eq(json.RawMessage(`{"key": "value"}`))).Times(1).Return(nil)
+
+	vm, err := mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 1})
+	require.NoError(t, err)
+	require.Equal(t, module.VersionMap{"module1": 3, "module2": 1}, vm)
+	require.Equal(t, []uint64{1, 2}, migrated)
+
+	// a missing migration is an error
+	mockAppModule1.EXPECT().ConsensusVersion().Times(1).Return(uint64(4))
+	_, err = mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 3, "module2": 1})
+	require.Error(t, err)
+
+	// new modules may not update the validator set
+	mockAppModule1.EXPECT().ConsensusVersion().Times(1).Return(uint64(3))
+	mockAppModule2.EXPECT().ConsensusVersion().Times(1).Return(uint64(1))
+	mockAppModule2.EXPECT().DefaultGenesis(gomock.Eq(cdc)).Times(1).Return(json.RawMessage(`{}`))
+	mockAppModule2.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Eq(json.RawMessage(`{}`))).Times(1).Return([]abci.ValidatorUpdate{{}})
+	_, err = mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 3})
+	require.Error(t, err)
+
+	// an empty version map of a chain started before module versions were stored
+	// is an error instead of running InitGenesis for every module
+	_, err = mm.RunMigrations(ctx, cfg, module.VersionMap{})
+	require.Error(t, err)
+	_, err = mm.RunMigrations(ctx, cfg, nil)
+	require.Error(t, err)
+}
+
+func TestManager_GetVersionMap(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	mm := module.NewManager(mockAppModule1, mockAppModule2)
+
+	mockAppModule1.EXPECT().ConsensusVersion().Times(1).Return(uint64(1))
+	mockAppModule2.EXPECT().ConsensusVersion().Times(1).Return(uint64(2))
+	require.Equal(t, module.VersionMap{"module1": 1, "module2": 2}, mm.GetVersionMap())
+}
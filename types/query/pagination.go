@@ -37,6 +37,42 @@ func ParsePagination(pageReq *PageRequest) (page, limit int, err error) {
 	return page, limit, nil
 }
 
+// ParseSlicePagination validates PageRequest for a slice of the given length
+// and returns the bounds of the requested page, as in slice[start:end], along
+// with the PageResponse. Slices have no keys, so a request with a key is
+// rejected. As in Paginate, the total is counted if CountTotal is set or the
+// limit is not supplied.
+func ParseSlicePagination(pageReq *PageRequest, length int) (start, end int, pageRes *PageResponse, err error) {
+	if pageReq == nil {
+		pageReq = &PageRequest{}
+	}
+	if len(pageReq.Key) != 0 {
+		return 0, 0, nil, status.Error(codes.InvalidArgument, "key is not supported, use offset")
+	}
+
+	limit := pageReq.Limit
+	countTotal := pageReq.CountTotal
+	if limit == 0 {
+		limit = DefaultLimit
+		countTotal = true
+	}
+
+	start, end = length, length
+	if pageReq.Offset < uint64(length) {
+		start = int(pageReq.Offset)
+	}
+	if limit < uint64(length-start) {
+		end = start + int(limit)
+	}
+
+	pageRes = &PageResponse{}
+	if countTotal {
+		pageRes.Total = uint64(length)
+	}
+
+	return start, end, pageRes, nil
+}
+
 // Paginate does pagination of all the results in the PrefixStore based on the
 // provided PageRequest. onResult should be used to do actual unmarshaling.
 func Paginate(
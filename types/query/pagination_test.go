@@ -62,6 +62,35 @@ func (s *paginationTestSuite) TestParsePagination() {
 	s.Require().Equal(limit, 10)
 }
 
+func (s *paginationTestSuite) TestParseSlicePagination() {
+	cases := []struct {
+		pageReq  *query.PageRequest
+		length   int
+		expStart int
+		expEnd   int
+		expTotal uint64
+	}{
+		{nil, 235, 0, query.DefaultLimit, 235},
+		{&query.PageRequest{Limit: 10}, 5, 0, 5, 0},
+		{&query.PageRequest{Offset: 10, Limit: 10}, 25, 10, 20, 0},
+		{&query.PageRequest{Offset: 5, Limit: 10}, 25, 5, 15, 0},
+		{&query.PageRequest{Offset: 20, Limit: 10, CountTotal: true}, 25, 20, 25, 25},
+		{&query.PageRequest{Offset: 30, Limit: 10}, 25, 25, 25, 0},
+		{&query.PageRequest{Offset: 3}, 25, 3, 25, 25},
+	}
+	for _, tc := range cases {
+		start, end, pageRes, err := query.ParseSlicePagination(tc.pageReq, tc.length)
+		s.Require().NoError(err)
+		s.Require().Equal(tc.expStart, start)
+		s.Require().Equal(tc.expEnd, end)
+		s.Require().Equal(tc.expTotal, pageRes.Total)
+		s.Require().Nil(pageRes.NextKey)
+	}
+
+	_, _, _, err := query.ParseSlicePagination(&query.PageRequest{Key: []byte("key")}, 25)
+	s.Require().Error(err)
+}
+
 func (s *paginationTestSuite) TestPagination() {
 	app, ctx, _ := setupTest()
 	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
@@ -11,6 +11,8 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/line/lfb-sdk/types"
 	query "github.com/line/lfb-sdk/types/query"
+	types2 "github.com/line/ostracon/abci/types"
+	types1 "github.com/line/ostracon/proto/ostracon/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -498,6 +500,161 @@ func (m *GetTxResponse) GetTxResponse() *types.TxResponse {
 	return nil
 }
 
+// GetBlockWithTxsRequest is the request type for the Service.GetBlockWithTxs
+// RPC method.
+type GetBlockWithTxsRequest struct {
+	// height is the height of the block to query.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// pagination defines a pagination for the txs of the block.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *GetBlockWithTxsRequest) Reset()         { *m = GetBlockWithTxsRequest{} }
+func (m *GetBlockWithTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockWithTxsRequest) ProtoMessage()    {}
+func (*GetBlockWithTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af5f209e95bb539d, []int{8}
+}
+func (m *GetBlockWithTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBlockWithTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBlockWithTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBlockWithTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockWithTxsRequest.Merge(m, src)
+}
+func (m *GetBlockWithTxsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBlockWithTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockWithTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockWithTxsRequest proto.InternalMessageInfo
+
+func (m *GetBlockWithTxsRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetBlockWithTxsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// GetBlockWithTxsResponse is the response type for the Service.GetBlockWithTxs
+// RPC method.
+type GetBlockWithTxsResponse struct {
+	// txs is the list of decoded transactions of the requested page.
+	Txs []*Tx `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+	// tx_responses is the list of TxResponses of the requested page.
+	TxResponses []*types.TxResponse `protobuf:"bytes,2,rep,name=tx_responses,json=txResponses,proto3" json:"tx_responses,omitempty"`
+	BlockId     *types1.BlockID     `protobuf:"bytes,3,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	Block       *types1.Block       `protobuf:"bytes,4,opt,name=block,proto3" json:"block,omitempty"`
+	// begin_block_events is the list of events emitted in BeginBlock.
+	BeginBlockEvents []types2.Event `protobuf:"bytes,5,rep,name=begin_block_events,json=beginBlockEvents,proto3" json:"begin_block_events"`
+	// end_block_events is the list of events emitted in EndBlock.
+	EndBlockEvents []types2.Event `protobuf:"bytes,6,rep,name=end_block_events,json=endBlockEvents,proto3" json:"end_block_events"`
+	// pagination defines a pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,7,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *GetBlockWithTxsResponse) Reset()         { *m = GetBlockWithTxsResponse{} }
+func (m *GetBlockWithTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBlockWithTxsResponse) ProtoMessage()    {}
+func (*GetBlockWithTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af5f209e95bb539d, []int{9}
+}
+func (m *GetBlockWithTxsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBlockWithTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBlockWithTxsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBlockWithTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockWithTxsResponse.Merge(m, src)
+}
+func (m *GetBlockWithTxsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBlockWithTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockWithTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockWithTxsResponse proto.InternalMessageInfo
+
+func (m *GetBlockWithTxsResponse) GetTxs() []*Tx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *GetBlockWithTxsResponse) GetTxResponses() []*types.TxResponse {
+	if m != nil {
+		return m.TxResponses
+	}
+	return nil
+}
+
+func (m *GetBlockWithTxsResponse) GetBlockId() *types1.BlockID {
+	if m != nil {
+		return m.BlockId
+	}
+	return nil
+}
+
+func (m *GetBlockWithTxsResponse) GetBlock() *types1.Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *GetBlockWithTxsResponse) GetBeginBlockEvents() []types2.Event {
+	if m != nil {
+		return m.BeginBlockEvents
+	}
+	return nil
+}
+
+func (m *GetBlockWithTxsResponse) GetEndBlockEvents() []types2.Event {
+	if m != nil {
+		return m.EndBlockEvents
+	}
+	return nil
+}
+
+func (m *GetBlockWithTxsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("lfb.tx.v1beta1.BroadcastMode", BroadcastMode_name, BroadcastMode_value)
 	proto.RegisterType((*GetTxsEventRequest)(nil), "lfb.tx.v1beta1.GetTxsEventRequest")
@@ -508,58 +665,73 @@ func init() {
 	proto.RegisterType((*SimulateResponse)(nil), "lfb.tx.v1beta1.SimulateResponse")
 	proto.RegisterType((*GetTxRequest)(nil), "lfb.tx.v1beta1.GetTxRequest")
 	proto.RegisterType((*GetTxResponse)(nil), "lfb.tx.v1beta1.GetTxResponse")
+	proto.RegisterType((*GetBlockWithTxsRequest)(nil), "lfb.tx.v1beta1.GetBlockWithTxsRequest")
+	proto.RegisterType((*GetBlockWithTxsResponse)(nil), "lfb.tx.v1beta1.GetBlockWithTxsResponse")
 }
 
 func init() { proto.RegisterFile("lfb/tx/v1beta1/service.proto", fileDescriptor_af5f209e95bb539d) }
 
 var fileDescriptor_af5f209e95bb539d = []byte{
-	// 734 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x6f, 0xda, 0x4a,
-	0x14, 0xc5, 0x90, 0x97, 0x8f, 0x4b, 0x92, 0x87, 0x86, 0xbc, 0x17, 0x1e, 0x0f, 0x5c, 0xea, 0x44,
-	0x6a, 0x14, 0xa9, 0xb6, 0x42, 0x95, 0x45, 0xb3, 0x0b, 0x1f, 0x8d, 0xa2, 0x36, 0x1f, 0x32, 0xe9,
-	0x22, 0xdd, 0x20, 0x1b, 0x06, 0x63, 0x95, 0x78, 0x08, 0x33, 0x50, 0x47, 0x6d, 0x37, 0x55, 0x7f,
-	0x40, 0xa5, 0xfe, 0xa8, 0x76, 0x19, 0xa9, 0x5d, 0x74, 0x59, 0x25, 0xfd, 0x21, 0xd5, 0x0c, 0x03,
-	0x18, 0x03, 0x51, 0xd4, 0xdd, 0x8c, 0xef, 0xb9, 0xf7, 0xdc, 0x73, 0xee, 0xf8, 0x42, 0xa6, 0xd5,
-	0xb0, 0x0d, 0xe6, 0x1b, 0xbd, 0x1d, 0x1b, 0x33, 0x6b, 0xc7, 0xa0, 0xb8, 0xd3, 0x73, 0x6b, 0x58,
-	0x6f, 0x77, 0x08, 0x23, 0x68, 0xb5, 0xd5, 0xb0, 0x75, 0xe6, 0xeb, 0x32, 0x9a, 0xce, 0x38, 0x84,
-	0x38, 0x2d, 0x6c, 0x58, 0x6d, 0xd7, 0xb0, 0x3c, 0x8f, 0x30, 0x8b, 0xb9, 0xc4, 0xa3, 0x7d, 0x74,
-	0x3a, 0xc7, 0x6b, 0xd9, 0x16, 0xc5, 0x86, 0x65, 0xd7, 0xdc, 0x61, 0x49, 0x7e, 0x91, 0x88, 0xf5,
-	0x10, 0x1b, 0xf3, 0x65, 0x60, 0xcd, 0x21, 0x0e, 0x11, 0x47, 0x83, 0x9f, 0xe4, 0xd7, 0x47, 0xc3,
-	0x82, 0x97, 0x5d, 0xdc, 0xb9, 0x1a, 0xa6, 0xb5, 0x2d, 0xc7, 0xf5, 0x04, 0x75, 0x1f, 0xa8, 0x5d,
-	0x02, 0x3a, 0xc0, 0xec, 0xcc, 0xa7, 0xe5, 0x1e, 0xf6, 0x98, 0x89, 0x2f, 0xbb, 0x98, 0x32, 0xf4,
-	0x2f, 0xcc, 0x63, 0x7e, 0xa7, 0x29, 0x25, 0x17, 0xdb, 0x5a, 0x32, 0xe5, 0x0d, 0x15, 0x01, 0x46,
-	0x15, 0x52, 0xd1, 0x9c, 0xb2, 0x15, 0xcf, 0x6f, 0xe8, 0x5c, 0x2a, 0xe7, 0xd2, 0x05, 0xd7, 0x40,
-	0xb2, 0x7e, 0x6a, 0x39, 0x58, 0x16, 0x34, 0x03, 0x69, 0xda, 0x17, 0x05, 0x92, 0x63, 0x9c, 0xb4,
-	0x4d, 0x3c, 0x8a, 0xd1, 0x26, 0xc4, 0x98, 0xdf, 0x67, 0x8c, 0xe7, 0x91, 0x3e, 0x6e, 0xa0, 0x7e,
-	0xe6, 0x9b, 0x3c, 0x8c, 0x4a, 0xb0, 0xcc, 0xfc, 0x6a, 0x47, 0x26, 0xd1, 0x54, 0x54, 0xc0, 0x1f,
-	0x8e, 0x9a, 0x10, 0xa6, 0x05, 0xb2, 0x24, 0xd2, 0x8c, 0xb3, 0xe1, 0x99, 0x57, 0x09, 0x0a, 0x89,
-	0x09, 0x21, 0x9b, 0x77, 0x0b, 0x91, 0x65, 0x82, 0x4a, 0x6c, 0x40, 0x85, 0x0e, 0xb1, 0xea, 0x35,
-	0x8b, 0x32, 0xce, 0xd4, 0x37, 0xef, 0x3f, 0x58, 0x64, 0x7e, 0xd5, 0xbe, 0x62, 0x98, 0x8b, 0x51,
-	0xb6, 0x96, 0xcd, 0x05, 0xe6, 0x17, 0xf8, 0x15, 0xed, 0xc0, 0xdc, 0x05, 0xa9, 0x63, 0xe1, 0xdc,
-	0x6a, 0x3e, 0x1b, 0xd6, 0x38, 0x2c, 0x76, 0x44, 0xea, 0xd8, 0x14, 0x50, 0xed, 0x1c, 0x92, 0x63,
-	0x1c, 0xd2, 0xac, 0x02, 0xc4, 0x03, 0x36, 0x08, 0x9e, 0x7b, 0xb9, 0x00, 0x23, 0x17, 0xb4, 0x5d,
-	0xf8, 0xbb, 0xe2, 0x5e, 0x74, 0x5b, 0x16, 0x1b, 0xcc, 0x09, 0x69, 0x10, 0x65, 0xbe, 0xac, 0x36,
-	0x6d, 0x04, 0x51, 0xe6, 0x6b, 0x1f, 0x15, 0x48, 0x8c, 0xf2, 0x64, 0x3f, 0x4f, 0x61, 0xd1, 0xb1,
-	0x68, 0xd5, 0xf5, 0x1a, 0x44, 0xa6, 0xab, 0x33, 0x9a, 0x39, 0xb0, 0xe8, 0xa1, 0xd7, 0x20, 0xe6,
-	0x82, 0xd3, 0x3f, 0xa0, 0x5d, 0x98, 0xef, 0x60, 0xda, 0x6d, 0x31, 0xf9, 0xa0, 0xb2, 0x33, 0x12,
-	0x4d, 0x01, 0x32, 0x25, 0x58, 0xd3, 0x60, 0x59, 0xbc, 0xa2, 0x41, 0xeb, 0x08, 0xe6, 0x9a, 0x16,
-	0x6d, 0x0a, 0xf6, 0x25, 0x53, 0x9c, 0xb5, 0x37, 0xb0, 0x22, 0x31, 0xb2, 0xcd, 0x7b, 0xe8, 0x0b,
-	0x5b, 0x1b, 0xfd, 0x03, 0x6b, 0xb7, 0xdf, 0xc1, 0xca, 0xd8, 0x30, 0x91, 0x0a, 0xe9, 0x82, 0x79,
-	0xb2, 0x5f, 0x2a, 0xee, 0x57, 0xce, 0xaa, 0x47, 0x27, 0xa5, 0x72, 0xf5, 0xe5, 0x71, 0xe5, 0xb4,
-	0x5c, 0x3c, 0x7c, 0x76, 0x58, 0x2e, 0x25, 0x22, 0x28, 0x05, 0x6b, 0xa1, 0x78, 0xe1, 0xc5, 0x49,
-	0xf1, 0x79, 0x42, 0x41, 0xeb, 0x90, 0x0c, 0x45, 0x2a, 0xe7, 0xc7, 0xc5, 0x44, 0x74, 0x4a, 0xca,
-	0xbe, 0x88, 0xc4, 0xf2, 0xdf, 0x63, 0xb0, 0x50, 0xe9, 0xaf, 0x23, 0xd4, 0x81, 0xc5, 0xc1, 0xb0,
-	0xd0, 0x83, 0xb0, 0xe2, 0xd0, 0xf8, 0xd3, 0xb9, 0xd9, 0x00, 0xf9, 0x66, 0x36, 0x3e, 0x7c, 0xfb,
-	0xf5, 0x39, 0x9a, 0xd5, 0x52, 0x46, 0x78, 0xfd, 0x49, 0xe4, 0x9e, 0xb2, 0x8d, 0x9a, 0xf0, 0x97,
-	0xb0, 0x1d, 0x65, 0xc2, 0xf5, 0x82, 0x13, 0x4b, 0x67, 0x67, 0x44, 0x25, 0x95, 0x26, 0xa8, 0x32,
-	0x28, 0x6d, 0x4c, 0xec, 0x3e, 0x6a, 0xbc, 0xe5, 0xf3, 0x7d, 0x8f, 0x7a, 0x10, 0x0f, 0xfc, 0x1d,
-	0x48, 0x9b, 0xf9, 0x47, 0x8d, 0x58, 0x37, 0xee, 0xc4, 0x48, 0x6e, 0x55, 0x70, 0xa7, 0xb4, 0xe4,
-	0x14, 0x6e, 0xae, 0x90, 0x42, 0x3c, 0xb0, 0xc2, 0x26, 0x79, 0x27, 0x77, 0xea, 0x24, 0xef, 0x94,
-	0x1d, 0xa8, 0xfd, 0x2f, 0x78, 0xff, 0x41, 0xd3, 0x78, 0x0b, 0x7b, 0x5f, 0x6f, 0x54, 0xe5, 0xfa,
-	0x46, 0x55, 0x7e, 0xde, 0xa8, 0xca, 0xa7, 0x5b, 0x35, 0x72, 0x7d, 0xab, 0x46, 0x7e, 0xdc, 0xaa,
-	0x91, 0x57, 0x39, 0xc7, 0x65, 0xcd, 0xae, 0xad, 0xd7, 0xc8, 0x85, 0xd1, 0x72, 0x3d, 0xcc, 0xb3,
-	0x1f, 0xd3, 0xfa, 0x6b, 0x83, 0x5d, 0xb5, 0x31, 0x35, 0x98, 0x6f, 0xcf, 0x8b, 0x75, 0xff, 0xe4,
-	0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xde, 0x13, 0xef, 0xcc, 0xb6, 0x06, 0x00, 0x00,
+	// 937 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
+	0x10, 0x15, 0x25, 0xd9, 0x72, 0x46, 0x8e, 0x23, 0xac, 0x9d, 0x58, 0x61, 0x6d, 0x46, 0xa5, 0x8d,
+	0xc6, 0x70, 0x51, 0x12, 0x56, 0x91, 0x43, 0x73, 0xb3, 0x3e, 0xea, 0x0a, 0x6d, 0xe2, 0x80, 0x52,
+	0x51, 0xa4, 0x17, 0x81, 0x94, 0xd6, 0x14, 0x11, 0x99, 0x2b, 0x6b, 0x57, 0x2e, 0x8d, 0x34, 0x97,
+	0xa2, 0xe7, 0xa2, 0x40, 0xff, 0x4e, 0xef, 0xcd, 0x31, 0x40, 0x2f, 0x3d, 0x15, 0x85, 0xdd, 0xfe,
+	0x8f, 0x82, 0xc3, 0x95, 0x44, 0xd1, 0x92, 0x13, 0x04, 0x05, 0x7a, 0xdb, 0xe5, 0xbc, 0x99, 0x37,
+	0x33, 0xbb, 0xf3, 0x96, 0xb0, 0xd5, 0x3f, 0x71, 0x4c, 0x11, 0x98, 0xe7, 0x07, 0x0e, 0x15, 0xf6,
+	0x81, 0xc9, 0xe9, 0xf0, 0xdc, 0xeb, 0x50, 0x63, 0x30, 0x64, 0x82, 0x91, 0xb5, 0xfe, 0x89, 0x63,
+	0x88, 0xc0, 0x90, 0x56, 0x75, 0xcb, 0x65, 0xcc, 0xed, 0x53, 0xd3, 0x1e, 0x78, 0xa6, 0xed, 0xfb,
+	0x4c, 0xd8, 0xc2, 0x63, 0x3e, 0x8f, 0xd0, 0x6a, 0x29, 0x8c, 0xe5, 0xd8, 0x9c, 0x9a, 0xb6, 0xd3,
+	0xf1, 0x26, 0x21, 0xc3, 0x8d, 0x44, 0x6c, 0x26, 0xd8, 0x44, 0x20, 0x0d, 0x1b, 0x2e, 0x73, 0x19,
+	0x2e, 0xcd, 0x70, 0x25, 0xbf, 0x3e, 0x9c, 0x04, 0x3c, 0x1b, 0xd1, 0xe1, 0xc5, 0xc4, 0x6d, 0x60,
+	0xbb, 0x9e, 0x8f, 0xd4, 0x12, 0x78, 0x9f, 0x71, 0x31, 0xb4, 0x3b, 0xcc, 0x8f, 0x98, 0xc5, 0xc5,
+	0x80, 0x8e, 0x93, 0x52, 0x27, 0x26, 0xfc, 0x6a, 0x3a, 0x7d, 0xd6, 0x79, 0xb1, 0xc0, 0x16, 0xf3,
+	0xd3, 0xcf, 0x80, 0x1c, 0x51, 0xd1, 0x0a, 0x78, 0xfd, 0x9c, 0xfa, 0xc2, 0xa2, 0x67, 0x23, 0xca,
+	0x05, 0xb9, 0x07, 0xcb, 0x34, 0xdc, 0xf3, 0xa2, 0x52, 0xca, 0xec, 0xdd, 0xb2, 0xe4, 0x8e, 0x54,
+	0x01, 0xa6, 0x49, 0x15, 0xd3, 0x25, 0x65, 0x2f, 0x5f, 0xde, 0x31, 0xc2, 0xee, 0x85, 0xe9, 0x1b,
+	0x98, 0xfe, 0xb8, 0x8b, 0xc6, 0x33, 0xdb, 0xa5, 0x32, 0xa0, 0x15, 0x73, 0xd3, 0x7f, 0x53, 0x60,
+	0x7d, 0x86, 0x93, 0x0f, 0x98, 0xcf, 0x29, 0xd9, 0x85, 0x8c, 0x08, 0x22, 0xc6, 0x7c, 0x99, 0x18,
+	0xb3, 0x67, 0x62, 0xb4, 0x02, 0x2b, 0x34, 0x93, 0x1a, 0xac, 0x8a, 0xa0, 0x3d, 0x94, 0x4e, 0xbc,
+	0x98, 0x46, 0xf8, 0x87, 0xd3, 0x24, 0xf0, 0x1c, 0x62, 0x5e, 0x12, 0x69, 0xe5, 0xc5, 0x64, 0x1d,
+	0x46, 0x89, 0x17, 0x92, 0xc1, 0x42, 0x76, 0x6f, 0x2e, 0x44, 0x86, 0x89, 0x57, 0xe2, 0x00, 0xa9,
+	0x0c, 0x99, 0xdd, 0xed, 0xd8, 0x5c, 0xb4, 0x02, 0x59, 0x2b, 0xb9, 0x0f, 0x2b, 0x22, 0x68, 0x3b,
+	0x17, 0x82, 0x86, 0xc5, 0x28, 0x7b, 0xab, 0x56, 0x4e, 0x04, 0x95, 0x70, 0x4b, 0x0e, 0x20, 0x7b,
+	0xca, 0xba, 0x14, 0x3b, 0xb7, 0x56, 0xde, 0x4e, 0xd6, 0x38, 0x09, 0xf6, 0x84, 0x75, 0xa9, 0x85,
+	0x50, 0xfd, 0x39, 0xac, 0xcf, 0x70, 0xc8, 0x66, 0x55, 0x20, 0x1f, 0x6b, 0x03, 0xf2, 0xbc, 0x53,
+	0x17, 0x60, 0xda, 0x05, 0xfd, 0x11, 0xdc, 0x69, 0x7a, 0xa7, 0xa3, 0xbe, 0x2d, 0xc6, 0xe7, 0x44,
+	0x74, 0x48, 0x8b, 0x40, 0x46, 0x9b, 0x77, 0x04, 0x69, 0x11, 0xe8, 0x3f, 0x2a, 0x50, 0x98, 0xfa,
+	0xc9, 0x7c, 0x3e, 0x83, 0x15, 0xd7, 0xe6, 0x6d, 0xcf, 0x3f, 0x61, 0xd2, 0x5d, 0x5b, 0x90, 0xcc,
+	0x91, 0xcd, 0x1b, 0xfe, 0x09, 0xb3, 0x72, 0x6e, 0xb4, 0x20, 0x8f, 0x60, 0x79, 0x48, 0xf9, 0xa8,
+	0x2f, 0xe4, 0x85, 0xda, 0x5e, 0xe0, 0x68, 0x21, 0xc8, 0x92, 0x60, 0x5d, 0x87, 0x55, 0xbc, 0x45,
+	0xe3, 0xd4, 0x09, 0x64, 0x7b, 0x36, 0xef, 0x21, 0xfb, 0x2d, 0x0b, 0xd7, 0xfa, 0x77, 0x70, 0x5b,
+	0x62, 0x64, 0x9a, 0xef, 0x50, 0x5f, 0xb2, 0xb5, 0xe9, 0xf7, 0x69, 0xed, 0x08, 0xee, 0x1d, 0x51,
+	0x51, 0x09, 0x87, 0xf0, 0x1b, 0x4f, 0xf4, 0x5a, 0x01, 0x8f, 0x8d, 0x56, 0x8f, 0x7a, 0x6e, 0x4f,
+	0x60, 0x16, 0x19, 0x4b, 0xee, 0xfe, 0x9b, 0xd1, 0xfa, 0x35, 0x03, 0x9b, 0xd7, 0x78, 0xff, 0x87,
+	0xf1, 0x2a, 0xc3, 0x0a, 0x0a, 0x50, 0xdb, 0xeb, 0xca, 0xe1, 0xda, 0x34, 0xc6, 0x22, 0x64, 0x44,
+	0xf2, 0x83, 0x39, 0x36, 0x6a, 0x56, 0x0e, 0x81, 0x8d, 0x2e, 0xf9, 0x18, 0x96, 0x70, 0x59, 0xcc,
+	0xa2, 0xc3, 0xdd, 0xb9, 0x0e, 0x56, 0x84, 0x21, 0x5f, 0x00, 0x71, 0xa8, 0xeb, 0xf9, 0xed, 0x88,
+	0x46, 0x8a, 0xd5, 0x12, 0x26, 0xbb, 0x31, 0xf5, 0xc4, 0x64, 0x51, 0x65, 0x2a, 0xd9, 0xd7, 0x7f,
+	0x3e, 0x48, 0x59, 0x05, 0xf4, 0xc2, 0x50, 0xf5, 0x48, 0xd2, 0x6a, 0x50, 0xa0, 0x7e, 0x77, 0x36,
+	0xce, 0xf2, 0x5b, 0xe3, 0xac, 0x51, 0xbf, 0x3b, 0x1b, 0x25, 0x7e, 0x7a, 0xb9, 0xf7, 0xd3, 0x93,
+	0xfd, 0xef, 0xe1, 0xf6, 0x8c, 0x04, 0x10, 0x0d, 0xd4, 0x8a, 0x75, 0x7c, 0x58, 0xab, 0x1e, 0x36,
+	0x5b, 0xed, 0x27, 0xc7, 0xb5, 0x7a, 0xfb, 0xeb, 0xa7, 0xcd, 0x67, 0xf5, 0x6a, 0xe3, 0xf3, 0x46,
+	0xbd, 0x56, 0x48, 0x91, 0x22, 0x6c, 0x24, 0xec, 0x95, 0xaf, 0x8e, 0xab, 0x5f, 0x16, 0x14, 0xb2,
+	0x09, 0xeb, 0x09, 0x4b, 0xf3, 0xf9, 0xd3, 0x6a, 0x21, 0x3d, 0xc7, 0xe5, 0x10, 0x2d, 0x99, 0xf2,
+	0x3f, 0x59, 0xc8, 0x35, 0xa3, 0x77, 0x91, 0x0c, 0x61, 0x65, 0x3c, 0xe2, 0xe4, 0x41, 0xf2, 0xae,
+	0x24, 0x44, 0x43, 0x2d, 0x2d, 0x06, 0xc8, 0x71, 0xd8, 0xf9, 0xe1, 0xf7, 0xbf, 0x7f, 0x49, 0x6f,
+	0xeb, 0x45, 0x33, 0xf9, 0x0e, 0x4b, 0xe4, 0x63, 0x65, 0x9f, 0xf4, 0x60, 0x09, 0x87, 0x95, 0x6c,
+	0x25, 0xe3, 0xc5, 0xe7, 0x5c, 0xdd, 0x5e, 0x60, 0x95, 0x54, 0x3a, 0x52, 0x6d, 0x11, 0xd5, 0xbc,
+	0xf6, 0x08, 0x73, 0xf3, 0x65, 0xa8, 0x0a, 0xaf, 0xc8, 0x39, 0xe4, 0x63, 0x9a, 0x4a, 0xf4, 0x85,
+	0x3a, 0x3c, 0x65, 0xdd, 0xb9, 0x11, 0x23, 0xb9, 0x35, 0xe4, 0x2e, 0xea, 0xeb, 0x73, 0xb8, 0xc3,
+	0x0a, 0x39, 0xe4, 0x63, 0x0f, 0xdf, 0x75, 0xde, 0xeb, 0x2f, 0xb1, 0xba, 0x73, 0x23, 0x46, 0xf2,
+	0x7e, 0x80, 0xbc, 0x77, 0xc9, 0x3c, 0x5e, 0xf2, 0x93, 0x02, 0x77, 0x12, 0x9a, 0x40, 0x3e, 0x9a,
+	0x13, 0x75, 0x8e, 0x58, 0xa9, 0x0f, 0xdf, 0x8a, 0x93, 0x19, 0xec, 0x63, 0x06, 0xbb, 0x44, 0x9f,
+	0xd7, 0x75, 0x9c, 0x2d, 0xf3, 0x65, 0x24, 0x74, 0xaf, 0x2a, 0x8f, 0x5f, 0x5f, 0x6a, 0xca, 0x9b,
+	0x4b, 0x4d, 0xf9, 0xeb, 0x52, 0x53, 0x7e, 0xbe, 0xd2, 0x52, 0x6f, 0xae, 0xb4, 0xd4, 0x1f, 0x57,
+	0x5a, 0xea, 0xdb, 0x92, 0xeb, 0x89, 0xde, 0xc8, 0x31, 0x3a, 0xec, 0xd4, 0xec, 0x7b, 0x3e, 0x0d,
+	0x83, 0x7d, 0xc2, 0xbb, 0x2f, 0xc6, 0xff, 0x2d, 0x81, 0xb3, 0x8c, 0x7f, 0x2d, 0x9f, 0xfe, 0x3b,
+	0x00, 0xb0, 0x43, 0xff, 0xa9, 0xd0, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -582,6 +754,8 @@ type ServiceClient interface {
 	BroadcastTx(ctx context.Context, in *BroadcastTxRequest, opts ...grpc.CallOption) (*BroadcastTxResponse, error)
 	// GetTxsEvent fetches txs by event.
 	GetTxsEvent(ctx context.Context, in *GetTxsEventRequest, opts ...grpc.CallOption) (*GetTxsEventResponse, error)
+	// GetBlockWithTxs fetches a block with its decoded txs, their results and the block events.
+	GetBlockWithTxs(ctx context.Context, in *GetBlockWithTxsRequest, opts ...grpc.CallOption) (*GetBlockWithTxsResponse, error)
 }
 
 type serviceClient struct {
@@ -628,6 +802,15 @@ func (c *serviceClient) GetTxsEvent(ctx context.Context, in *GetTxsEventRequest,
 	return out, nil
 }
 
+func (c *serviceClient) GetBlockWithTxs(ctx context.Context, in *GetBlockWithTxsRequest, opts ...grpc.CallOption) (*GetBlockWithTxsResponse, error) {
+	out := new(GetBlockWithTxsResponse)
+	err := c.cc.Invoke(ctx, "/lfb.tx.v1beta1.Service/GetBlockWithTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 type ServiceServer interface {
 	// Simulate simulates executing a transaction for estimating gas usage.
@@ -638,6 +821,8 @@ type ServiceServer interface {
 	BroadcastTx(context.Context, *BroadcastTxRequest) (*BroadcastTxResponse, error)
 	// GetTxsEvent fetches txs by event.
 	GetTxsEvent(context.Context, *GetTxsEventRequest) (*GetTxsEventResponse, error)
+	// GetBlockWithTxs fetches a block with its decoded txs, their results and the block events.
+	GetBlockWithTxs(context.Context, *GetBlockWithTxsRequest) (*GetBlockWithTxsResponse, error)
 }
 
 // UnimplementedServiceServer can be embedded to have forward compatible implementations.
@@ -656,6 +841,9 @@ func (*UnimplementedServiceServer) BroadcastTx(ctx context.Context, req *Broadca
 func (*UnimplementedServiceServer) GetTxsEvent(ctx context.Context, req *GetTxsEventRequest) (*GetTxsEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTxsEvent not implemented")
 }
+func (*UnimplementedServiceServer) GetBlockWithTxs(ctx context.Context, req *GetBlockWithTxsRequest) (*GetBlockWithTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockWithTxs not implemented")
+}
 
 func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
 	s.RegisterService(&_Service_serviceDesc, srv)
@@ -733,6 +921,24 @@ func _Service_GetTxsEvent_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_GetBlockWithTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockWithTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetBlockWithTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lfb.tx.v1beta1.Service/GetBlockWithTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetBlockWithTxs(ctx, req.(*GetBlockWithTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Service_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lfb.tx.v1beta1.Service",
 	HandlerType: (*ServiceServer)(nil),
@@ -753,6 +959,10 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTxsEvent",
 			Handler:    _Service_GetTxsEvent_Handler,
 		},
+		{
+			MethodName: "GetBlockWithTxs",
+			Handler:    _Service_GetBlockWithTxs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lfb/tx/v1beta1/service.proto",
@@ -1094,6 +1304,161 @@ func (m *GetTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GetBlockWithTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockWithTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockWithTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockWithTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockWithTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockWithTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.EndBlockEvents) > 0 {
+		for iNdEx := len(m.EndBlockEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EndBlockEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.BeginBlockEvents) > 0 {
+		for iNdEx := len(m.BeginBlockEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BeginBlockEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BlockId != nil {
+		{
+			size, err := m.BlockId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TxResponses) > 0 {
+		for iNdEx := len(m.TxResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintService(dAtA []byte, offset int, v uint64) int {
 	offset -= sovService(v)
 	base := offset
@@ -1238,16 +1603,77 @@ func (m *GetTxResponse) Size() (n int) {
 	return n
 }
 
-func sovService(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozService(x uint64) (n int) {
-	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GetTxsEventRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+func (m *GetBlockWithTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovService(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *GetBlockWithTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.TxResponses) > 0 {
+		for _, e := range m.TxResponses {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.BlockId != nil {
+		l = m.BlockId.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.BeginBlockEvents) > 0 {
+		for _, e := range m.BeginBlockEvents {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.EndBlockEvents) > 0 {
+		for _, e := range m.EndBlockEvents {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func sovService(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozService(x uint64) (n int) {
+	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetTxsEventRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -2117,6 +2543,405 @@ func (m *GetTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetBlockWithTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockWithTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockWithTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockWithTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockWithTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockWithTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &Tx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxResponses = append(m.TxResponses, &types.TxResponse{})
+			if err := m.TxResponses[len(m.TxResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockId == nil {
+				m.BlockId = &types1.BlockID{}
+			}
+			if err := m.BlockId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &types1.Block{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlockEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeginBlockEvents = append(m.BeginBlockEvents, types2.Event{})
+			if err := m.BeginBlockEvents[len(m.BeginBlockEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlockEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndBlockEvents = append(m.EndBlockEvents, types2.Event{})
+			if err := m.EndBlockEvents[len(m.EndBlockEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipService(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -88,3 +88,12 @@ type TxDecoder func(txBytes []byte) (Tx, error)
 
 // TxEncoder marshals transaction to bytes
 type TxEncoder func(tx Tx) ([]byte, error)
+
+// MsgTypeURL returns the TypeURL of a `sdk.Msg`. For a ServiceMsg it is the
+// TypeURL of its request.
+func MsgTypeURL(msg Msg) string {
+	if svcMsg, ok := msg.(ServiceMsg); ok {
+		return "/" + proto.MessageName(svcMsg.Request)
+	}
+	return "/" + proto.MessageName(msg)
+}
@@ -87,13 +87,23 @@ func ParseTimeBytes(bz []byte) (time.Time, error) {
 
 // NewLevelDB instantiate a new LevelDB instance according to DBBackend.
 func NewLevelDB(name, dir string) (db tmdb.DB, err error) {
+	return NewDB(name, "", dir)
+}
+
+// NewDB instantiate a new DB instance of the given backend, e.g. goleveldb or
+// badgerdb, or of the DBBackend the binary was built with if it is empty. The
+// backend must have been compiled in with its build tag.
+func NewDB(name, dbBackend, dir string) (db tmdb.DB, err error) {
 	defer func() {
 		if r := recover(); r != nil {
 			err = fmt.Errorf("couldn't create db: %v", r)
 		}
 	}()
 
-	return metadb.NewDB(name, backend, dir)
+	if dbBackend == "" {
+		return metadb.NewDB(name, backend, dir)
+	}
+	return metadb.NewDB(name, metadb.BackendType(dbBackend), dir)
 }
 
 // copy bytes
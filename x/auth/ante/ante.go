@@ -8,9 +8,12 @@ import (
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & sig block height, and deducts fees from the first
-// signer.
+// signer, or from the fee granter if one is set. The feegrant keeper may be nil,
+// in which case transactions with a fee granter are rejected. The per-block
+// limits of the auth params are tracked in the transient store of tkey, and
+// aren't enforced if it is nil.
 func NewAnteHandler(
-	ak AccountKeeper, bankKeeper types.BankKeeper,
+	ak AccountKeeper, bankKeeper types.BankKeeper, feegrantKeeper FeegrantKeeper, tkey sdk.StoreKey,
 	sigGasConsumer SignatureVerificationGasConsumer,
 	signModeHandler signing.SignModeHandler,
 ) sdk.AnteHandler {
@@ -18,17 +21,18 @@ func NewAnteHandler(
 		NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		NewRejectExtensionOptionsDecorator(),
 		NewMempoolFeeDecorator(),
+		NewTxPriorityDecorator(),
 		NewValidateBasicDecorator(),
 		NewTxSigBlockHeightDecorator(ak),
 		TxTimeoutHeightDecorator{},
+		NewMsgLimitDecorator(ak, tkey),
 		NewValidateMemoDecorator(ak),
 		NewConsumeGasForTxSizeDecorator(ak),
-		NewRejectFeeGranterDecorator(),
 		// The above handlers should not call `GetAccount` or `GetSignerAcc` for signer
 		NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
 		// The handlers below may call `GetAccount` or `GetSignerAcc` for signer
 		NewValidateSigCountDecorator(ak),
-		NewDeductFeeDecorator(ak, bankKeeper),
+		NewDeductFeeDecorator(ak, bankKeeper, feegrantKeeper),
 		NewSigGasConsumeDecorator(ak, sigGasConsumer),
 		NewSigVerificationDecorator(ak, signModeHandler),
 		NewIncrementSequenceDecorator(ak),
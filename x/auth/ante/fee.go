@@ -2,6 +2,7 @@ package ante
 
 import (
 	"fmt"
+	"math"
 
 	sdk "github.com/line/lfb-sdk/types"
 	sdkerrors "github.com/line/lfb-sdk/types/errors"
@@ -54,19 +55,93 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	return next(ctx, tx, simulate)
 }
 
-// DeductFeeDecorator deducts fees from the first signer of the tx
-// If the first signer does not have the funds to pay for the fees, return with InsufficientFunds error
+// GasPricePriorityScale is the factor the gas price of a tx is multiplied by to
+// compute its priority, so that gas prices below one unit of the fee denom or
+// below the minimum gas price still yield distinct priorities.
+const GasPricePriorityScale = 1000000
+
+// TxPriorityDecorator sets the priority of the tx in the context to its gas
+// price, i.e. the fee paid per unit of gas scaled by GasPricePriorityScale.
+// Fee coins of different denoms can't be compared with each other, so the gas
+// price of each fee coin is expressed as a multiple of the minimum gas price of
+// its denom, and the highest multiple is used. Fee coins in denoms without a
+// positive minimum gas price are ignored. If the node has no positive minimum
+// gas prices, only a fee of a single coin yields a priority, which is its raw
+// gas price. The priority is returned in the events of ResponseCheckTx. It is
+// advisory only: the Ostracon mempool orders txs by arrival and ignores it.
+// CONTRACT: Tx must implement FeeTx to use TxPriorityDecorator
+type TxPriorityDecorator struct{}
+
+func NewTxPriorityDecorator() TxPriorityDecorator {
+	return TxPriorityDecorator{}
+}
+
+func (tpd TxPriorityDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	priority := GetTxPriority(feeTx.GetFee(), feeTx.GetGas(), ctx.MinGasPrices())
+	return next(ctx.WithPriority(priority), tx, simulate)
+}
+
+// GetTxPriority returns the priority of a tx with the given fee and gas limit
+// on a node with the given minimum gas prices, see TxPriorityDecorator.
+func GetTxPriority(fee sdk.Coins, gas uint64, minGasPrices sdk.DecCoins) int64 {
+	if fee.IsZero() || gas == 0 {
+		return 0
+	}
+
+	gasDec := sdk.NewDecFromInt(sdk.NewIntFromUint64(gas))
+	scale := sdk.NewDec(GasPricePriorityScale)
+
+	var priority sdk.Dec
+	if minGasPrices.IsZero() {
+		if len(fee) != 1 {
+			return 0
+		}
+		priority = fee[0].Amount.ToDec().Mul(scale).Quo(gasDec)
+	} else {
+		priority = sdk.ZeroDec()
+		for _, coin := range fee {
+			minGasPrice := minGasPrices.AmountOf(coin.Denom)
+			if !minGasPrice.IsPositive() {
+				continue
+			}
+			p := coin.Amount.ToDec().Mul(scale).Quo(gasDec.Mul(minGasPrice))
+			if p.GT(priority) {
+				priority = p
+			}
+		}
+	}
+
+	truncated := priority.TruncateInt()
+	if !truncated.IsInt64() {
+		return math.MaxInt64
+	}
+
+	return truncated.Int64()
+}
+
+// DeductFeeDecorator deducts fees from the first signer of the tx, or from the
+// fee granter if the tx sets one and the fee payer was granted an allowance.
+// If the account paying the fees does not have the funds, return with InsufficientFunds error
 // Call next AnteHandler if fees successfully deducted
 // CONTRACT: Tx must implement FeeTx interface to use DeductFeeDecorator
 type DeductFeeDecorator struct {
-	ak         AccountKeeper
-	bankKeeper types.BankKeeper
+	ak             AccountKeeper
+	bankKeeper     types.BankKeeper
+	feegrantKeeper FeegrantKeeper
 }
 
-func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper) DeductFeeDecorator {
+// NewDeductFeeDecorator returns a new DeductFeeDecorator. The feegrant keeper
+// may be nil, in which case transactions with a fee granter are rejected.
+func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper) DeductFeeDecorator {
 	return DeductFeeDecorator{
-		ak:         ak,
-		bankKeeper: bk,
+		ak:             ak,
+		bankKeeper:     bk,
+		feegrantKeeper: fk,
 	}
 }
 
@@ -80,12 +155,33 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		panic(fmt.Sprintf("%s module account has not been set", types.FeeCollectorName))
 	}
 
+	fee := feeTx.GetFee()
 	feePayer := feeTx.FeePayer()
-	feePayerAcc := dfd.ak.NewAccountWithAddress(ctx, feePayer)
+	feeGranter := feeTx.FeeGranter()
+
+	deductFeesFromAcc := dfd.ak.NewAccountWithAddress(ctx, feePayer)
+
+	// if the fee granter is set, the fees are deducted from its account as
+	// long as it granted an allowance to the fee payer
+	if feeGranter != nil {
+		if dfd.feegrantKeeper == nil {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee grants are not enabled")
+		} else if !feeGranter.Equals(feePayer) {
+			err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, feePayer, fee, tx.GetMsgs())
+			if err != nil {
+				return ctx, sdkerrors.Wrapf(err, "%s not allowed to pay fees from %s", feeGranter, feePayer)
+			}
+		}
+
+		deductFeesFromAcc = dfd.ak.GetAccount(ctx, feeGranter)
+		if deductFeesFromAcc == nil {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "fee granter address: %s does not exist", feeGranter)
+		}
+	}
 
 	// deduct the fees
-	if !feeTx.GetFee().IsZero() {
-		err = DeductFees(dfd.bankKeeper, ctx, feePayerAcc, feeTx.GetFee())
+	if !fee.IsZero() {
+		err = DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, fee)
 		if err != nil {
 			return ctx, err
 		}
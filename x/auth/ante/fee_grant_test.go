@@ -1,32 +1,105 @@
 package ante_test
 
 import (
-	"github.com/line/lfb-sdk/codec"
-	"github.com/line/lfb-sdk/codec/types"
+	"errors"
+	"time"
+
+	cryptotypes "github.com/line/lfb-sdk/crypto/types"
 	"github.com/line/lfb-sdk/testutil/testdata"
 	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
 	"github.com/line/lfb-sdk/x/auth/ante"
-	"github.com/line/lfb-sdk/x/auth/tx"
+	feegranttypes "github.com/line/lfb-sdk/x/feegrant/types"
 )
 
-type setFeeGranter interface {
-	SetFeeGranter(feeGranter sdk.AccAddress)
-}
-
 func (suite *AnteTestSuite) TestRejectFeeGranter() {
 	suite.SetupTest(true) // setup
-	txConfig := tx.NewTxConfig(codec.NewProtoCodec(types.NewInterfaceRegistry()), tx.DefaultSignModes)
-	txBuilder := txConfig.NewTxBuilder()
-	d := ante.NewRejectFeeGranterDecorator()
-	antehandler := sdk.ChainAnteDecorators(d)
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+
+	// without a feegrant keeper, fee grants are not supported
+	antehandler := sdk.ChainAnteDecorators(ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, nil))
 
-	_, err := antehandler(suite.ctx, txBuilder.GetTx(), false)
+	_, err := antehandler(suite.ctx, suite.txBuilder.GetTx(), false)
 	suite.Require().NoError(err)
 
-	setGranterTx := txBuilder.(setFeeGranter)
-	_, _, addr := testdata.KeyTestPubAddr()
-	setGranterTx.SetFeeGranter(addr)
+	_, _, granter := testdata.KeyTestPubAddr()
+	suite.txBuilder.SetFeeGranter(granter)
 
-	_, err = antehandler(suite.ctx, txBuilder.GetTx(), false)
+	_, err = antehandler(suite.ctx, suite.txBuilder.GetTx(), false)
 	suite.Require().Error(err)
+	suite.Require().True(sdkerrors.ErrInvalidRequest.Is(err))
+}
+
+func (suite *AnteTestSuite) TestDeductGrantedFees() {
+	suite.SetupTest(false) // setup
+
+	dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.FeeGrantKeeper)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	_, _, addr3 := testdata.KeyTestPubAddr()
+	_, _, addr4 := testdata.KeyTestPubAddr()
+
+	// addr2 funds addr1, addr3 has a grant but no funds, addr4 granted nothing
+	suite.Require().NoError(suite.app.BankKeeper.SetBalances(suite.ctx, addr2, sdk.NewCoins(sdk.NewInt64Coin("atom", 99999))))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr2))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr3))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr4))
+
+	expiration := suite.ctx.BlockTime().Add(time.Hour)
+	err := suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, addr2, addr1, &feegranttypes.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 500)),
+		Expiration: &expiration,
+	})
+	suite.Require().NoError(err)
+	err = suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, addr3, addr1, &feegranttypes.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 500)),
+	})
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name    string
+		granter sdk.AccAddress
+		fee     int64
+		expErr  error
+	}{
+		{"paid by fee payer", nil, 0, nil},
+		{"paid by granter", addr2, 300, nil},
+		{"granted fee exceeds the remaining allowance", addr2, 300, feegranttypes.ErrFeeLimitExceeded},
+		{"granter without funds", addr3, 300, sdkerrors.ErrInsufficientFunds},
+		{"no allowance from granter", addr4, 1, feegranttypes.ErrNoAllowance},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+			suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", tc.fee)))
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+			suite.txBuilder.SetFeeGranter(tc.granter)
+
+			tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}, suite.ctx.ChainID())
+			suite.Require().NoError(err)
+
+			granterBalance := suite.app.BankKeeper.GetAllBalances(suite.ctx, tc.granter)
+
+			_, err = antehandler(suite.ctx, tx, false)
+			if tc.expErr != nil {
+				suite.Require().Error(err)
+				suite.Require().True(errors.Is(err, tc.expErr), err.Error())
+				return
+			}
+			suite.Require().NoError(err)
+
+			if tc.granter != nil {
+				fee := sdk.NewCoins(sdk.NewInt64Coin("atom", tc.fee))
+				suite.Require().Equal(granterBalance.Sub(fee), suite.app.BankKeeper.GetAllBalances(suite.ctx, tc.granter))
+			}
+		})
+	}
 }
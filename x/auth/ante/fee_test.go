@@ -1,6 +1,8 @@
 package ante_test
 
 import (
+	"math"
+
 	cryptotypes "github.com/line/lfb-sdk/crypto/types"
 	"github.com/line/lfb-sdk/testutil/testdata"
 
@@ -60,6 +62,72 @@ func (suite *AnteTestSuite) TestEnsureMempoolFees() {
 	suite.Require().Nil(err, "Decorator should not have errored on fee higher than local gasPrice")
 }
 
+func (suite *AnteTestSuite) TestTxPriority() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	tpd := ante.NewTxPriorityDecorator()
+	antehandler := sdk.ChainAnteDecorators(tpd)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// msg and signatures
+	msg := testdata.NewTestMsg(addr1)
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 150), sdk.NewInt64Coin("stake", 300)))
+	suite.txBuilder.SetGasLimit(200000)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	// without minimum gas prices the fee coins can't be compared
+	newCtx, err := antehandler(suite.ctx.WithIsCheckTx(true), tx, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(0), newCtx.Priority())
+
+	// the gas price of 0.00075atom is 3 times the minimum one and the one of
+	// 0.0015stake 1.5 times, so the atom fee is used
+	minGasPrices := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(25, 5)),
+		sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 3)),
+	)
+	newCtx, err = antehandler(suite.ctx.WithIsCheckTx(true).WithMinGasPrices(minGasPrices), tx, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(3000000), newCtx.Priority())
+}
+
+func (suite *AnteTestSuite) TestGetTxPriority() {
+	minGasPrices := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 2)),
+		sdk.NewDecCoinFromDec("stake", sdk.NewDec(10)),
+	)
+
+	testCases := []struct {
+		name         string
+		fee          sdk.Coins
+		gas          uint64
+		minGasPrices sdk.DecCoins
+		expected     int64
+	}{
+		{"no fee", sdk.NewCoins(), 100000, nil, 0},
+		{"no gas", sdk.NewCoins(sdk.NewInt64Coin("atom", 150)), 0, nil, 0},
+		{"gas price below one", sdk.NewCoins(sdk.NewInt64Coin("atom", 150)), 200000, nil, 750},
+		{"gas price above one", sdk.NewCoins(sdk.NewInt64Coin("atom", 300)), 100, nil, 3000000},
+		{"several denoms without min gas prices", sdk.NewCoins(sdk.NewInt64Coin("atom", 300), sdk.NewInt64Coin("stake", 200)), 100, nil, 0},
+		{"multiple of min gas price", sdk.NewCoins(sdk.NewInt64Coin("atom", 3)), 100, minGasPrices, 3000000},
+		{"highest multiple of min gas prices", sdk.NewCoins(sdk.NewInt64Coin("atom", 1), sdk.NewInt64Coin("stake", 1000000)), 100, minGasPrices, 1000000000},
+		{"denom without min gas price", sdk.NewCoins(sdk.NewInt64Coin("atom", 1), sdk.NewInt64Coin("big", 1000000)), 100, minGasPrices, 1000000},
+		{"no denom with min gas price", sdk.NewCoins(sdk.NewInt64Coin("big", 1000000)), 100, minGasPrices, 0},
+		{"overflow", sdk.NewCoins(sdk.NewCoin("atom", sdk.NewIntWithDecimal(1, 30))), 1, nil, math.MaxInt64},
+	}
+
+	for _, tc := range testCases {
+		suite.Require().Equal(tc.expected, ante.GetTxPriority(tc.fee, tc.gas, tc.minGasPrices), tc.name)
+	}
+}
+
 func (suite *AnteTestSuite) TestDeductFees() {
 	suite.SetupTest(true) // setup
 	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
@@ -84,7 +152,7 @@ func (suite *AnteTestSuite) TestDeductFees() {
 	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
 	suite.app.BankKeeper.SetBalances(suite.ctx, addr1, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(10))))
 
-	dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper)
+	dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, nil)
 	antehandler := sdk.ChainAnteDecorators(dfd)
 
 	_, err = antehandler(suite.ctx, tx, false)
@@ -0,0 +1,230 @@
+package ante
+
+import (
+	"sync"
+
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	"github.com/line/lfb-sdk/x/auth/signing"
+	"github.com/line/lfb-sdk/x/auth/types"
+)
+
+// MsgLimitDecorator enforces the per-block limits of the auth params: the
+// gas the txs including a Msg type can use, counted by their gas limits, and
+// the number of txs an account can sign. The usage of the current block is
+// tracked in a transient store, which is reset on Commit.
+// In CheckTx, the usage is tracked for the block of the check state as well,
+// so that the mempool doesn't accept more txs than a block can include. As
+// the txs of different signers are checked concurrently, each on a branch of
+// the check state, the gas used by Msg type is tracked in memory there.
+// The txs of a block executed in parallel conflict on the gas used by a Msg
+// type unless a MsgGasAccumulator is registered for it with SetAccumulator.
+// If the transient store key is nil, no limit is enforced.
+// CONTRACT: Tx must implement GasTx interface
+type MsgLimitDecorator struct {
+	ak   AccountKeeper
+	tkey sdk.StoreKey
+
+	checkTxGasUsed *msgGasUsage
+}
+
+func NewMsgLimitDecorator(ak AccountKeeper, tkey sdk.StoreKey) MsgLimitDecorator {
+	return MsgLimitDecorator{
+		ak:             ak,
+		tkey:           tkey,
+		checkTxGasUsed: &msgGasUsage{},
+	}
+}
+
+func (mld MsgLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// limits don't apply to the genesis txs
+	if mld.tkey == nil || ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	gasTx, ok := tx.(GasTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be GasTx")
+	}
+
+	params := mld.ak.GetParams(ctx)
+	// the bookkeeping doesn't consume the gas of the tx
+	store := ctx.MultiStore().GetKVStore(mld.tkey)
+
+	var limits []types.MsgGasLimit
+	if len(params.MsgGasLimits) > 0 {
+		seen := make(map[string]bool)
+		for _, msg := range tx.GetMsgs() {
+			typeURL := sdk.MsgTypeURL(msg)
+			if seen[typeURL] {
+				continue
+			}
+			seen[typeURL] = true
+
+			if maxGas, ok := params.MaxGasPerBlock(typeURL); ok {
+				limits = append(limits, types.MsgGasLimit{MsgTypeURL: typeURL, MaxGasPerBlock: maxGas})
+			}
+		}
+	}
+
+	if !ctx.IsCheckTx() {
+		for _, l := range limits {
+			key := types.MsgGasUsedKey(l.MsgTypeURL)
+			gasUsed := getUint64(store, key)
+			if err := checkMsgGas(l, gasUsed, gasTx.GetGas()); err != nil {
+				return ctx, err
+			}
+			store.Set(key, sdk.Uint64ToBigEndian(gasUsed+gasTx.GetGas()))
+		}
+	}
+
+	if params.MaxTxsPerSigner > 0 {
+		sigTx, ok := tx.(signing.SigVerifiableTx)
+		if !ok {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+		}
+
+		for _, signer := range sigTx.GetSigners() {
+			key := types.SignerTxCountKey(signer)
+			count := getUint64(store, key)
+			if count >= params.MaxTxsPerSigner {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrTooManyTxs,
+					"%s signed %d txs, limit %d", signer, count, params.MaxTxsPerSigner)
+			}
+			store.Set(key, sdk.Uint64ToBigEndian(count+1))
+		}
+	}
+
+	if ctx.IsCheckTx() && len(limits) > 0 {
+		if err := mld.checkTxGasUsed.add(ctx.BlockHeight(), limits, gasTx.GetGas(), simulate); err != nil {
+			return ctx, err
+		}
+		if !simulate {
+			// the gas isn't used if the tx is rejected after all
+			next = releaseOnError(next, func() {
+				mld.checkTxGasUsed.release(ctx.BlockHeight(), limits, gasTx.GetGas())
+			})
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// checkMsgGas returns an error if the txs including the Msg type of the limit
+// would use more gas than it allows.
+func checkMsgGas(l types.MsgGasLimit, gasUsed, gasWanted uint64) error {
+	if gasUsed+gasWanted < gasUsed || gasUsed+gasWanted > l.MaxGasPerBlock {
+		return sdkerrors.Wrapf(sdkerrors.ErrMsgGasLimitExceeded,
+			"%s: gas used %d, gas wanted %d, limit %d", l.MsgTypeURL, gasUsed, gasWanted, l.MaxGasPerBlock)
+	}
+	return nil
+}
+
+// releaseOnError returns an AnteHandler calling next and then release if next
+// fails.
+func releaseOnError(next sdk.AnteHandler, release func()) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		newCtx, err := next(ctx, tx, simulate)
+		if err != nil {
+			release()
+		}
+		return newCtx, err
+	}
+}
+
+// msgGasUsage is the gas used by Msg type in the block of the check state.
+// Each read and update of the usage is atomic, so that the txs checked
+// concurrently can't exceed the limits together.
+type msgGasUsage struct {
+	mtx     sync.Mutex
+	height  int64
+	gasUsed map[string]uint64
+}
+
+// add adds the gas wanted by a tx to the usage of the Msg types of the limits,
+// or returns an error if it would exceed one of them. The usage is reset when
+// the check state moves to another block. If simulate is set, the limits are
+// only checked.
+func (u *msgGasUsage) add(height int64, limits []types.MsgGasLimit, gasWanted uint64, simulate bool) error {
+	u.mtx.Lock()
+	defer u.mtx.Unlock()
+
+	if u.height != height {
+		u.height = height
+		u.gasUsed = make(map[string]uint64)
+	}
+
+	for _, l := range limits {
+		if err := checkMsgGas(l, u.gasUsed[l.MsgTypeURL], gasWanted); err != nil {
+			return err
+		}
+	}
+	if !simulate {
+		for _, l := range limits {
+			u.gasUsed[l.MsgTypeURL] += gasWanted
+		}
+	}
+
+	return nil
+}
+
+// release subtracts the gas added by add, unless the check state moved to
+// another block in the meantime.
+func (u *msgGasUsage) release(height int64, limits []types.MsgGasLimit, gasWanted uint64) {
+	u.mtx.Lock()
+	defer u.mtx.Unlock()
+
+	if u.height != height {
+		return
+	}
+	for _, l := range limits {
+		u.gasUsed[l.MsgTypeURL] -= gasWanted
+	}
+}
+
+// MsgGasAccumulator adds up the gas used by the Msg types with a gas limit,
+// as tracked by MsgLimitDecorator in the transient store. It implements
+// baseapp.LimitedAccumulator, so that the txs of a block executed in parallel
+// only conflict on the Msg types whose limit they exceed together.
+type MsgGasAccumulator struct {
+	ak AccountKeeper
+}
+
+// NewMsgGasAccumulator returns a MsgGasAccumulator reading the limits from
+// the auth params.
+func NewMsgGasAccumulator(ak AccountKeeper) MsgGasAccumulator {
+	return MsgGasAccumulator{ak: ak}
+}
+
+// Sub implements baseapp.Accumulator.
+func (a MsgGasAccumulator) Sub(value, base []byte) ([]byte, bool) {
+	v, b := bytesToUint64(value), bytesToUint64(base)
+	if v < b {
+		return nil, false
+	}
+	return sdk.Uint64ToBigEndian(v - b), true
+}
+
+// Add implements baseapp.Accumulator.
+func (a MsgGasAccumulator) Add(value, amount []byte) []byte {
+	return sdk.Uint64ToBigEndian(bytesToUint64(value) + bytesToUint64(amount))
+}
+
+// WithinLimit implements baseapp.LimitedAccumulator.
+func (a MsgGasAccumulator) WithinLimit(ctx sdk.Context, key, value []byte) bool {
+	typeURL := string(key[len(types.MsgGasUsedKeyPrefix):])
+	maxGas, ok := a.ak.GetParams(ctx).MaxGasPerBlock(typeURL)
+	return !ok || bytesToUint64(value) <= maxGas
+}
+
+func getUint64(store sdk.KVStore, key []byte) uint64 {
+	return bytesToUint64(store.Get(key))
+}
+
+func bytesToUint64(bz []byte) uint64 {
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
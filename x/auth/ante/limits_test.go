@@ -0,0 +1,161 @@
+package ante_test
+
+import (
+	"sync"
+
+	abci "github.com/line/ostracon/abci/types"
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
+
+	cryptotypes "github.com/line/lfb-sdk/crypto/types"
+	"github.com/line/lfb-sdk/testutil/testdata"
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	"github.com/line/lfb-sdk/x/auth/ante"
+	"github.com/line/lfb-sdk/x/auth/types"
+	banktypes "github.com/line/lfb-sdk/x/bank/types"
+)
+
+func (suite *AnteTestSuite) TestMsgLimitDecorator() {
+	suite.SetupTest(false) // setup
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	priv2, _, addr2 := testdata.KeyTestPubAddr()
+
+	params := suite.app.AccountKeeper.GetParams(suite.ctx)
+	params.MsgGasLimits = []types.MsgGasLimit{{MsgTypeURL: "/testdata.TestMsg", MaxGasPerBlock: 250000}}
+	params.MaxTxsPerSigner = 3
+	suite.app.AccountKeeper.SetParams(suite.ctx, params)
+
+	mld := ante.NewMsgLimitDecorator(suite.app.AccountKeeper, suite.app.GetTKey(types.TStoreKey))
+	antehandler := sdk.ChainAnteDecorators(mld)
+
+	createTx := func(priv cryptotypes.PrivKey, gasLimit uint64, msgs ...sdk.Msg) sdk.Tx {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		suite.Require().NoError(suite.txBuilder.SetMsgs(msgs...))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(gasLimit)
+
+		tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{priv}, []uint64{0}, []uint64{0}, suite.ctx.ChainID())
+		suite.Require().NoError(err)
+		return tx
+	}
+	send := banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("atom", 1)))
+
+	// a Msg type is counted once per tx
+	_, err := antehandler(suite.ctx, createTx(priv1, 100000, testdata.NewTestMsg(addr1), testdata.NewTestMsg(addr1)), false)
+	suite.Require().NoError(err)
+	_, err = antehandler(suite.ctx, createTx(priv2, 100000, testdata.NewTestMsg(addr2)), false)
+	suite.Require().NoError(err)
+
+	// the gas limits of the txs exceed the limit of the Msg type
+	_, err = antehandler(suite.ctx, createTx(priv2, 100000, testdata.NewTestMsg(addr2)), false)
+	suite.Require().True(sdkerrors.ErrMsgGasLimitExceeded.Is(err), "unexpected error: %v", err)
+	_, err = antehandler(suite.ctx, createTx(priv2, 50000, testdata.NewTestMsg(addr2)), false)
+	suite.Require().NoError(err)
+
+	// Msg types without a limit aren't limited
+	_, err = antehandler(suite.ctx, createTx(priv1, 1000000, send), false)
+	suite.Require().NoError(err)
+
+	// addr1 signed 2 txs so far, and can sign one more
+	_, err = antehandler(suite.ctx, createTx(priv1, 1000000, send), false)
+	suite.Require().NoError(err)
+	_, err = antehandler(suite.ctx, createTx(priv1, 1000000, send), false)
+	suite.Require().True(sdkerrors.ErrTooManyTxs.Is(err), "unexpected error: %v", err)
+
+	// limits don't apply without the transient store key
+	antehandler = sdk.ChainAnteDecorators(ante.NewMsgLimitDecorator(suite.app.AccountKeeper, nil))
+	_, err = antehandler(suite.ctx, createTx(priv1, 1000000, testdata.NewTestMsg(addr1)), false)
+	suite.Require().NoError(err)
+}
+
+func (suite *AnteTestSuite) TestMsgLimitDecoratorConcurrentCheckTx() {
+	suite.SetupTest(false) // setup
+
+	// the limits apply from the first block on
+	header := ostproto.Header{Height: 1}
+	suite.app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	suite.ctx = suite.app.BaseApp.NewContext(false, header)
+
+	send := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	params := suite.app.AccountKeeper.GetParams(suite.ctx)
+	params.MsgGasLimits = []types.MsgGasLimit{{MsgTypeURL: send, MaxGasPerBlock: 500000}}
+	suite.app.AccountKeeper.SetParams(suite.ctx, params)
+	accounts := suite.CreateTestAccounts(101)
+
+	suite.app.EndBlock(abci.RequestEndBlock{Height: 1})
+	suite.app.Commit()
+	suite.app.BeginRecheckTx(abci.RequestBeginRecheckTx{Header: header})
+	suite.app.EndRecheckTx(abci.RequestEndRecheckTx{})
+
+	createTx := func(acc TestAccount, seq uint64) []byte {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		msg := banktypes.NewMsgSend(acc.acc.GetAddress(), acc.acc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("atom", 1)))
+		suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(100000)
+
+		tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{acc.priv}, []uint64{1}, []uint64{seq}, suite.ctx.ChainID())
+		suite.Require().NoError(err)
+		bz, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+		suite.Require().NoError(err)
+		return bz
+	}
+
+	// a tx rejected after the limit was checked doesn't use any gas
+	res := suite.app.CheckTxSync(abci.RequestCheckTx{Tx: createTx(accounts[0], 1)})
+	suite.Require().Equal(sdkerrors.ErrWrongSequence.ABCICode(), res.Code, res.Log)
+
+	// the txs of different signers are checked concurrently
+	txs := make([][]byte, 0, len(accounts)-1)
+	for _, acc := range accounts[1:] {
+		txs = append(txs, createTx(acc, 0))
+	}
+
+	var wg sync.WaitGroup
+	codes := make(chan uint32, len(txs))
+	for _, tx := range txs {
+		wg.Add(1)
+		suite.app.CheckTxAsync(abci.RequestCheckTx{Tx: tx}, func(res abci.ResponseCheckTx) {
+			codes <- res.Code
+			wg.Done()
+		})
+	}
+	wg.Wait()
+	close(codes)
+
+	// only as many txs as the limit allows are accepted
+	accepted := 0
+	for code := range codes {
+		if code == abci.CodeTypeOK {
+			accepted++
+		} else {
+			suite.Require().Equal(sdkerrors.ErrMsgGasLimitExceeded.ABCICode(), code)
+		}
+	}
+	suite.Require().Equal(5, accepted)
+}
+
+func (suite *AnteTestSuite) TestMsgGasAccumulator() {
+	suite.SetupTest(false) // setup
+
+	send := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	params := suite.app.AccountKeeper.GetParams(suite.ctx)
+	params.MsgGasLimits = []types.MsgGasLimit{{MsgTypeURL: send, MaxGasPerBlock: 500000}}
+	suite.app.AccountKeeper.SetParams(suite.ctx, params)
+
+	acc := ante.NewMsgGasAccumulator(suite.app.AccountKeeper)
+
+	amount, ok := acc.Sub(sdk.Uint64ToBigEndian(300000), nil)
+	suite.Require().True(ok)
+	suite.Require().Equal(sdk.Uint64ToBigEndian(300000), amount)
+	_, ok = acc.Sub(sdk.Uint64ToBigEndian(100000), sdk.Uint64ToBigEndian(200000))
+	suite.Require().False(ok)
+	suite.Require().Equal(sdk.Uint64ToBigEndian(400000), acc.Add(sdk.Uint64ToBigEndian(100000), amount))
+
+	suite.Require().True(acc.WithinLimit(suite.ctx, types.MsgGasUsedKey(send), sdk.Uint64ToBigEndian(500000)))
+	suite.Require().False(acc.WithinLimit(suite.ctx, types.MsgGasUsedKey(send), sdk.Uint64ToBigEndian(500001)))
+	// Msg types without a limit aren't limited
+	suite.Require().True(acc.WithinLimit(suite.ctx, types.MsgGasUsedKey("/testdata.TestMsg"), sdk.Uint64ToBigEndian(500001)))
+}
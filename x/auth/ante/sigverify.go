@@ -10,6 +10,7 @@ import (
 	"github.com/line/lfb-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/line/lfb-sdk/crypto/keys/multisig"
 	"github.com/line/lfb-sdk/crypto/keys/secp256k1"
+	"github.com/line/lfb-sdk/crypto/keys/secp256r1"
 	cryptotypes "github.com/line/lfb-sdk/crypto/types"
 	"github.com/line/lfb-sdk/crypto/types/multisig"
 	sdk "github.com/line/lfb-sdk/types"
@@ -443,6 +444,10 @@ func DefaultSigVerificationGasConsumer(
 		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: secp256k1")
 		return nil
 
+	case *secp256r1.PubKey:
+		meter.ConsumeGas(params.SigVerifyCostSecp256r1, "ante verify: secp256r1")
+		return nil
+
 	case multisig.PubKey:
 		multisignature, ok := sig.Data.(*signing.MultiSignatureData)
 		if !ok {
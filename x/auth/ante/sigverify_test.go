@@ -8,6 +8,7 @@ import (
 	"github.com/line/lfb-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/line/lfb-sdk/crypto/keys/multisig"
 	"github.com/line/lfb-sdk/crypto/keys/secp256k1"
+	"github.com/line/lfb-sdk/crypto/keys/secp256r1"
 	cryptotypes "github.com/line/lfb-sdk/crypto/types"
 	"github.com/line/lfb-sdk/crypto/types/multisig"
 	"github.com/line/lfb-sdk/simapp"
@@ -94,6 +95,7 @@ func (suite *AnteTestSuite) TestConsumeSignatureVerificationGas() {
 	}{
 		{"PubKeyEd25519", args{sdk.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostED25519, true},
 		{"PubKeySecp256k1", args{sdk.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostSecp256k1, false},
+		{"PubKeySecp256r1", args{sdk.NewInfiniteGasMeter(), nil, secp256r1.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostSecp256r1, false},
 		{"Multisig", args{sdk.NewInfiniteGasMeter(), multisignature1, multisigKey1, params}, expectedCost1, false},
 		{"unknown key", args{sdk.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
 	}
@@ -199,7 +201,7 @@ func (suite *AnteTestSuite) TestSigVerification_ExplicitAmino() {
 	suite.clientCtx = client.Context{}.
 		WithTxConfig(txConfig)
 
-	suite.anteHandler = ante.NewAnteHandler(suite.app.AccountKeeper, suite.app.BankKeeper, ante.DefaultSigVerificationGasConsumer, txConfig.SignModeHandler())
+	suite.anteHandler = ante.NewAnteHandler(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.FeeGrantKeeper, suite.app.GetTKey(types.TStoreKey), ante.DefaultSigVerificationGasConsumer, txConfig.SignModeHandler())
 
 	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
 
@@ -286,6 +288,24 @@ func (suite *AnteTestSuite) TestSigIntegration() {
 	suite.Require().Equal(initialSigCost*uint64(len(privs)), doubleCost-initialCost)
 }
 
+func (suite *AnteTestSuite) TestSigIntegrationSecp256r1() {
+	privs := []cryptotypes.PrivKey{
+		secp256r1.GenPrivKey(),
+		secp256k1.GenPrivKey(),
+	}
+
+	params := types.DefaultParams()
+	initialCost, err := suite.runSigDecorators(params, false, privs...)
+	suite.Require().Nil(err)
+
+	params.SigVerifyCostSecp256k1 *= 2
+	params.SigVerifyCostSecp256r1 *= 2
+	doubleCost, err := suite.runSigDecorators(params, false, privs...)
+	suite.Require().Nil(err)
+
+	suite.Require().Equal(types.DefaultSigVerifyCostSecp256r1+types.DefaultSigVerifyCostSecp256k1, doubleCost-initialCost)
+}
+
 func (suite *AnteTestSuite) runSigDecorators(params types.Params, _ bool, privs ...cryptotypes.PrivKey) (sdk.Gas, error) {
 	suite.SetupTest(true) // setup
 	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
@@ -11,7 +11,7 @@ import (
 // CONTRACT: old coins from the FeeCollectionKeeper need to be transferred through
 // a genesis port script to the new fee collector account
 func InitGenesis(ctx sdk.Context, ak keeper.AccountKeeper, data types.GenesisState) {
-	ak.SetParams(ctx, data.Params)
+	ak.SetParams(ctx, data.Params.WithDefaults())
 
 	accounts, err := types.UnpackAccounts(data.Accounts)
 	if err != nil {
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/auth/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper AccountKeeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper AccountKeeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. It sets the MsgGasLimits, MaxTxsPerSigner and
+// SigVerifyCostSecp256r1 parameters, which do not exist in the param store of a chain
+// started with version 1.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if m.keeper.paramSubspace.GetRaw(ctx, types.KeyMsgGasLimits) == nil {
+		m.keeper.paramSubspace.Set(ctx, types.KeyMsgGasLimits, types.DefaultMsgGasLimits)
+	}
+	if m.keeper.paramSubspace.GetRaw(ctx, types.KeyMaxTxsPerSigner) == nil {
+		m.keeper.paramSubspace.Set(ctx, types.KeyMaxTxsPerSigner, types.DefaultMaxTxsPerSigner)
+	}
+	if m.keeper.paramSubspace.GetRaw(ctx, types.KeySigVerifyCostSecp256r1) == nil {
+		m.keeper.paramSubspace.Set(ctx, types.KeySigVerifyCostSecp256r1, types.DefaultSigVerifyCostSecp256r1)
+	}
+	return nil
+}
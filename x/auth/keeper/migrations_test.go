@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/simapp"
+	"github.com/line/lfb-sdk/store/prefix"
+	"github.com/line/lfb-sdk/x/auth/keeper"
+	"github.com/line/lfb-sdk/x/auth/types"
+	paramstypes "github.com/line/lfb-sdk/x/params/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	// given a param store written by version 1, without MsgGasLimits, MaxTxsPerSigner and SigVerifyCostSecp256r1
+	store := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	store.Delete(types.KeyMsgGasLimits)
+	store.Delete(types.KeyMaxTxsPerSigner)
+	store.Delete(types.KeySigVerifyCostSecp256r1)
+
+	// and a keeper with a fresh subspace, as after a node restart
+	cdc, legacyAmino := simapp.MakeCodecs()
+	subspace := paramstypes.NewSubspace(cdc, legacyAmino, app.GetKey(paramstypes.StoreKey), types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+	ak := keeper.NewAccountKeeper(
+		cdc, app.GetKey(types.StoreKey), subspace, types.ProtoBaseAccount, simapp.GetMaccPerms(),
+	)
+	require.Panics(t, func() { ak.GetParams(ctx) })
+
+	// when
+	require.NoError(t, keeper.NewMigrator(ak).Migrate1to2(ctx))
+
+	// then
+	params := ak.GetParams(ctx)
+	require.Equal(t, types.DefaultMsgGasLimits, params.MsgGasLimits)
+	require.Equal(t, types.DefaultMaxTxsPerSigner, params.MaxTxsPerSigner)
+	require.Equal(t, types.DefaultSigVerifyCostSecp256r1, params.SigVerifyCostSecp256r1)
+	require.Equal(t, types.DefaultParams().MaxMemoCharacters, params.MaxMemoCharacters)
+
+	// and params already set are kept
+	params.MaxTxsPerSigner = 10
+	ak.SetParams(ctx, params)
+	require.NoError(t, keeper.NewMigrator(ak).Migrate1to2(ctx))
+	require.Equal(t, uint64(10), ak.GetParams(ctx).MaxTxsPerSigner)
+}
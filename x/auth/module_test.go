@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/line/lfb-sdk/simapp"
+	"github.com/line/lfb-sdk/x/auth"
 	"github.com/line/lfb-sdk/x/auth/types"
 )
 
@@ -25,3 +26,14 @@ func TestItCreatesModuleAccountOnInitBlock(t *testing.T) {
 	acc := app.AccountKeeper.GetAccount(ctx, types.NewModuleAddress(types.FeeCollectorName))
 	require.NotNil(t, acc)
 }
+
+func TestInitGenesisWithoutSigVerifyCostSecp256r1(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, ostproto.Header{})
+
+	genState := types.DefaultGenesisState()
+	genState.Params.SigVerifyCostSecp256r1 = 0
+	auth.InitGenesis(ctx, app.AccountKeeper, *genState)
+
+	require.Equal(t, types.DefaultSigVerifyCostSecp256r1, app.AccountKeeper.GetParams(ctx).SigVerifyCostSecp256r1)
+}
@@ -20,6 +20,7 @@ const (
 	SigVerifyCostED25519   = "sig_verify_cost_ed25519"
 	SigVerifyCostSECP256K1 = "sig_verify_cost_secp256k1"
 	ValidSigBlockPeriod    = "valid_sig_block_period"
+	SigVerifyCostSECP256R1 = "sig_verify_cost_secp256r1"
 )
 
 // RandomGenesisAccounts defines the default RandomGenesisAccountsFn used on the SDK.
@@ -88,6 +89,11 @@ func GenSigVerifyCostSECP256K1(r *rand.Rand) uint64 {
 	return uint64(simulation.RandIntBetween(r, 500, 1000))
 }
 
+// GenSigVerifyCostSECP256R1 randomized SigVerifyCostSECP256R1
+func GenSigVerifyCostSECP256R1(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 750, 1500))
+}
+
 func GenValidSigBlockPeriod(r *rand.Rand) uint64 {
 	return uint64(simulation.RandIntBetween(r, 1, 1000))
 }
@@ -130,8 +136,14 @@ func RandomizedGenState(simState *module.SimulationState, randGenAccountsFn type
 		func(r *rand.Rand) { validSigBlockPeriod = GenValidSigBlockPeriod(r) },
 	)
 
+	var sigVerifyCostSECP256R1 uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SigVerifyCostSECP256R1, &sigVerifyCostSECP256R1, simState.Rand,
+		func(r *rand.Rand) { sigVerifyCostSECP256R1 = GenSigVerifyCostSECP256R1(r) },
+	)
+
 	params := types.NewParams(maxMemoChars, txSigLimit, txSizeCostPerByte,
-		sigVerifyCostED25519, sigVerifyCostSECP256K1, validSigBlockPeriod)
+		sigVerifyCostED25519, sigVerifyCostSECP256K1, validSigBlockPeriod, nil, 0, sigVerifyCostSECP256R1)
 	genesisAccs := randGenAccountsFn(simState)
 
 	authGenesis := types.NewGenesisState(params, genesisAccs)
@@ -7,6 +7,7 @@ import (
 
 	gogogrpc "github.com/gogo/protobuf/grpc"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	ctypes "github.com/line/ostracon/rpc/core/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -139,6 +140,83 @@ func (s txServer) GetTx(ctx context.Context, req *txtypes.GetTxRequest) (*txtype
 	}, nil
 }
 
+// GetBlockWithTxs implements the ServiceServer.GetBlockWithTxs RPC method.
+func (s txServer) GetBlockWithTxs(ctx context.Context, req *txtypes.GetBlockWithTxsRequest) (*txtypes.GetBlockWithTxsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	if req.Height <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "height must be greater than 0")
+	}
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := node.Block(ctx, &req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	blockResults, err := node.BlockResults(ctx, &req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	blockTxs := block.Block.Data.Txs
+	if len(blockResults.TxsResults) != len(blockTxs) {
+		return nil, status.Errorf(codes.Internal, "block %d has %d txs but %d results", req.Height, len(blockTxs), len(blockResults.TxsResults))
+	}
+
+	start, end, pageRes, err := pagination.ParseSlicePagination(req.Pagination, len(blockTxs))
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]*txtypes.Tx, 0, end-start)
+	txResponses := make([]*sdk.TxResponse, 0, end-start)
+	for i := start; i < end; i++ {
+		resTx := &ctypes.ResultTx{
+			Hash:     blockTxs[i].Hash(),
+			Height:   req.Height,
+			Index:    uint32(i),
+			TxResult: *blockResults.TxsResults[i],
+			Tx:       blockTxs[i],
+		}
+
+		txResponse, err := mkTxResult(s.clientCtx.TxConfig, resTx, block)
+		if err != nil {
+			return nil, err
+		}
+
+		protoTx, ok := txResponse.Tx.GetCachedValue().(*txtypes.Tx)
+		if !ok {
+			return nil, status.Errorf(codes.Internal, "expected %T, got %T", txtypes.Tx{}, txResponse.Tx.GetCachedValue())
+		}
+
+		txs = append(txs, protoTx)
+		txResponses = append(txResponses, txResponse)
+	}
+
+	protoBlockID := block.BlockID.ToProto()
+	protoBlock, err := block.Block.ToProto()
+	if err != nil {
+		return nil, err
+	}
+
+	return &txtypes.GetBlockWithTxsResponse{
+		Txs:              txs,
+		TxResponses:      txResponses,
+		BlockId:          &protoBlockID,
+		Block:            protoBlock,
+		BeginBlockEvents: blockResults.BeginBlockEvents,
+		EndBlockEvents:   blockResults.EndBlockEvents,
+		Pagination:       pageRes,
+	}, nil
+}
+
 func (s txServer) BroadcastTx(ctx context.Context, req *txtypes.BroadcastTxRequest) (*txtypes.BroadcastTxResponse, error) {
 	return client.TxServiceBroadcast(ctx, s.clientCtx, req)
 }
@@ -353,6 +353,67 @@ func (s IntegrationTestSuite) TestGetTx_GRPCGateway() {
 	}
 }
 
+func (s IntegrationTestSuite) TestGetBlockWithTxs_GRPC() {
+	testCases := []struct {
+		name      string
+		req       *tx.GetBlockWithTxsRequest
+		expErr    bool
+		expErrMsg string
+		expTxs    int
+		expTotal  uint64
+	}{
+		{"nil request", nil, true, "request cannot be nil", 0, 0},
+		{"empty request", &tx.GetBlockWithTxsRequest{}, true, "height must be greater than 0", 0, 0},
+		{"block with the tx", &tx.GetBlockWithTxsRequest{Height: s.txRes.Height}, false, "", 1, 1},
+		{"pagination past the txs", &tx.GetBlockWithTxsRequest{
+			Height:     s.txRes.Height,
+			Pagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true},
+		}, false, "", 0, 1},
+		{"pagination without total", &tx.GetBlockWithTxsRequest{
+			Height:     s.txRes.Height,
+			Pagination: &query.PageRequest{Limit: 1},
+		}, false, "", 1, 0},
+		{"pagination with key", &tx.GetBlockWithTxsRequest{
+			Height:     s.txRes.Height,
+			Pagination: &query.PageRequest{Key: []byte("key")},
+		}, true, "key is not supported", 0, 0},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			// Query the block with txs using gRPC.
+			grpcRes, err := s.queryClient.GetBlockWithTxs(context.Background(), tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Len(grpcRes.Txs, tc.expTxs)
+				s.Require().Len(grpcRes.TxResponses, tc.expTxs)
+				s.Require().Equal(tc.expTotal, grpcRes.Pagination.Total)
+				s.Require().Equal(s.txRes.Height, grpcRes.Block.Header.Height)
+				if tc.expTxs > 0 {
+					s.Require().Equal("foobar", grpcRes.Txs[0].Body.Memo)
+					s.Require().Equal(s.txRes.TxHash, grpcRes.TxResponses[0].TxHash)
+					s.Require().NotEmpty(grpcRes.TxResponses[0].Timestamp)
+				}
+			}
+		})
+	}
+}
+
+func (s IntegrationTestSuite) TestGetBlockWithTxs_GRPCGateway() {
+	val := s.network.Validators[0]
+	res, err := rest.GetRequest(fmt.Sprintf("%s/lfb/tx/v1beta1/txs/block/%d", val.APIAddress, s.txRes.Height))
+	s.Require().NoError(err)
+
+	var result tx.GetBlockWithTxsResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(res, &result))
+	s.Require().Len(result.Txs, 1)
+	s.Require().Equal("foobar", result.Txs[0].Body.Memo)
+	s.Require().Equal(s.txRes.TxHash, result.TxResponses[0].TxHash)
+}
+
 func (s IntegrationTestSuite) TestBroadcastTx_GRPC() {
 	val := s.network.Validators[0]
 	txBuilder := s.mkTxBuilder()
@@ -113,6 +113,12 @@ type Params struct {
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty" yaml:"sig_verify_cost_ed25519"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty" yaml:"sig_verify_cost_secp256k1"`
 	ValidSigBlockPeriod    uint64 `protobuf:"varint,6,opt,name=valid_sig_block_period,json=validSigBlockPeriod,proto3" json:"valid_sig_block_period,omitempty" yaml:"valid_sig_block_period"`
+	// msg_gas_limits caps the gas the txs including a Msg type can use in a block.
+	MsgGasLimits []MsgGasLimit `protobuf:"bytes,7,rep,name=msg_gas_limits,json=msgGasLimits,proto3" json:"msg_gas_limits" yaml:"msg_gas_limits"`
+	// max_txs_per_signer is the maximum number of txs an account can sign in a
+	// block. 0 means no limit.
+	MaxTxsPerSigner        uint64 `protobuf:"varint,8,opt,name=max_txs_per_signer,json=maxTxsPerSigner,proto3" json:"max_txs_per_signer,omitempty" yaml:"max_txs_per_signer"`
+	SigVerifyCostSecp256r1 uint64 `protobuf:"varint,9,opt,name=sig_verify_cost_secp256r1,json=sigVerifyCostSecp256r1,proto3" json:"sig_verify_cost_secp256r1,omitempty" yaml:"sig_verify_cost_secp256r1"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -189,60 +195,150 @@ func (m *Params) GetValidSigBlockPeriod() uint64 {
 	return 0
 }
 
+func (m *Params) GetMsgGasLimits() []MsgGasLimit {
+	if m != nil {
+		return m.MsgGasLimits
+	}
+	return nil
+}
+
+func (m *Params) GetMaxTxsPerSigner() uint64 {
+	if m != nil {
+		return m.MaxTxsPerSigner
+	}
+	return 0
+}
+
+func (m *Params) GetSigVerifyCostSecp256r1() uint64 {
+	if m != nil {
+		return m.SigVerifyCostSecp256r1
+	}
+	return 0
+}
+
+// MsgGasLimit defines the maximum gas the txs including a Msg type can use in
+// a block, counted by the gas limits of the txs.
+type MsgGasLimit struct {
+	// msg_type_url is the type URL of the Msg, e.g. "/lfb.wasm.v1beta1.MsgStoreCode".
+	MsgTypeURL string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	// max_gas_per_block is the maximum sum of the gas limits of the txs including
+	// the Msg in a block.
+	MaxGasPerBlock uint64 `protobuf:"varint,2,opt,name=max_gas_per_block,json=maxGasPerBlock,proto3" json:"max_gas_per_block,omitempty" yaml:"max_gas_per_block"`
+}
+
+func (m *MsgGasLimit) Reset()         { *m = MsgGasLimit{} }
+func (m *MsgGasLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgGasLimit) ProtoMessage()    {}
+func (*MsgGasLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f89657c3058cd869, []int{3}
+}
+func (m *MsgGasLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGasLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGasLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGasLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGasLimit.Merge(m, src)
+}
+func (m *MsgGasLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGasLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGasLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGasLimit proto.InternalMessageInfo
+
+func (m *MsgGasLimit) GetMsgTypeURL() string {
+	if m != nil {
+		return m.MsgTypeURL
+	}
+	return ""
+}
+
+func (m *MsgGasLimit) GetMaxGasPerBlock() uint64 {
+	if m != nil {
+		return m.MaxGasPerBlock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "lfb.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "lfb.auth.v1beta1.ModuleAccount")
 	proto.RegisterType((*Params)(nil), "lfb.auth.v1beta1.Params")
+	proto.RegisterType((*MsgGasLimit)(nil), "lfb.auth.v1beta1.MsgGasLimit")
 }
 
 func init() { proto.RegisterFile("lfb/auth/v1beta1/auth.proto", fileDescriptor_f89657c3058cd869) }
 
 var fileDescriptor_f89657c3058cd869 = []byte{
-	// 689 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x3d, 0x4f, 0xdb, 0x5a,
-	0x18, 0x8e, 0x2f, 0xb9, 0x01, 0x4e, 0xb8, 0x57, 0x17, 0x93, 0x0b, 0x26, 0x14, 0x3b, 0xb5, 0x3a,
-	0x64, 0x68, 0x6c, 0x25, 0x15, 0x95, 0xc8, 0x80, 0x84, 0x69, 0x07, 0xd4, 0x52, 0x21, 0x47, 0x62,
-	0xa8, 0x5a, 0x59, 0xc7, 0xce, 0x89, 0x39, 0x8a, 0x9d, 0x63, 0x7c, 0x8e, 0x51, 0xcc, 0x2f, 0xe8,
-	0xd8, 0xb1, 0x23, 0x3f, 0x02, 0xa9, 0x43, 0x97, 0x8e, 0x1d, 0x11, 0x53, 0x27, 0xab, 0x0a, 0x4b,
-	0xd5, 0xd1, 0xbf, 0xa0, 0xf2, 0x71, 0x12, 0x02, 0x4a, 0x37, 0x3f, 0x1f, 0xef, 0xf3, 0x7e, 0x58,
-	0x36, 0xd8, 0xf2, 0x7a, 0xb6, 0x0e, 0x23, 0x76, 0xaa, 0x9f, 0x37, 0x6d, 0xc4, 0x60, 0x93, 0x03,
-	0x2d, 0x08, 0x09, 0x23, 0xe2, 0x7f, 0x5e, 0xcf, 0xd6, 0x38, 0x1e, 0x8b, 0xd5, 0x4d, 0x87, 0x50,
-	0x9f, 0x50, 0x8b, 0xeb, 0x7a, 0x0e, 0x72, 0x73, 0xb5, 0xe2, 0x12, 0x97, 0xe4, 0x7c, 0xf6, 0x34,
-	0x66, 0x37, 0x5d, 0x42, 0x5c, 0x0f, 0xe9, 0x1c, 0xd9, 0x51, 0x4f, 0x87, 0x83, 0x38, 0x97, 0xd4,
-	0x2f, 0x02, 0x28, 0x1b, 0x90, 0xa2, 0x7d, 0xc7, 0x21, 0xd1, 0x80, 0x89, 0x12, 0x58, 0x84, 0xdd,
-	0x6e, 0x88, 0x28, 0x95, 0x84, 0x9a, 0x50, 0x5f, 0x36, 0x27, 0x50, 0x7c, 0x07, 0x16, 0x83, 0xc8,
-	0xb6, 0xfa, 0x28, 0x96, 0xfe, 0xaa, 0x09, 0xf5, 0x72, 0xab, 0xa2, 0xe5, 0xb1, 0xda, 0x24, 0x56,
-	0xdb, 0x1f, 0xc4, 0x46, 0xe3, 0x57, 0xa2, 0x54, 0x82, 0xc8, 0xf6, 0xb0, 0x93, 0x79, 0x9f, 0x12,
-	0x1f, 0x33, 0xe4, 0x07, 0x2c, 0x4e, 0x13, 0x65, 0x35, 0x86, 0xbe, 0xd7, 0x56, 0xef, 0x54, 0xd5,
-	0x2c, 0x05, 0x91, 0xfd, 0x0a, 0xc5, 0x62, 0x15, 0x2c, 0x51, 0x74, 0x16, 0xa1, 0x81, 0x83, 0xa4,
-	0x85, 0x9a, 0x50, 0x2f, 0x9a, 0x53, 0xdc, 0x96, 0x3e, 0x5c, 0x2a, 0x85, 0x4f, 0x97, 0x4a, 0xe1,
-	0xe7, 0xa5, 0x52, 0xb8, 0xb9, 0x6a, 0x2c, 0x8d, 0x87, 0x3d, 0x54, 0xbf, 0x0a, 0xe0, 0x9f, 0x23,
-	0xd2, 0x8d, 0xbc, 0xe9, 0xfc, 0xef, 0xc1, 0x8a, 0x0d, 0x29, 0xb2, 0x60, 0x8e, 0xf9, 0x12, 0xe5,
-	0xd6, 0xb6, 0xf6, 0xf0, 0x88, 0xda, 0xcc, 0xd2, 0xc6, 0xd6, 0x75, 0xa2, 0x08, 0x69, 0xa2, 0xac,
-	0xe5, 0xf3, 0xcd, 0x06, 0xa8, 0x66, 0xd9, 0x9e, 0x39, 0x8f, 0x08, 0x8a, 0x03, 0xe8, 0x23, 0x7e,
-	0x81, 0x65, 0x93, 0x3f, 0x8b, 0x35, 0x50, 0x0e, 0x50, 0xe8, 0x63, 0x4a, 0x31, 0x19, 0x50, 0x69,
-	0xa1, 0xb6, 0x50, 0x5f, 0x36, 0x67, 0xa9, 0x76, 0x75, 0xb2, 0xc0, 0xcd, 0x55, 0xe3, 0xdf, 0x7b,
-	0xf3, 0x1e, 0xaa, 0x9f, 0x8b, 0xa0, 0x74, 0x0c, 0x43, 0xe8, 0x53, 0xf1, 0x0d, 0x58, 0xf3, 0xe1,
-	0xd0, 0xf2, 0x91, 0x4f, 0x2c, 0xe7, 0x14, 0x86, 0xd0, 0x61, 0x28, 0xcc, 0xdf, 0x43, 0xd1, 0x90,
-	0xd3, 0x44, 0xa9, 0xe6, 0xf3, 0xcd, 0x31, 0xa9, 0xe6, 0xaa, 0x0f, 0x87, 0x47, 0xc8, 0x27, 0x07,
-	0x53, 0x4e, 0xdc, 0x05, 0x2b, 0x6c, 0x68, 0x51, 0xec, 0x5a, 0x1e, 0xf6, 0x31, 0xe3, 0x43, 0x17,
-	0x8d, 0x8d, 0xbb, 0x45, 0x67, 0x55, 0xd5, 0x04, 0x6c, 0xd8, 0xc1, 0xee, 0xeb, 0x0c, 0x88, 0x26,
-	0xf8, 0x9f, 0x8b, 0x17, 0xc8, 0x72, 0x08, 0x65, 0x56, 0x80, 0x42, 0xcb, 0x8e, 0xd9, 0xf8, 0xdd,
-	0x18, 0xb5, 0x34, 0x51, 0x1e, 0xcd, 0x64, 0x3c, 0xb4, 0xa9, 0xe6, 0x6a, 0x16, 0x76, 0x81, 0x0e,
-	0x08, 0x65, 0xc7, 0x28, 0x34, 0x62, 0x86, 0xc4, 0x33, 0xb0, 0x91, 0x75, 0x3b, 0x47, 0x21, 0xee,
-	0xc5, 0xb9, 0x1f, 0x75, 0x5b, 0x3b, 0x3b, 0xcd, 0x5d, 0xa9, 0xc8, 0x53, 0xdb, 0xa3, 0x44, 0xa9,
-	0x74, 0xb0, 0x7b, 0xc2, 0x1d, 0x59, 0xe9, 0xcb, 0x17, 0x5c, 0x4f, 0x13, 0x45, 0xce, 0xbb, 0xfd,
-	0x21, 0x40, 0x35, 0x2b, 0xf4, 0x5e, 0x5d, 0x4e, 0x8b, 0x31, 0xd8, 0x7c, 0x58, 0x41, 0x91, 0x13,
-	0xb4, 0x76, 0x9e, 0xf7, 0x9b, 0xd2, 0xdf, 0xbc, 0xe9, 0xde, 0x28, 0x51, 0xd6, 0xef, 0x35, 0xed,
-	0x4c, 0x1c, 0x69, 0xa2, 0xd4, 0xe6, 0xb7, 0x9d, 0x86, 0xa8, 0xe6, 0x3a, 0x9d, 0x5b, 0x2b, 0x9e,
-	0x80, 0xf5, 0x73, 0xe8, 0xe1, 0x2e, 0xbf, 0xb0, 0xed, 0x11, 0xa7, 0x9f, 0x5d, 0x07, 0x93, 0xae,
-	0x54, 0xe2, 0x7d, 0x1f, 0xa7, 0x89, 0xb2, 0x9d, 0xa7, 0xcf, 0xf7, 0xa9, 0xe6, 0x1a, 0x17, 0x3a,
-	0xd8, 0x35, 0x32, 0xfa, 0x98, 0xb3, 0xed, 0xa5, 0xf1, 0x87, 0x20, 0x18, 0x7b, 0xdf, 0x46, 0xb2,
-	0x70, 0x3d, 0x92, 0x85, 0x1f, 0x23, 0x59, 0xf8, 0x78, 0x2b, 0x17, 0xae, 0x6f, 0xe5, 0xc2, 0xf7,
-	0x5b, 0xb9, 0xf0, 0xf6, 0x89, 0x8b, 0xd9, 0x69, 0x64, 0x6b, 0x0e, 0xf1, 0x75, 0x0f, 0x0f, 0x90,
-	0xee, 0xf5, 0xec, 0x06, 0xed, 0xf6, 0xf5, 0x61, 0xfe, 0x97, 0x61, 0x71, 0x80, 0xa8, 0x5d, 0xe2,
-	0xdf, 0xed, 0xb3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x78, 0x3d, 0x9d, 0xdb, 0x7e, 0x04, 0x00,
-	0x00,
+	// 871 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0x3f, 0x6f, 0xdb, 0x46,
+	0x14, 0x17, 0x6b, 0x55, 0xb1, 0x4f, 0xae, 0x5b, 0xd3, 0x8a, 0x43, 0x2b, 0xb1, 0x4e, 0x3d, 0xb4,
+	0x80, 0x87, 0x5a, 0x82, 0x5c, 0xb8, 0x40, 0x34, 0x04, 0x08, 0xd3, 0xc2, 0x48, 0x1b, 0x17, 0xc6,
+	0x29, 0xcd, 0x50, 0xb4, 0x20, 0x8e, 0xd4, 0x89, 0x26, 0xcc, 0xd3, 0x31, 0x77, 0x47, 0x83, 0xcc,
+	0x27, 0xe8, 0xd8, 0xb1, 0xa3, 0x97, 0x7e, 0x83, 0x6c, 0x5d, 0x3a, 0x66, 0x34, 0x32, 0x75, 0x22,
+	0x0a, 0x79, 0x29, 0x3a, 0xf2, 0x13, 0x14, 0x3c, 0xca, 0x96, 0x64, 0x28, 0xe9, 0xc6, 0xf7, 0xde,
+	0xef, 0x7e, 0xef, 0xcf, 0xef, 0xdd, 0x11, 0xdc, 0x0f, 0x47, 0x6e, 0x97, 0xc4, 0xea, 0xb4, 0x7b,
+	0xde, 0x73, 0xa9, 0x22, 0x3d, 0x6d, 0x74, 0x22, 0xc1, 0x15, 0x37, 0x3f, 0x09, 0x47, 0x6e, 0x47,
+	0xdb, 0xd3, 0x60, 0x73, 0xc7, 0xe3, 0x92, 0x71, 0xe9, 0xe8, 0x78, 0xb7, 0x34, 0x4a, 0x70, 0xb3,
+	0xe1, 0x73, 0x9f, 0x97, 0xfe, 0xe2, 0x6b, 0xea, 0xdd, 0xf1, 0x39, 0xf7, 0x43, 0xda, 0xd5, 0x96,
+	0x1b, 0x8f, 0xba, 0x64, 0x9c, 0x96, 0x21, 0xf4, 0x87, 0x01, 0xea, 0x36, 0x91, 0xf4, 0xb1, 0xe7,
+	0xf1, 0x78, 0xac, 0x4c, 0x0b, 0xdc, 0x21, 0xc3, 0xa1, 0xa0, 0x52, 0x5a, 0x46, 0xdb, 0xd8, 0x5b,
+	0xc3, 0xd7, 0xa6, 0xf9, 0x13, 0xb8, 0x13, 0xc5, 0xae, 0x73, 0x46, 0x53, 0xeb, 0x83, 0xb6, 0xb1,
+	0x57, 0x3f, 0x68, 0x74, 0x4a, 0xda, 0xce, 0x35, 0x6d, 0xe7, 0xf1, 0x38, 0xb5, 0xf7, 0xff, 0xcd,
+	0x60, 0x23, 0x8a, 0xdd, 0x30, 0xf0, 0x0a, 0xec, 0x17, 0x9c, 0x05, 0x8a, 0xb2, 0x48, 0xa5, 0x79,
+	0x06, 0x37, 0x53, 0xc2, 0xc2, 0x3e, 0x9a, 0x45, 0x11, 0xae, 0x45, 0xb1, 0xfb, 0x1d, 0x4d, 0xcd,
+	0x26, 0x58, 0x95, 0xf4, 0x65, 0x4c, 0xc7, 0x1e, 0xb5, 0x56, 0xda, 0xc6, 0x5e, 0x15, 0xdf, 0xd8,
+	0x7d, 0xeb, 0x97, 0x0b, 0x58, 0xf9, 0xed, 0x02, 0x56, 0xfe, 0xb9, 0x80, 0x95, 0xb7, 0xaf, 0xf7,
+	0x57, 0xa7, 0xc5, 0x3e, 0x45, 0x7f, 0x1a, 0xe0, 0xa3, 0x63, 0x3e, 0x8c, 0xc3, 0x9b, 0xfa, 0x7f,
+	0x06, 0xeb, 0x2e, 0x91, 0xd4, 0x21, 0xa5, 0xad, 0x9b, 0xa8, 0x1f, 0xec, 0x76, 0x6e, 0x0f, 0xb1,
+	0x33, 0xd7, 0xb4, 0x7d, 0xff, 0x32, 0x83, 0x46, 0x9e, 0xc1, 0xad, 0xb2, 0xbe, 0x79, 0x02, 0x84,
+	0xeb, 0xee, 0xdc, 0x78, 0x4c, 0x50, 0x1d, 0x13, 0x46, 0xf5, 0x04, 0xd6, 0xb0, 0xfe, 0x36, 0xdb,
+	0xa0, 0x1e, 0x51, 0xc1, 0x02, 0x29, 0x03, 0x3e, 0x96, 0xd6, 0x4a, 0x7b, 0x65, 0x6f, 0x0d, 0xcf,
+	0xbb, 0xfa, 0xcd, 0xeb, 0x06, 0xde, 0xbe, 0xde, 0xdf, 0x58, 0xa8, 0xf7, 0x29, 0x9a, 0xd4, 0x40,
+	0xed, 0x84, 0x08, 0xc2, 0xa4, 0xf9, 0x3d, 0xd8, 0x62, 0x24, 0x71, 0x18, 0x65, 0xdc, 0xf1, 0x4e,
+	0x89, 0x20, 0x9e, 0xa2, 0xa2, 0xd4, 0xa1, 0x6a, 0xb7, 0xf2, 0x0c, 0x36, 0xcb, 0xfa, 0x96, 0x80,
+	0x10, 0xde, 0x64, 0x24, 0x39, 0xa6, 0x8c, 0x3f, 0xb9, 0xf1, 0x99, 0x0f, 0xc1, 0xba, 0x4a, 0x1c,
+	0x19, 0xf8, 0x4e, 0x18, 0xb0, 0x40, 0xe9, 0xa2, 0xab, 0xf6, 0xbd, 0x59, 0xa3, 0xf3, 0x51, 0x84,
+	0x81, 0x4a, 0x06, 0x81, 0xff, 0xac, 0x30, 0x4c, 0x0c, 0xee, 0xea, 0xe0, 0x2b, 0xea, 0x78, 0x5c,
+	0x2a, 0x27, 0xa2, 0xc2, 0x71, 0x53, 0x35, 0xd5, 0xc6, 0x6e, 0xe7, 0x19, 0x7c, 0x30, 0xc7, 0x71,
+	0x1b, 0x86, 0xf0, 0x66, 0x41, 0xf6, 0x8a, 0x3e, 0xe1, 0x52, 0x9d, 0x50, 0x61, 0xa7, 0x8a, 0x9a,
+	0x2f, 0xc1, 0xbd, 0x22, 0xdb, 0x39, 0x15, 0xc1, 0x28, 0x2d, 0xf1, 0x74, 0x78, 0x70, 0x78, 0xd8,
+	0x7b, 0x68, 0x55, 0x35, 0x6b, 0x7f, 0x92, 0xc1, 0xc6, 0x20, 0xf0, 0x5f, 0x68, 0x44, 0x71, 0xf4,
+	0x9b, 0xaf, 0x75, 0x3c, 0xcf, 0x60, 0xab, 0xcc, 0xf6, 0x0e, 0x02, 0x84, 0x1b, 0x72, 0xe1, 0x5c,
+	0xe9, 0x36, 0x53, 0xb0, 0x73, 0xfb, 0x84, 0xa4, 0x5e, 0x74, 0x70, 0xf8, 0xd5, 0x59, 0xcf, 0xfa,
+	0x50, 0x27, 0x7d, 0x34, 0xc9, 0xe0, 0xf6, 0x42, 0xd2, 0xc1, 0x35, 0x22, 0xcf, 0x60, 0x7b, 0x79,
+	0xda, 0x1b, 0x12, 0x84, 0xb7, 0xe5, 0xd2, 0xb3, 0xe6, 0x0b, 0xb0, 0x7d, 0x4e, 0xc2, 0x60, 0xa8,
+	0x27, 0xec, 0x86, 0xdc, 0x3b, 0x2b, 0xa6, 0x13, 0xf0, 0xa1, 0x55, 0xd3, 0x79, 0x3f, 0xcd, 0x33,
+	0xb8, 0x5b, 0xb2, 0x2f, 0xc7, 0x21, 0xbc, 0xa5, 0x03, 0x83, 0xc0, 0xb7, 0x0b, 0xf7, 0x89, 0xf6,
+	0x9a, 0x2e, 0xd8, 0x60, 0xd2, 0x77, 0x7c, 0x22, 0x4b, 0xdd, 0xa4, 0x75, 0xa7, 0xbd, 0xb2, 0x7c,
+	0xc5, 0x8f, 0xa5, 0x7f, 0x44, 0xa4, 0x16, 0xd4, 0xde, 0x7d, 0x93, 0xc1, 0x4a, 0x9e, 0xc1, 0xbb,
+	0xd3, 0x15, 0x5a, 0xa0, 0x40, 0x78, 0x9d, 0xcd, 0xb0, 0xd2, 0xfc, 0x16, 0x98, 0xc5, 0x8e, 0xa9,
+	0x44, 0x6a, 0x45, 0x65, 0xe0, 0x8f, 0xa9, 0xb0, 0x56, 0x75, 0xdd, 0xbb, 0x79, 0x06, 0x77, 0x66,
+	0x7b, 0xb8, 0x88, 0x41, 0xf8, 0x63, 0x46, 0x92, 0xe7, 0x89, 0x3c, 0xa1, 0x62, 0xa0, 0x3d, 0xef,
+	0x91, 0x40, 0xf4, 0xac, 0xb5, 0xf7, 0x4b, 0x20, 0xfe, 0x5f, 0x02, 0xf1, 0x2e, 0x09, 0x44, 0xaf,
+	0xbf, 0x3a, 0x7d, 0x33, 0x0c, 0xf4, 0xbb, 0x01, 0xea, 0x73, 0xd3, 0x30, 0x8f, 0x40, 0xd1, 0xb0,
+	0xa3, 0xd2, 0x88, 0x3a, 0xb1, 0x08, 0xcb, 0xa7, 0xce, 0xfe, 0x7c, 0x92, 0x41, 0x70, 0x2c, 0xfd,
+	0xe7, 0x69, 0x44, 0x7f, 0xc0, 0xcf, 0x66, 0xf7, 0x64, 0x1e, 0x8b, 0x30, 0x60, 0x53, 0x88, 0x08,
+	0xcd, 0x23, 0x50, 0xdc, 0x3b, 0x3d, 0x4a, 0xbd, 0xfb, 0x85, 0x50, 0xd3, 0x7b, 0xf6, 0x20, 0xcf,
+	0xa0, 0x35, 0x1b, 0xd4, 0x02, 0x04, 0xe1, 0x0d, 0x46, 0x92, 0x23, 0x52, 0xcc, 0x49, 0x8b, 0xdb,
+	0xaf, 0x16, 0x75, 0xda, 0x8f, 0xde, 0x4c, 0x5a, 0xc6, 0xe5, 0xa4, 0x65, 0xfc, 0x3d, 0x69, 0x19,
+	0xbf, 0x5e, 0xb5, 0x2a, 0x97, 0x57, 0xad, 0xca, 0x5f, 0x57, 0xad, 0xca, 0x8f, 0x9f, 0xf9, 0x81,
+	0x3a, 0x8d, 0xdd, 0x8e, 0xc7, 0x59, 0x37, 0x0c, 0xc6, 0xb4, 0x1b, 0x8e, 0xdc, 0x7d, 0x39, 0x3c,
+	0xeb, 0x26, 0xe5, 0x8f, 0xa3, 0x28, 0x4f, 0xba, 0x35, 0xfd, 0x14, 0x7f, 0xf9, 0xdf, 0x00, 0xa6,
+	0xde, 0x23, 0x37, 0x51, 0x06, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -282,6 +378,47 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.ValidSigBlockPeriod != that1.ValidSigBlockPeriod {
 		return false
 	}
+	if len(this.MsgGasLimits) != len(that1.MsgGasLimits) {
+		return false
+	}
+	for i := range this.MsgGasLimits {
+		if !this.MsgGasLimits[i].Equal(&that1.MsgGasLimits[i]) {
+			return false
+		}
+	}
+	if this.MaxTxsPerSigner != that1.MaxTxsPerSigner {
+		return false
+	}
+	if this.SigVerifyCostSecp256r1 != that1.SigVerifyCostSecp256r1 {
+		return false
+	}
+	return true
+}
+func (this *MsgGasLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgGasLimit)
+	if !ok {
+		that2, ok := that.(MsgGasLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MsgTypeURL != that1.MsgTypeURL {
+		return false
+	}
+	if this.MaxGasPerBlock != that1.MaxGasPerBlock {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -402,6 +539,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SigVerifyCostSecp256r1 != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256r1))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxTxsPerSigner != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.MaxTxsPerSigner))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.MsgGasLimits) > 0 {
+		for iNdEx := len(m.MsgGasLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgGasLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.ValidSigBlockPeriod != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.ValidSigBlockPeriod))
 		i--
@@ -435,6 +596,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgGasLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGasLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGasLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGasPerBlock != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.MaxGasPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeURL) > 0 {
+		i -= len(m.MsgTypeURL)
+		copy(dAtA[i:], m.MsgTypeURL)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.MsgTypeURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuth(v)
 	base := offset
@@ -513,6 +709,34 @@ func (m *Params) Size() (n int) {
 	if m.ValidSigBlockPeriod != 0 {
 		n += 1 + sovAuth(uint64(m.ValidSigBlockPeriod))
 	}
+	if len(m.MsgGasLimits) > 0 {
+		for _, e := range m.MsgGasLimits {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.MaxTxsPerSigner != 0 {
+		n += 1 + sovAuth(uint64(m.MaxTxsPerSigner))
+	}
+	if m.SigVerifyCostSecp256r1 != 0 {
+		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256r1))
+	}
+	return n
+}
+
+func (m *MsgGasLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeURL)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.MaxGasPerBlock != 0 {
+		n += 1 + sovAuth(uint64(m.MaxGasPerBlock))
+	}
 	return n
 }
 
@@ -952,6 +1176,179 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgGasLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgGasLimits = append(m.MsgGasLimits, MsgGasLimit{})
+			if err := m.MsgGasLimits[len(m.MsgGasLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxsPerSigner", wireType)
+			}
+			m.MaxTxsPerSigner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxsPerSigner |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostSecp256r1", wireType)
+			}
+			m.SigVerifyCostSecp256r1 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostSecp256r1 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGasLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGasLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGasLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerBlock", wireType)
+			}
+			m.MaxGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -61,7 +61,7 @@ func GetGenesisStateFromAppState(cdc codec.Marshaler, appState map[string]json.R
 // ValidateGenesis performs basic validation of auth genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
-	if err := data.Params.Validate(); err != nil {
+	if err := data.Params.WithDefaults().Validate(); err != nil {
 		return err
 	}
 
@@ -127,3 +127,12 @@ func TestPackAccountsAny(t *testing.T) {
 		})
 	}
 }
+
+// genesis exported before SigVerifyCostSecp256r1 was added is still valid
+func TestValidateGenesisWithoutSigVerifyCostSecp256r1(t *testing.T) {
+	genState := types.DefaultGenesisState()
+	genState.Params.SigVerifyCostSecp256r1 = 0
+	require.NoError(t, types.ValidateGenesis(*genState))
+
+	require.Equal(t, types.DefaultSigVerifyCostSecp256r1, genState.Params.WithDefaults().SigVerifyCostSecp256r1)
+}
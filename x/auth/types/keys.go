@@ -11,6 +11,10 @@ const (
 	// StoreKey is string representation of the store key for auth
 	StoreKey = "acc"
 
+	// TStoreKey is string representation of the transient store key for auth,
+	// which tracks the usage of the per-block limits of the params
+	TStoreKey = "transient_" + ModuleName
+
 	// RouterKey defines the module's message routing key
 	RouterKey = ModuleName
 
@@ -24,9 +28,27 @@ const (
 var (
 	// AddressStoreKeyPrefix prefix for account-by-address store
 	AddressStoreKeyPrefix = []byte{0x01}
+
+	// MsgGasUsedKeyPrefix prefix for the gas used by Msg type in the transient store
+	MsgGasUsedKeyPrefix = []byte{0x01}
+
+	// SignerTxCountKeyPrefix prefix for the tx count by signer in the transient store
+	SignerTxCountKeyPrefix = []byte{0x02}
 )
 
 // AddressStoreKey turn an address to key used to get it from the account store
 func AddressStoreKey(addr sdk.AccAddress) []byte {
 	return append(AddressStoreKeyPrefix, addr.Bytes()...)
 }
+
+// MsgGasUsedKey returns the transient store key of the gas used by the txs
+// including a Msg type in the current block
+func MsgGasUsedKey(msgTypeURL string) []byte {
+	return append(MsgGasUsedKeyPrefix, []byte(msgTypeURL)...)
+}
+
+// SignerTxCountKey returns the transient store key of the number of txs signed
+// by an address in the current block
+func SignerTxCountKey(addr sdk.AccAddress) []byte {
+	return append(SignerTxCountKeyPrefix, addr.Bytes()...)
+}
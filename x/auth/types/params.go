@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	yaml "gopkg.in/yaml.v2"
 
@@ -16,8 +17,13 @@ const (
 	DefaultSigVerifyCostED25519   uint64 = 590
 	DefaultSigVerifyCostSecp256k1 uint64 = 1000
 	DefaultValidSigBlockPeriod    uint64 = 100
+	DefaultMaxTxsPerSigner        uint64 = 0
+	DefaultSigVerifyCostSecp256r1 uint64 = 1500
 )
 
+// DefaultMsgGasLimits is the default value of MsgGasLimits, which limits no Msg type.
+var DefaultMsgGasLimits []MsgGasLimit = nil
+
 // Parameter keys
 var (
 	KeyMaxMemoCharacters      = []byte("MaxMemoCharacters")
@@ -26,6 +32,9 @@ var (
 	KeySigVerifyCostED25519   = []byte("SigVerifyCostED25519")
 	KeySigVerifyCostSecp256k1 = []byte("SigVerifyCostSecp256k1")
 	KeyValidSigBlockPeriod    = []byte("ValidSigBlockPeriod")
+	KeyMsgGasLimits           = []byte("MsgGasLimits")
+	KeyMaxTxsPerSigner        = []byte("MaxTxsPerSigner")
+	KeySigVerifyCostSecp256r1 = []byte("SigVerifyCostSecp256r1")
 )
 
 var _ paramtypes.ParamSet = &Params{}
@@ -33,7 +42,7 @@ var _ paramtypes.ParamSet = &Params{}
 // NewParams creates a new Params object
 func NewParams(
 	maxMemoCharacters, txSigLimit, txSizeCostPerByte, sigVerifyCostED25519, sigVerifyCostSecp256k1,
-	validSigBlockPeriod uint64,
+	validSigBlockPeriod uint64, msgGasLimits []MsgGasLimit, maxTxsPerSigner, sigVerifyCostSecp256r1 uint64,
 ) Params {
 	return Params{
 		MaxMemoCharacters:      maxMemoCharacters,
@@ -42,6 +51,9 @@ func NewParams(
 		SigVerifyCostED25519:   sigVerifyCostED25519,
 		SigVerifyCostSecp256k1: sigVerifyCostSecp256k1,
 		ValidSigBlockPeriod:    validSigBlockPeriod,
+		MsgGasLimits:           msgGasLimits,
+		MaxTxsPerSigner:        maxTxsPerSigner,
+		SigVerifyCostSecp256r1: sigVerifyCostSecp256r1,
 	}
 }
 
@@ -61,6 +73,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeySigVerifyCostED25519, &p.SigVerifyCostED25519, validateSigVerifyCostED25519),
 		paramtypes.NewParamSetPair(KeySigVerifyCostSecp256k1, &p.SigVerifyCostSecp256k1, validateSigVerifyCostSecp256k1),
 		paramtypes.NewParamSetPair(KeyValidSigBlockPeriod, &p.ValidSigBlockPeriod, validateValidSigBlockPeriod),
+		paramtypes.NewParamSetPair(KeyMsgGasLimits, &p.MsgGasLimits, validateMsgGasLimits),
+		paramtypes.NewParamSetPair(KeyMaxTxsPerSigner, &p.MaxTxsPerSigner, validateMaxTxsPerSigner),
+		paramtypes.NewParamSetPair(KeySigVerifyCostSecp256r1, &p.SigVerifyCostSecp256r1, validateSigVerifyCostSecp256r1),
 	}
 }
 
@@ -73,7 +88,31 @@ func DefaultParams() Params {
 		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
 		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
 		ValidSigBlockPeriod:    DefaultValidSigBlockPeriod,
+		MsgGasLimits:           DefaultMsgGasLimits,
+		MaxTxsPerSigner:        DefaultMaxTxsPerSigner,
+		SigVerifyCostSecp256r1: DefaultSigVerifyCostSecp256r1,
+	}
+}
+
+// MaxGasPerBlock returns the maximum gas the txs including the given Msg type
+// can use in a block, and whether the Msg type is limited at all.
+func (p Params) MaxGasPerBlock(msgTypeURL string) (uint64, bool) {
+	for _, l := range p.MsgGasLimits {
+		if l.MsgTypeURL == msgTypeURL {
+			return l.MaxGasPerBlock, true
+		}
 	}
+
+	return 0, false
+}
+
+// WithDefaults returns the params with the default value set for the params which are
+// zero in genesis files exported before they were added.
+func (p Params) WithDefaults() Params {
+	if p.SigVerifyCostSecp256r1 == 0 {
+		p.SigVerifyCostSecp256r1 = DefaultSigVerifyCostSecp256r1
+	}
+	return p
 }
 
 // String implements the stringer interface.
@@ -121,6 +160,19 @@ func validateSigVerifyCostSecp256k1(i interface{}) error {
 	return nil
 }
 
+func validateSigVerifyCostSecp256r1(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("invalid secp256r1 signature verification cost: %d", v)
+	}
+
+	return nil
+}
+
 func validateValidSigBlockPeriod(i interface{}) error {
 	v, ok := i.(uint64)
 	if !ok {
@@ -160,6 +212,38 @@ func validateTxSizeCostPerByte(i interface{}) error {
 	return nil
 }
 
+func validateMsgGasLimits(i interface{}) error {
+	v, ok := i.([]MsgGasLimit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, l := range v {
+		if !strings.HasPrefix(l.MsgTypeURL, "/") {
+			return fmt.Errorf("invalid msg type url: %q", l.MsgTypeURL)
+		}
+		if seen[l.MsgTypeURL] {
+			return fmt.Errorf("duplicate msg gas limit: %s", l.MsgTypeURL)
+		}
+		seen[l.MsgTypeURL] = true
+
+		if l.MaxGasPerBlock == 0 {
+			return fmt.Errorf("invalid max gas per block of %s: %d", l.MsgTypeURL, l.MaxGasPerBlock)
+		}
+	}
+
+	return nil
+}
+
+func validateMaxTxsPerSigner(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
 	if err := validateTxSigLimit(p.TxSigLimit); err != nil {
@@ -180,5 +264,14 @@ func (p Params) Validate() error {
 	if err := validateValidSigBlockPeriod(p.ValidSigBlockPeriod); err != nil {
 		return err
 	}
+	if err := validateMsgGasLimits(p.MsgGasLimits); err != nil {
+		return err
+	}
+	if err := validateMaxTxsPerSigner(p.MaxTxsPerSigner); err != nil {
+		return err
+	}
+	if err := validateSigVerifyCostSecp256r1(p.SigVerifyCostSecp256r1); err != nil {
+		return err
+	}
 	return nil
 }
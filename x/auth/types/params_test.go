@@ -26,17 +26,33 @@ func TestParams_Validate(t *testing.T) {
 	}{
 		{"default params", types.DefaultParams(), nil},
 		{"invalid tx signature limit", types.NewParams(types.DefaultMaxMemoCharacters, 0, types.DefaultTxSizeCostPerByte,
-			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultValidSigBlockPeriod), fmt.Errorf("invalid tx signature limit: 0")},
+			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultValidSigBlockPeriod, nil, 0, types.DefaultSigVerifyCostSecp256r1), fmt.Errorf("invalid tx signature limit: 0")},
 		{"invalid ED25519 signature verification cost", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
-			0, types.DefaultSigVerifyCostSecp256k1, types.DefaultValidSigBlockPeriod), fmt.Errorf("invalid ED25519 signature verification cost: 0")},
+			0, types.DefaultSigVerifyCostSecp256k1, types.DefaultValidSigBlockPeriod, nil, 0, types.DefaultSigVerifyCostSecp256r1), fmt.Errorf("invalid ED25519 signature verification cost: 0")},
 		{"invalid SECK256k1 signature verification cost", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
-			types.DefaultSigVerifyCostED25519, 0, types.DefaultValidSigBlockPeriod), fmt.Errorf("invalid SECK256k1 signature verification cost: 0")},
+			types.DefaultSigVerifyCostED25519, 0, types.DefaultValidSigBlockPeriod, nil, 0, types.DefaultSigVerifyCostSecp256r1), fmt.Errorf("invalid SECK256k1 signature verification cost: 0")},
 		{"invalid max memo characters", types.NewParams(0, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
-			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultValidSigBlockPeriod), fmt.Errorf("invalid max memo characters: 0")},
+			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultValidSigBlockPeriod, nil, 0, types.DefaultSigVerifyCostSecp256r1), fmt.Errorf("invalid max memo characters: 0")},
 		{"invalid tx size cost per byte", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 0,
-			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultValidSigBlockPeriod), fmt.Errorf("invalid tx size cost per byte: 0")},
+			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultValidSigBlockPeriod, nil, 0, types.DefaultSigVerifyCostSecp256r1), fmt.Errorf("invalid tx size cost per byte: 0")},
 		{"invalid valid sig block period", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
-			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, 0), fmt.Errorf("invalid validSigBlockPeriod: 0")},
+			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, 0, nil, 0, types.DefaultSigVerifyCostSecp256r1), fmt.Errorf("invalid validSigBlockPeriod: 0")},
+		{"msg gas limits", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
+			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultValidSigBlockPeriod,
+			[]types.MsgGasLimit{{MsgTypeURL: "/lfb.bank.v1beta1.MsgSend", MaxGasPerBlock: 1000000}}, 10, types.DefaultSigVerifyCostSecp256r1), nil},
+		{"invalid msg type url", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
+			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultValidSigBlockPeriod,
+			[]types.MsgGasLimit{{MsgTypeURL: "MsgSend", MaxGasPerBlock: 1000000}}, 0, types.DefaultSigVerifyCostSecp256r1), fmt.Errorf("invalid msg type url: \"MsgSend\"")},
+		{"duplicate msg gas limit", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
+			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultValidSigBlockPeriod,
+			[]types.MsgGasLimit{{MsgTypeURL: "/lfb.bank.v1beta1.MsgSend", MaxGasPerBlock: 1}, {MsgTypeURL: "/lfb.bank.v1beta1.MsgSend", MaxGasPerBlock: 2}}, 0, types.DefaultSigVerifyCostSecp256r1),
+			fmt.Errorf("duplicate msg gas limit: /lfb.bank.v1beta1.MsgSend")},
+		{"invalid max gas per block", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
+			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultValidSigBlockPeriod,
+			[]types.MsgGasLimit{{MsgTypeURL: "/lfb.bank.v1beta1.MsgSend"}}, 0, types.DefaultSigVerifyCostSecp256r1), fmt.Errorf("invalid max gas per block of /lfb.bank.v1beta1.MsgSend: 0")},
+		{"invalid secp256r1 signature verification cost", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
+			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultValidSigBlockPeriod, nil, 0, 0),
+			fmt.Errorf("invalid secp256r1 signature verification cost: 0")},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -50,3 +66,15 @@ func TestParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestParamsMaxGasPerBlock(t *testing.T) {
+	params := types.DefaultParams()
+	params.MsgGasLimits = []types.MsgGasLimit{{MsgTypeURL: "/lfb.bank.v1beta1.MsgSend", MaxGasPerBlock: 1000000}}
+
+	max, ok := params.MaxGasPerBlock("/lfb.bank.v1beta1.MsgSend")
+	require.True(t, ok)
+	require.Equal(t, uint64(1000000), max)
+
+	_, ok = params.MaxGasPerBlock("/lfb.bank.v1beta1.MsgMultiSend")
+	require.False(t, ok)
+}
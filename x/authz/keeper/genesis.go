@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	"github.com/line/lfb-sdk/x/authz/types"
+)
+
+// InitGenesis initializes the authz module's state from a given genesis state.
+// Expired grants are skipped.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) error {
+	for _, entry := range data.Authorization {
+		if entry.Expiration.Before(ctx.BlockTime()) {
+			continue
+		}
+
+		granter, err := sdk.AccAddressFromBech32(entry.Granter)
+		if err != nil {
+			return err
+		}
+		grantee, err := sdk.AccAddressFromBech32(entry.Grantee)
+		if err != nil {
+			return err
+		}
+
+		authorization := entry.GetAuthorization()
+		if authorization == nil {
+			return sdkerrors.Wrap(types.ErrUnknownAuthorizationType, "missing authorization")
+		}
+
+		if err := k.SaveGrant(ctx, granter, grantee, authorization, entry.Expiration); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportGenesis returns a GenesisState for a given context.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	var entries []types.GrantAuthorization
+	k.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant types.Grant) bool {
+		entries = append(entries, types.GrantAuthorization{
+			Granter:       granter.String(),
+			Grantee:       grantee.String(),
+			Authorization: grant.Authorization,
+			Expiration:    grant.Expiration,
+		})
+		return false
+	})
+
+	return types.NewGenesisState(entries)
+}
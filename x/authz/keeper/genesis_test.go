@@ -0,0 +1,41 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/authz/types"
+	banktypes "github.com/line/lfb-sdk/x/bank/types"
+)
+
+func (s *TestSuite) TestImportExportGenesis() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	granterAddr, granteeAddr := addrs[0], addrs[1]
+
+	coins := sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1000)))
+
+	now := ctx.BlockHeader().Time
+	grant := &banktypes.SendAuthorization{SpendLimit: coins}
+	err := app.AuthzKeeper.SaveGrant(ctx, granterAddr, granteeAddr, grant, now.Add(time.Hour))
+	s.Require().NoError(err)
+	genesis := app.AuthzKeeper.ExportGenesis(ctx)
+	s.Require().Len(genesis.Authorization, 1)
+
+	// clear the grant and import the genesis back
+	s.Require().NoError(app.AuthzKeeper.DeleteGrant(ctx, granterAddr, granteeAddr, grant.MsgTypeURL()))
+	s.Require().Empty(app.AuthzKeeper.ExportGenesis(ctx).Authorization)
+
+	s.Require().NoError(app.AuthzKeeper.InitGenesis(ctx, genesis))
+	newGenesis := app.AuthzKeeper.ExportGenesis(ctx)
+	s.Require().Equal(genesis, newGenesis)
+
+	// expired grants are not imported
+	expired := types.GrantAuthorization{
+		Granter:       granteeAddr.String(),
+		Grantee:       granterAddr.String(),
+		Authorization: genesis.Authorization[0].Authorization,
+		Expiration:    now.Add(-time.Hour),
+	}
+	s.Require().NoError(app.AuthzKeeper.InitGenesis(ctx, types.NewGenesisState([]types.GrantAuthorization{expired})))
+	s.Require().Equal(genesis, app.AuthzKeeper.ExportGenesis(ctx))
+}
@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"time"
+
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/authz/types"
+	banktypes "github.com/line/lfb-sdk/x/bank/types"
+)
+
+func (s *TestSuite) TestGRPCQueryAuthorization() {
+	app, ctx, queryClient, addrs := s.app, s.ctx, s.queryClient, s.addrs
+
+	var (
+		req              *types.QueryGrantsRequest
+		expAuthorization types.Authorization
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail invalid granter addr",
+			func() {
+				req = &types.QueryGrantsRequest{}
+			},
+			false,
+		},
+		{
+			"fail invalid grantee addr",
+			func() {
+				req = &types.QueryGrantsRequest{
+					Granter: addrs[0].String(),
+				}
+			},
+			false,
+		},
+		{
+			"fail invalid msg-type",
+			func() {
+				req = &types.QueryGrantsRequest{
+					Granter:    addrs[0].String(),
+					Grantee:    addrs[1].String(),
+					MsgTypeUrl: "unknown",
+				}
+			},
+			false,
+		},
+		{
+			"Success",
+			func() {
+				now := ctx.BlockHeader().Time
+				newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+				expAuthorization = &banktypes.SendAuthorization{SpendLimit: newCoins}
+				err := app.AuthzKeeper.SaveGrant(ctx, addrs[0], addrs[1], expAuthorization, now.Add(time.Hour))
+				s.Require().NoError(err)
+				req = &types.QueryGrantsRequest{
+					Granter:    addrs[0].String(),
+					Grantee:    addrs[1].String(),
+					MsgTypeUrl: expAuthorization.MsgTypeURL(),
+				}
+			},
+			true,
+		},
+	}
+	for _, testCase := range testCases {
+		s.Run(testCase.msg, func() {
+			testCase.malleate()
+			result, err := queryClient.Grants(gocontext.Background(), req)
+			if testCase.expPass {
+				s.Require().NoError(err)
+				s.Require().Len(result.Grants, 1)
+				s.Require().Equal(expAuthorization, result.Grants[0].GetAuthorization())
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestGRPCQueryAuthorizations() {
+	app, ctx, queryClient, addrs := s.app, s.ctx, s.queryClient, s.addrs
+
+	_, err := app.AuthzKeeper.Grants(sdk.WrapSDKContext(ctx), nil)
+	s.Require().Error(err)
+
+	_, err = queryClient.Grants(gocontext.Background(), &types.QueryGrantsRequest{
+		Granter: addrs[0].String(),
+		Grantee: "invalid",
+	})
+	s.Require().Error(err)
+
+	// no grants yet
+	result, err := queryClient.Grants(gocontext.Background(), &types.QueryGrantsRequest{
+		Granter: addrs[0].String(),
+		Grantee: addrs[1].String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Empty(result.Grants)
+
+	now := ctx.BlockHeader().Time
+	send := banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("steak", 100)))
+	generic := types.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgMultiSend{}))
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, addrs[0], addrs[1], send, now.Add(time.Hour)))
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, addrs[0], addrs[1], generic, now.Add(time.Hour)))
+	// a grant to another grantee is not returned
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, addrs[0], addrs[2], send, now.Add(time.Hour)))
+
+	result, err = queryClient.Grants(gocontext.Background(), &types.QueryGrantsRequest{
+		Granter: addrs[0].String(),
+		Grantee: addrs[1].String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(result.Grants, 2)
+	s.Require().Equal(uint64(2), result.Pagination.Total)
+}
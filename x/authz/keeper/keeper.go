@@ -0,0 +1,206 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/line/ostracon/libs/log"
+
+	"github.com/line/lfb-sdk/baseapp"
+	"github.com/line/lfb-sdk/codec"
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	"github.com/line/lfb-sdk/x/authz/types"
+)
+
+// Keeper manages the authorization grants and dispatches the messages
+// executed on behalf of granters.
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryMarshaler
+	router   *baseapp.MsgServiceRouter
+}
+
+// NewKeeper constructs a message authorization Keeper
+func NewKeeper(storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, router *baseapp.MsgServiceRouter) Keeper {
+	return Keeper{
+		storeKey: storeKey,
+		cdc:      cdc,
+		router:   router,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// getGrant returns the grant stored under the given key, if any.
+func (k Keeper) getGrant(ctx sdk.Context, skey []byte) (grant types.Grant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(skey)
+	if bz == nil {
+		return grant, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+	return grant, true
+}
+
+func (k Keeper) update(ctx sdk.Context, granter, grantee sdk.AccAddress, updated types.Authorization) error {
+	skey := types.GrantStoreKey(granter, grantee, updated.MsgTypeURL())
+	grant, found := k.getGrant(ctx, skey)
+	if !found {
+		return types.ErrNoAuthorizationFound
+	}
+
+	updatedGrant, err := types.NewGrant(updated, grant.Expiration)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(skey, k.cdc.MustMarshalBinaryBare(&updatedGrant))
+	return nil
+}
+
+// DispatchActions attempts to execute the provided messages via authorization
+// grants from the message signer to the grantee, and returns the data of their
+// results. The messages are routed through the MsgServiceRouter by type URL.
+func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
+	results := make([][]byte, len(msgs))
+	for i, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 {
+			return nil, types.ErrAuthorizationNumOfSigners
+		}
+
+		msgTypeURL := sdk.MsgTypeURL(msg)
+		granter := signers[0]
+
+		// if the granter is the grantee, the message is executed without an
+		// authorization
+		if !granter.Equals(grantee) {
+			authorization, _ := k.GetCleanAuthorization(ctx, granter, grantee, msgTypeURL)
+			if authorization == nil {
+				return nil, sdkerrors.Wrapf(types.ErrNoAuthorizationFound, "%s from %s to %s", msgTypeURL, granter, grantee)
+			}
+
+			resp, err := authorization.Accept(ctx, msg)
+			if err != nil {
+				return nil, err
+			}
+
+			if resp.Delete {
+				err = k.DeleteGrant(ctx, granter, grantee, msgTypeURL)
+			} else if resp.Updated != nil {
+				err = k.update(ctx, granter, grantee, resp.Updated)
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			if !resp.Accept {
+				return nil, sdkerrors.ErrUnauthorized
+			}
+		}
+
+		handler := k.router.HandlerByTypeURL(msgTypeURL)
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message type: %s; message index: %d", msgTypeURL, i)
+		}
+
+		msgResp, err := handler(ctx, msg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
+		}
+		results[i] = msgResp.Data
+
+		// emit the events from the dispatched actions
+		ctx.EventManager().EmitEvents(msgResp.GetEvents())
+	}
+
+	return results, nil
+}
+
+// SaveGrant grants the provided authorization to the grantee on the granter's
+// account with the provided expiration time. If there is an existing
+// authorization grant for the same Msg type URL, it gets overwritten.
+func (k Keeper) SaveGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, authorization types.Authorization, expiration time.Time) error {
+	grant, err := types.NewGrant(authorization, expiration)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GrantStoreKey(granter, grantee, authorization.MsgTypeURL()), k.cdc.MustMarshalBinaryBare(&grant))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGrant,
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, authorization.MsgTypeURL()),
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// DeleteGrant revokes any authorization for the provided Msg type URL granted
+// to the grantee by the granter.
+func (k Keeper) DeleteGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, msgTypeURL string) error {
+	skey := types.GrantStoreKey(granter, grantee, msgTypeURL)
+	if _, found := k.getGrant(ctx, skey); !found {
+		return sdkerrors.Wrapf(types.ErrNoAuthorizationFound, "failed to delete grant with key %X", skey)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(skey)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevoke,
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msgTypeURL),
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetCleanAuthorization returns an authorization and its expiration time for
+// the given granter, grantee and Msg type URL. If the authorization has
+// expired, it is deleted and nil is returned.
+func (k Keeper) GetCleanAuthorization(ctx sdk.Context, granter, grantee sdk.AccAddress, msgTypeURL string) (types.Authorization, time.Time) {
+	grant, found := k.getGrant(ctx, types.GrantStoreKey(granter, grantee, msgTypeURL))
+	if !found {
+		return nil, time.Time{}
+	}
+
+	if grant.Expiration.Before(ctx.BlockHeader().Time) {
+		// the grant is removed right away, there is no point in keeping it
+		_ = k.DeleteGrant(ctx, granter, grantee, msgTypeURL)
+		return nil, time.Time{}
+	}
+
+	return grant.GetAuthorization(), grant.Expiration
+}
+
+// IterateGrants iterates over all authorization grants. The iteration stops
+// when the handler function returns true.
+func (k Keeper) IterateGrants(ctx sdk.Context, handler func(granter, grantee sdk.AccAddress, grant types.Grant) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GrantKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var grant types.Grant
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &grant)
+
+		granter, grantee, _ := types.ParseGrantStoreKey(iter.Key())
+		if handler(granter, grantee, grant) {
+			break
+		}
+	}
+}
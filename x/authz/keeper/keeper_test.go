@@ -0,0 +1,269 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/line/lfb-sdk/baseapp"
+	"github.com/line/lfb-sdk/simapp"
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	"github.com/line/lfb-sdk/x/authz/types"
+	banktypes "github.com/line/lfb-sdk/x/bank/types"
+)
+
+var bankSendAuthMsgType = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	queryClient types.QueryClient
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, ostproto.Header{Time: time.Now()})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.AuthzKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.queryClient = types.NewQueryClient(queryHelper)
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+}
+
+func (s *TestSuite) TestKeeper() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	recipientAddr := addrs[2]
+
+	s.T().Log("verify that no authorization returns nil")
+	authorization, expiration := app.AuthzKeeper.GetCleanAuthorization(ctx, granterAddr, granteeAddr, bankSendAuthMsgType)
+	s.Require().Nil(authorization)
+	s.Require().Zero(expiration)
+
+	now := ctx.BlockHeader().Time
+	newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+
+	s.T().Log("verify if expired authorization is rejected")
+	x := &banktypes.SendAuthorization{SpendLimit: newCoins}
+	err := app.AuthzKeeper.SaveGrant(ctx, granterAddr, granteeAddr, x, now.Add(-1*time.Hour))
+	s.Require().NoError(err)
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granterAddr, granteeAddr, bankSendAuthMsgType)
+	s.Require().Nil(authorization)
+
+	s.T().Log("verify if authorization is accepted")
+	x = &banktypes.SendAuthorization{SpendLimit: newCoins}
+	err = app.AuthzKeeper.SaveGrant(ctx, granterAddr, granteeAddr, x, now.Add(time.Hour))
+	s.Require().NoError(err)
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granterAddr, granteeAddr, bankSendAuthMsgType)
+	s.Require().NotNil(authorization)
+	s.Require().Equal(authorization.MsgTypeURL(), bankSendAuthMsgType)
+
+	s.T().Log("verify fetching authorization with wrong msg type fails")
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granterAddr, granteeAddr, sdk.MsgTypeURL(&banktypes.MsgMultiSend{}))
+	s.Require().Nil(authorization)
+
+	s.T().Log("verify fetching authorization with wrong grantee fails")
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granterAddr, recipientAddr, bankSendAuthMsgType)
+	s.Require().Nil(authorization)
+
+	s.T().Log("verify revoke fails with wrong information")
+	err = app.AuthzKeeper.DeleteGrant(ctx, granterAddr, recipientAddr, bankSendAuthMsgType)
+	s.Require().Error(err)
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granterAddr, recipientAddr, bankSendAuthMsgType)
+	s.Require().Nil(authorization)
+
+	s.T().Log("verify revoke executes with correct information")
+	err = app.AuthzKeeper.DeleteGrant(ctx, granterAddr, granteeAddr, bankSendAuthMsgType)
+	s.Require().NoError(err)
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granterAddr, granteeAddr, bankSendAuthMsgType)
+	s.Require().Nil(authorization)
+}
+
+func (s *TestSuite) TestKeeperIter() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+
+	now := ctx.BlockHeader().Time
+	newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+	authorization := &banktypes.SendAuthorization{SpendLimit: newCoins}
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, granterAddr, granteeAddr, authorization, now.Add(time.Hour)))
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, authorization, now.Add(time.Hour)))
+
+	var grants []sdk.AccAddress
+	app.AuthzKeeper.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant types.Grant) bool {
+		s.Require().Equal(authorization, grant.GetAuthorization())
+		grants = append(grants, granter, grantee)
+		return false
+	})
+	s.Require().ElementsMatch([]sdk.AccAddress{granterAddr, granteeAddr, granteeAddr, granterAddr}, grants)
+
+	var count int
+	app.AuthzKeeper.IterateGrants(ctx, func(_, _ sdk.AccAddress, _ types.Grant) bool {
+		count++
+		return true
+	})
+	s.Require().Equal(1, count)
+}
+
+func (s *TestSuite) TestDispatchAction() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	recipientAddr := addrs[2]
+	a := banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+
+	send := func(amount int64) []sdk.Msg {
+		return []sdk.Msg{banktypes.NewMsgSend(granterAddr, recipientAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", amount)))}
+	}
+
+	now := ctx.BlockHeader().Time
+
+	testCases := []struct {
+		name      string
+		msgs      []sdk.Msg
+		preRun    func()
+		expErr    error
+		postRun   func()
+		recipient int64
+	}{
+		{
+			"no authorization",
+			send(10),
+			func() {},
+			types.ErrNoAuthorizationFound,
+			func() {},
+			0,
+		},
+		{
+			"expired authorization",
+			send(10),
+			func() {
+				s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, granterAddr, granteeAddr, a, now.Add(-time.Hour)))
+			},
+			types.ErrNoAuthorizationFound,
+			func() {},
+			0,
+		},
+		{
+			"over the spend limit",
+			send(101),
+			func() {
+				s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, granterAddr, granteeAddr, a, now.Add(time.Hour)))
+			},
+			sdkerrors.ErrInsufficientFunds,
+			func() {},
+			0,
+		},
+		{
+			"authorization is updated",
+			send(40),
+			func() {
+				s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, granterAddr, granteeAddr, a, now.Add(time.Hour)))
+			},
+			nil,
+			func() {
+				authz, _ := app.AuthzKeeper.GetCleanAuthorization(ctx, granterAddr, granteeAddr, bankSendAuthMsgType)
+				s.Require().Equal(banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 60))), authz)
+			},
+			40,
+		},
+		{
+			"authorization is deleted once used up",
+			send(100),
+			func() {
+				s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, granterAddr, granteeAddr, a, now.Add(time.Hour)))
+			},
+			nil,
+			func() {
+				authz, _ := app.AuthzKeeper.GetCleanAuthorization(ctx, granterAddr, granteeAddr, bankSendAuthMsgType)
+				s.Require().Nil(authz)
+			},
+			100,
+		},
+		{
+			"signer with no grant",
+			[]sdk.Msg{banktypes.NewMsgSend(recipientAddr, granterAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))},
+			func() {
+				s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, granterAddr, granteeAddr, a, now.Add(time.Hour)))
+			},
+			types.ErrNoAuthorizationFound,
+			func() {},
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			app, ctx = s.app, s.ctx
+
+			tc.preRun()
+			before := app.BankKeeper.GetBalance(ctx, recipientAddr, "stake")
+
+			_, err := app.AuthzKeeper.DispatchActions(ctx, granteeAddr, tc.msgs)
+			if tc.expErr != nil {
+				s.Require().Error(err)
+				s.Require().True(errors.Is(err, tc.expErr), err.Error())
+			} else {
+				s.Require().NoError(err)
+			}
+
+			after := app.BankKeeper.GetBalance(ctx, recipientAddr, "stake")
+			s.Require().Equal(tc.recipient, after.Amount.Sub(before.Amount).Int64())
+			tc.postRun()
+		})
+	}
+}
+
+func (s *TestSuite) TestDispatchServiceMsg() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	recipientAddr := addrs[2]
+
+	now := ctx.BlockHeader().Time
+	a := types.NewGenericAuthorization(bankSendAuthMsgType)
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, granterAddr, granteeAddr, a, now.Add(time.Hour)))
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	svcMsg := sdk.ServiceMsg{
+		MethodName: "/lfb.bank.v1beta1.Msg/Send",
+		Request:    banktypes.NewMsgSend(granterAddr, recipientAddr, coins),
+	}
+
+	// the service Msg is packed with its method name and dispatched by the
+	// type URL of its request
+	msg, err := types.NewMsgExec(granteeAddr, []sdk.Msg{svcMsg})
+	s.Require().NoError(err)
+	s.Require().Equal(svcMsg.MethodName, msg.Msgs[0].TypeUrl)
+
+	msgs, err := msg.GetMessages()
+	s.Require().NoError(err)
+
+	before := app.BankKeeper.GetBalance(ctx, recipientAddr, "stake")
+	_, err = app.AuthzKeeper.DispatchActions(ctx, granteeAddr, msgs)
+	s.Require().NoError(err)
+	after := app.BankKeeper.GetBalance(ctx, recipientAddr, "stake")
+	s.Require().Equal(coins[0], after.Sub(before))
+}
@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/authz/keeper"
+	"github.com/line/lfb-sdk/x/authz/types"
+	banktypes "github.com/line/lfb-sdk/x/bank/types"
+)
+
+func (s *TestSuite) TestMsgServer() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	msgSrvr := keeper.NewMsgServerImpl(app.AuthzKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	granterAddr, granteeAddr, recipientAddr := addrs[0], addrs[1], addrs[2]
+	now := ctx.BlockHeader().Time
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	// the expiration must be in the future
+	msgGrant, err := types.NewMsgGrant(granterAddr, granteeAddr, banktypes.NewSendAuthorization(coins), now.Add(-time.Hour))
+	s.Require().NoError(err)
+	_, err = msgSrvr.Grant(goCtx, msgGrant)
+	s.Require().Error(err)
+
+	// only Msgs with a registered handler can be granted
+	msgGrant, err = types.NewMsgGrant(granterAddr, granteeAddr, types.NewGenericAuthorization("/lfb.bank.v1beta1.MsgUnknown"), now.Add(time.Hour))
+	s.Require().NoError(err)
+	_, err = msgSrvr.Grant(goCtx, msgGrant)
+	s.Require().Error(err)
+
+	msgGrant, err = types.NewMsgGrant(granterAddr, granteeAddr, banktypes.NewSendAuthorization(coins), now.Add(time.Hour))
+	s.Require().NoError(err)
+	_, err = msgSrvr.Grant(goCtx, msgGrant)
+	s.Require().NoError(err)
+
+	msgExec, err := types.NewMsgExec(granteeAddr, []sdk.Msg{banktypes.NewMsgSend(granterAddr, recipientAddr, coins)})
+	s.Require().NoError(err)
+	before := app.BankKeeper.GetBalance(ctx, recipientAddr, "stake")
+	res, err := msgSrvr.Exec(goCtx, &msgExec)
+	s.Require().NoError(err)
+	s.Require().Len(res.Results, 1)
+	after := app.BankKeeper.GetBalance(ctx, recipientAddr, "stake")
+	s.Require().Equal(coins[0], after.Sub(before))
+
+	// the spend limit is used up, so the grant is gone
+	_, err = msgSrvr.Exec(goCtx, &msgExec)
+	s.Require().Error(err)
+	msgRevoke := types.NewMsgRevoke(granterAddr, granteeAddr, bankSendAuthMsgType)
+	_, err = msgSrvr.Revoke(goCtx, &msgRevoke)
+	s.Require().Error(err)
+
+	_, err = msgSrvr.Grant(goCtx, msgGrant)
+	s.Require().NoError(err)
+	_, err = msgSrvr.Revoke(goCtx, &msgRevoke)
+	s.Require().NoError(err)
+	_, err = msgSrvr.Exec(goCtx, &msgExec)
+	s.Require().Error(err)
+}
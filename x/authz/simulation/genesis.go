@@ -0,0 +1,67 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	codectypes "github.com/line/lfb-sdk/codec/types"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/types/module"
+	simtypes "github.com/line/lfb-sdk/types/simulation"
+	"github.com/line/lfb-sdk/x/authz/types"
+	banktypes "github.com/line/lfb-sdk/x/bank/types"
+)
+
+// genGrants returns a slice of randomly generated grants.
+func genGrants(r *rand.Rand, accounts []simtypes.Account, genT time.Time) []types.GrantAuthorization {
+	authorizations := make([]types.GrantAuthorization, len(accounts)-1)
+	for i := 0; i < len(accounts)-1; i++ {
+		granter := accounts[i]
+		grantee := accounts[i+1]
+		authorizations[i] = types.GrantAuthorization{
+			Granter:       granter.Address.String(),
+			Grantee:       grantee.Address.String(),
+			Authorization: generateRandomGrant(r),
+			Expiration:    genT.AddDate(1, 0, 0),
+		}
+	}
+
+	return authorizations
+}
+
+func generateRandomGrant(r *rand.Rand) *codectypes.Any {
+	authorizations := make([]*codectypes.Any, 2)
+	authorizations[0] = newAnyAuthorization(banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000)))))
+	authorizations[1] = newAnyAuthorization(types.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{})))
+
+	return authorizations[r.Intn(len(authorizations))]
+}
+
+func newAnyAuthorization(a types.Authorization) *codectypes.Any {
+	any, err := codectypes.NewAnyWithValue(a)
+	if err != nil {
+		panic(err)
+	}
+
+	return any
+}
+
+// RandomizedGenState generates a random GenesisState for authz
+func RandomizedGenState(simState *module.SimulationState) {
+	var grants []types.GrantAuthorization
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, "authz", &grants, simState.Rand,
+		func(r *rand.Rand) { grants = genGrants(r, simState.Accounts, simState.GenTimestamp) },
+	)
+
+	authzGenesis := types.NewGenesisState(grants)
+	bz, err := simState.Cdc.MarshalJSON(authzGenesis)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, bz)
+	simState.GenState[types.ModuleName] = bz
+}
@@ -0,0 +1,268 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/line/lfb-sdk/baseapp"
+	"github.com/line/lfb-sdk/codec"
+	"github.com/line/lfb-sdk/simapp/helpers"
+	simappparams "github.com/line/lfb-sdk/simapp/params"
+	sdk "github.com/line/lfb-sdk/types"
+	simtypes "github.com/line/lfb-sdk/types/simulation"
+	"github.com/line/lfb-sdk/x/authz/keeper"
+	"github.com/line/lfb-sdk/x/authz/types"
+	banktypes "github.com/line/lfb-sdk/x/bank/types"
+	"github.com/line/lfb-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgGrant  = "op_weight_msg_grant"
+	OpWeightMsgRevoke = "op_weight_msg_revoke"
+	OpWeightMsgExec   = "op_weight_msg_exec"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONMarshaler,
+	ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+
+	var (
+		weightMsgGrant  int
+		weightMsgRevoke int
+		weightMsgExec   int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgGrant, &weightMsgGrant, nil,
+		func(_ *rand.Rand) {
+			weightMsgGrant = simappparams.DefaultWeightMsgGrant
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRevoke, &weightMsgRevoke, nil,
+		func(_ *rand.Rand) {
+			weightMsgRevoke = simappparams.DefaultWeightMsgRevoke
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgExec, &weightMsgExec, nil,
+		func(_ *rand.Rand) {
+			weightMsgExec = simappparams.DefaultWeightMsgExec
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgGrant,
+			SimulateMsgGrant(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRevoke,
+			SimulateMsgRevoke(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgExec,
+			SimulateMsgExec(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgGrant generates a MsgGrant of a SendAuthorization with random values.
+func SimulateMsgGrant(ak types.AccountKeeper, bk types.BankKeeper, _ keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		granter, _ := simtypes.RandomAcc(r, accs)
+		grantee, _ := simtypes.RandomAcc(r, accs)
+		if granter.Address.Equals(grantee.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrant, "granter and grantee are same"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, granter.Address)
+
+		spendableCoins := bk.SpendableCoins(ctx, account.GetAddress())
+		fees, err := simtypes.RandomFees(r, ctx, spendableCoins)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrant, err.Error()), nil, err
+		}
+
+		spendLimit := spendableCoins.Sub(fees)
+		if spendLimit.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrant, "spend limit is nil"), nil, nil
+		}
+
+		expiration := ctx.BlockTime().AddDate(1, 0, 0)
+		msg, err := types.NewMsgGrant(granter.Address, grantee.Address, banktypes.NewSendAuthorization(spendLimit), expiration)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrant, err.Error()), nil, err
+		}
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{0},
+			[]uint64{account.GetSequence()},
+			granter.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrant, "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgRevoke generates a MsgRevoke of an existing grant.
+func SimulateMsgRevoke(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var granterAddr, granteeAddr sdk.AccAddress
+		var a types.Authorization
+		hasGrant := false
+
+		k.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant types.Grant) bool {
+			a = grant.GetAuthorization()
+			granterAddr = granter
+			granteeAddr = grantee
+			hasGrant = true
+			return true
+		})
+
+		if !hasGrant {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevoke, "no grants"), nil, nil
+		}
+
+		granterAcc, ok := simtypes.FindAccount(accs, granterAddr)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevoke, "account not found"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, granterAcc.Address)
+		spendableCoins := bk.SpendableCoins(ctx, account.GetAddress())
+		fees, err := simtypes.RandomFees(r, ctx, spendableCoins)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevoke, "fee error"), nil, err
+		}
+
+		msg := types.NewMsgRevoke(granterAddr, granteeAddr, a.MsgTypeURL())
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{&msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{0},
+			[]uint64{account.GetSequence()},
+			granterAcc.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevoke, err.Error()), nil, err
+		}
+
+		_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(&msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgExec generates a MsgExec of a MsgSend using an existing
+// SendAuthorization grant.
+func SimulateMsgExec(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var granterAddr, granteeAddr sdk.AccAddress
+		var sendAuth *banktypes.SendAuthorization
+		hasGrant := false
+
+		k.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant types.Grant) bool {
+			if grant.Expiration.Before(ctx.BlockTime()) {
+				return false
+			}
+			a, ok := grant.GetAuthorization().(*banktypes.SendAuthorization)
+			if !ok {
+				return false
+			}
+			sendAuth = a
+			granterAddr = granter
+			granteeAddr = grantee
+			hasGrant = true
+			return true
+		})
+
+		if !hasGrant {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgExec, "no send grants"), nil, nil
+		}
+
+		if _, ok := simtypes.FindAccount(accs, granterAddr); !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgExec, "granter account not found"), nil, nil
+		}
+		granteeAcc, ok := simtypes.FindAccount(accs, granteeAddr)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgExec, "grantee account not found"), nil, nil
+		}
+
+		// send coins within both the spend limit and the granter's balance
+		granterSpendable := bk.SpendableCoins(ctx, granterAddr)
+		var sendable sdk.Coins
+		for _, limit := range sendAuth.SpendLimit {
+			amount := sdk.MinInt(limit.Amount, granterSpendable.AmountOf(limit.Denom))
+			if amount.IsPositive() {
+				sendable = append(sendable, sdk.NewCoin(limit.Denom, amount))
+			}
+		}
+		coins := simtypes.RandSubsetCoins(r, sendable)
+		if coins.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgExec, "no coins to send"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, granteeAddr)
+		fees, err := simtypes.RandomFees(r, ctx, bk.SpendableCoins(ctx, granteeAddr))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgExec, "fee error"), nil, err
+		}
+
+		msg, err := types.NewMsgExec(granteeAddr, []sdk.Msg{banktypes.NewMsgSend(granterAddr, granteeAddr, coins)})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgExec, err.Error()), nil, err
+		}
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{&msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{0},
+			[]uint64{account.GetSequence()},
+			granteeAcc.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgExec, err.Error()), nil, err
+		}
+
+		_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(&msg, true, ""), nil, nil
+	}
+}
@@ -0,0 +1,14 @@
+package types
+
+// authz module events
+const (
+	EventTypeGrant  = "grant"
+	EventTypeRevoke = "revoke"
+	EventTypeExec   = "exec"
+
+	AttributeKeyGranter    = "granter"
+	AttributeKeyGrantee    = "grantee"
+	AttributeKeyMsgTypeURL = "msg_type_url"
+
+	AttributeValueCategory = ModuleName
+)
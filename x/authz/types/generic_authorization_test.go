@@ -0,0 +1,28 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/authz/types"
+	banktypes "github.com/line/lfb-sdk/x/bank/types"
+)
+
+func TestGenericAuthorization(t *testing.T) {
+	t.Log("verify ValidateBasic returns nil for service msg")
+	a := types.NewGenericAuthorization(msgSendURL)
+	require.NoError(t, a.ValidateBasic())
+	require.Equal(t, msgSendURL, a.MsgTypeURL())
+
+	t.Log("verify ValidateBasic returns an error for an empty msg type")
+	require.Error(t, types.NewGenericAuthorization("").ValidateBasic())
+
+	t.Log("verify any msg is accepted without changes")
+	resp, err := a.Accept(sdk.Context{}, banktypes.NewMsgSend(granter, grantee, coinsPos))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+}
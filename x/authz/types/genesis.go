@@ -0,0 +1,58 @@
+package types
+
+import (
+	"github.com/line/lfb-sdk/codec/types"
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+)
+
+var _ types.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates new GenesisState object
+func NewGenesisState(entries []GrantAuthorization) *GenesisState {
+	return &GenesisState{
+		Authorization: entries,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the authz module.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	for _, a := range gs.Authorization {
+		granter, err := sdk.AccAddressFromBech32(a.Granter)
+		if err != nil {
+			return sdkerrors.Wrap(err, "invalid granter address")
+		}
+		grantee, err := sdk.AccAddressFromBech32(a.Grantee)
+		if err != nil {
+			return sdkerrors.Wrap(err, "invalid grantee address")
+		}
+		if granter.Equals(grantee) {
+			return ErrGranteeIsGranter
+		}
+
+		authorization := a.GetAuthorization()
+		if authorization == nil {
+			return sdkerrors.Wrap(ErrUnknownAuthorizationType, "missing authorization")
+		}
+		if err := authorization.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, a := range gs.Authorization {
+		if err := a.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
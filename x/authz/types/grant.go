@@ -0,0 +1,90 @@
+package types
+
+import (
+	"time"
+
+	"github.com/line/lfb-sdk/codec/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+)
+
+var (
+	_ types.UnpackInterfacesMessage = &Grant{}
+	_ types.UnpackInterfacesMessage = &GrantAuthorization{}
+)
+
+// NewGrant returns a new Grant of the authorization until the expiration time.
+func NewGrant(a Authorization, expiration time.Time) (Grant, error) {
+	any, err := packAuthorization(a)
+	if err != nil {
+		return Grant{}, err
+	}
+
+	return Grant{
+		Authorization: any,
+		Expiration:    expiration,
+	}, nil
+}
+
+// GetAuthorization returns the cached value from the Grant.Authorization if present.
+func (g Grant) GetAuthorization() Authorization {
+	if g.Authorization == nil {
+		return nil
+	}
+	a, ok := g.Authorization.GetCachedValue().(Authorization)
+	if !ok {
+		return nil
+	}
+	return a
+}
+
+// ValidateBasic performs basic validation on the Grant.
+func (g Grant) ValidateBasic() error {
+	a := g.GetAuthorization()
+	if a == nil {
+		return sdkerrors.Wrap(ErrUnknownAuthorizationType, "missing authorization")
+	}
+	return a.ValidateBasic()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g Grant) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var a Authorization
+	return unpacker.UnpackAny(g.Authorization, &a)
+}
+
+// GetAuthorization returns the cached value from the GrantAuthorization.Authorization if present.
+func (g GrantAuthorization) GetAuthorization() Authorization {
+	if g.Authorization == nil {
+		return nil
+	}
+	a, ok := g.Authorization.GetCachedValue().(Authorization)
+	if !ok {
+		return nil
+	}
+	return a
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g GrantAuthorization) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var a Authorization
+	return unpacker.UnpackAny(g.Authorization, &a)
+}
+
+func packAuthorization(a Authorization) (*types.Any, error) {
+	if a == nil {
+		return nil, sdkerrors.Wrap(ErrUnknownAuthorizationType, "missing authorization")
+	}
+	return types.NewAnyWithValue(a)
+}
+
+var _ types.UnpackInterfacesMessage = &QueryGrantsResponse{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r QueryGrantsResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, g := range r.Grants {
+		if err := g.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
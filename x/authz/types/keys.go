@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "authz"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+var (
+	// GrantKey is the prefix of the kvstore for authorization grants
+	GrantKey = []byte{0x01}
+)
+
+// GrantStoreKey is the canonical key to store a grant from granter to grantee
+// for the given Msg type URL. We store by granter first to allow iterating
+// over all the grants of a granter.
+func GrantStoreKey(granter, grantee sdk.AccAddress, msgTypeURL string) []byte {
+	return append(GranteeKeyPrefix(granter, grantee), []byte(msgTypeURL)...)
+}
+
+// GranteeKeyPrefix returns a prefix to scan for all the grants from granter
+// to grantee.
+func GranteeKeyPrefix(granter, grantee sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(GrantKey)+len(granter)+len(grantee))
+	key = append(key, GrantKey...)
+	key = append(key, granter.Bytes()...)
+	return append(key, grantee.Bytes()...)
+}
+
+// ParseGrantStoreKey extracts the granter, grantee and Msg type URL from a key
+// built by GrantStoreKey.
+func ParseGrantStoreKey(key []byte) (granter, grantee sdk.AccAddress, msgTypeURL string) {
+	// key is of format:
+	// 0x01<granterAddressBytes><granteeAddressBytes><msgTypeURLBytes>
+	key = key[len(GrantKey):]
+	return sdk.AccAddress(key[:sdk.AddrLen]), sdk.AccAddress(key[sdk.AddrLen : 2*sdk.AddrLen]), string(key[2*sdk.AddrLen:])
+}
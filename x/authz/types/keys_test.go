@@ -0,0 +1,19 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/x/authz/types"
+)
+
+func TestGrantStoreKey(t *testing.T) {
+	key := types.GrantStoreKey(granter, grantee, msgSendURL)
+	require.Equal(t, types.GranteeKeyPrefix(granter, grantee), key[:len(key)-len(msgSendURL)])
+
+	granter1, grantee1, msgTypeURL := types.ParseGrantStoreKey(key)
+	require.Equal(t, granter, granter1)
+	require.Equal(t, grantee, grantee1)
+	require.Equal(t, msgSendURL, msgTypeURL)
+}
@@ -0,0 +1,139 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/crypto/keys/secp256k1"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/authz/types"
+	banktypes "github.com/line/lfb-sdk/x/bank/types"
+)
+
+var (
+	coinsPos   = sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+	granter    = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	grantee    = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msgSendURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+)
+
+func TestMsgExecAuthorized(t *testing.T) {
+	tests := []struct {
+		title      string
+		grantee    sdk.AccAddress
+		msgs       []sdk.Msg
+		expectPass bool
+	}{
+		{"nil grantee address", nil, []sdk.Msg{}, false},
+		{"zero-messages test: should fail", grantee, []sdk.Msg{}, false},
+		{"invalid inner message", grantee, []sdk.Msg{
+			banktypes.NewMsgSend(granter, grantee, sdk.Coins{sdk.Coin{Denom: "steak", Amount: sdk.NewInt(-1)}}),
+		}, false},
+		{"valid test: msg type", grantee, []sdk.Msg{
+			banktypes.NewMsgSend(granter, grantee, coinsPos),
+		}, true},
+		{"valid test: service msg type", grantee, []sdk.Msg{
+			sdk.ServiceMsg{
+				MethodName: "/lfb.bank.v1beta1.Msg/Send",
+				Request:    banktypes.NewMsgSend(granter, grantee, coinsPos),
+			},
+		}, true},
+	}
+	for i, tc := range tests {
+		msg, err := types.NewMsgExec(tc.grantee, tc.msgs)
+		require.NoError(t, err)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgExecGetMessages(t *testing.T) {
+	send := banktypes.NewMsgSend(granter, grantee, coinsPos)
+	msg, err := types.NewMsgExec(grantee, []sdk.Msg{
+		send,
+		sdk.ServiceMsg{MethodName: "/lfb.bank.v1beta1.Msg/Send", Request: send},
+	})
+	require.NoError(t, err)
+	require.Equal(t, msgSendURL, msg.Msgs[0].TypeUrl)
+	require.Equal(t, "/lfb.bank.v1beta1.Msg/Send", msg.Msgs[1].TypeUrl)
+
+	msgs, err := msg.GetMessages()
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{send, send}, msgs)
+	require.Equal(t, []sdk.AccAddress{grantee}, msg.GetSigners())
+}
+
+func TestMsgRevokeAuthorization(t *testing.T) {
+	tests := []struct {
+		title            string
+		granter, grantee sdk.AccAddress
+		msgType          string
+		expectPass       bool
+	}{
+		{"empty granter", sdk.AccAddress{}, grantee, msgSendURL, false},
+		{"empty grantee", granter, sdk.AccAddress{}, msgSendURL, false},
+		{"valid test", granter, grantee, msgSendURL, true},
+		{"same granter and grantee", granter, granter, msgSendURL, false},
+		{"empty msg type", granter, grantee, "", false},
+	}
+	for i, tc := range tests {
+		msg := types.NewMsgRevoke(tc.granter, tc.grantee, tc.msgType)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgGrantAuthorization(t *testing.T) {
+	tests := []struct {
+		title            string
+		granter, grantee sdk.AccAddress
+		authorization    types.Authorization
+		expiration       time.Time
+		expectErr        bool
+		expectPass       bool
+	}{
+		{"nil granter address", nil, grantee, &banktypes.SendAuthorization{SpendLimit: coinsPos}, time.Now(), false, false},
+		{"nil grantee address", granter, nil, &banktypes.SendAuthorization{SpendLimit: coinsPos}, time.Now(), false, false},
+		{"nil granter and grantee address", nil, nil, &banktypes.SendAuthorization{SpendLimit: coinsPos}, time.Now(), false, false},
+		{"nil authorization", granter, grantee, nil, time.Now(), true, false},
+		{"valid test case", granter, grantee, &banktypes.SendAuthorization{SpendLimit: coinsPos}, time.Now().AddDate(0, 1, 0), false, true},
+		{"same granter and grantee", granter, granter, &banktypes.SendAuthorization{SpendLimit: coinsPos}, time.Now().AddDate(0, 1, 0), false, false},
+		{"invalid spend limit", granter, grantee, &banktypes.SendAuthorization{}, time.Now().AddDate(0, 1, 0), false, false},
+		{"empty generic authorization", granter, grantee, types.NewGenericAuthorization(""), time.Now().AddDate(0, 1, 0), false, false},
+	}
+	for i, tc := range tests {
+		msg, err := types.NewMsgGrant(tc.granter, tc.grantee, tc.authorization, tc.expiration)
+		if tc.expectErr {
+			require.Error(t, err, "test: %v", i)
+			continue
+		}
+		require.NoError(t, err)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgGrantGetAuthorization(t *testing.T) {
+	require := require.New(t)
+
+	g := types.NewGenericAuthorization("some_type")
+	m, err := types.NewMsgGrant(granter, grantee, g, time.Now())
+	require.NoError(err)
+	require.Equal(g, m.GetAuthorization())
+
+	g = types.NewGenericAuthorization(msgSendURL)
+	m, err = types.NewMsgGrant(granter, grantee, g, time.Now())
+	require.NoError(err)
+	require.Equal(g, m.GetAuthorization())
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"github.com/line/lfb-sdk/codec"
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+// BalanceAccumulator adds up the balances of the bank store. It implements
+// baseapp.Accumulator, so that the transactions of a block executed in
+// parallel can credit the same account, such as the fee collector, without
+// conflicting.
+type BalanceAccumulator struct {
+	cdc codec.BinaryMarshaler
+}
+
+// NewBalanceAccumulator returns a BalanceAccumulator decoding the balances
+// with cdc.
+func NewBalanceAccumulator(cdc codec.BinaryMarshaler) BalanceAccumulator {
+	return BalanceAccumulator{cdc: cdc}
+}
+
+// Sub returns the coin by which the balance value exceeds the balance base.
+func (a BalanceAccumulator) Sub(value, base []byte) ([]byte, bool) {
+	if base == nil {
+		return value, true
+	}
+	if value == nil {
+		return nil, false
+	}
+
+	var v, b sdk.Coin
+	a.cdc.MustUnmarshalBinaryBare(value, &v)
+	a.cdc.MustUnmarshalBinaryBare(base, &b)
+	if v.Denom != b.Denom || v.Amount.LT(b.Amount) {
+		return nil, false
+	}
+
+	diff := sdk.NewCoin(v.Denom, v.Amount.Sub(b.Amount))
+	return a.cdc.MustMarshalBinaryBare(&diff), true
+}
+
+// Add returns the balance value increased by the coin amount.
+func (a BalanceAccumulator) Add(value, amount []byte) []byte {
+	if value == nil {
+		return amount
+	}
+	if amount == nil {
+		return value
+	}
+
+	var v, amt sdk.Coin
+	a.cdc.MustUnmarshalBinaryBare(value, &v)
+	a.cdc.MustUnmarshalBinaryBare(amount, &amt)
+
+	sum := v.Add(amt)
+	return a.cdc.MustMarshalBinaryBare(&sum)
+}
@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/line/lfb-sdk/crypto/codec"
 	sdk "github.com/line/lfb-sdk/types"
 	"github.com/line/lfb-sdk/types/msgservice"
+	authztypes "github.com/line/lfb-sdk/x/authz/types"
 	"github.com/line/lfb-sdk/x/bank/exported"
 )
 
@@ -16,6 +17,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&Supply{}, "lfb-sdk/Supply", nil)
 	cdc.RegisterConcrete(&MsgSend{}, "lfb-sdk/MsgSend", nil)
 	cdc.RegisterConcrete(&MsgMultiSend{}, "lfb-sdk/MsgMultiSend", nil)
+	cdc.RegisterConcrete(&SendAuthorization{}, "lfb-sdk/SendAuthorization", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -23,6 +25,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSend{},
 		&MsgMultiSend{},
 	)
+	registry.RegisterImplementations(
+		(*authztypes.Authorization)(nil),
+		&SendAuthorization{},
+	)
 
 	registry.RegisterInterface(
 		"lfb.bank.v1beta1.SupplyI",
@@ -49,4 +55,7 @@ func init() {
 	RegisterLegacyAminoCodec(amino)
 	cryptocodec.RegisterCrypto(amino)
 	amino.Seal()
+
+	// the bank Msgs and authorization can be embedded in x/authz Msgs
+	RegisterLegacyAminoCodec(authztypes.Amino)
 }
@@ -33,6 +33,12 @@ func DenomMetadataKey(denom string) []byte {
 	return append(DenomMetadataPrefix, d...)
 }
 
+// CreateAccountBalancesPrefix returns the prefix of the balances of an account
+// in the bank store.
+func CreateAccountBalancesPrefix(addr []byte) []byte {
+	return append(append([]byte{}, BalancesPrefix...), addr...)
+}
+
 // AddressFromBalancesStore returns an account address from a balances prefix
 // store. The key must not contain the perfix BalancesPrefix as the prefix store
 // iterator discards the actual prefix.
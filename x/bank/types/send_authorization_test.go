@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/bank/types"
+)
+
+var (
+	coins1000 = sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000)))
+	coins500  = sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(500)))
+	fromAddr  = sdk.AccAddress("_____from _____")
+	toAddr    = sdk.AccAddress("_______to________")
+)
+
+func TestSendAuthorization(t *testing.T) {
+	ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+	authorization := types.NewSendAuthorization(coins1000)
+
+	t.Log("verify authorization returns valid method name")
+	require.Equal(t, authorization.MsgTypeURL(), "/lfb.bank.v1beta1.MsgSend")
+	require.NoError(t, authorization.ValidateBasic())
+	send := types.NewMsgSend(fromAddr, toAddr, coins1000)
+
+	t.Log("verify updated authorization returns nil")
+	resp, err := authorization.Accept(ctx, send)
+	require.NoError(t, err)
+	require.True(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+
+	t.Log("verify updated authorization returns remaining spent limit")
+	send = types.NewMsgSend(fromAddr, toAddr, coins500)
+	resp, err = authorization.Accept(ctx, send)
+	require.NoError(t, err)
+	require.False(t, resp.Delete)
+	require.NotNil(t, resp.Updated)
+	require.Equal(t, types.NewSendAuthorization(coins500), resp.Updated)
+
+	t.Log("expect updated authorization nil after spending remaining amount")
+	resp, err = resp.Updated.Accept(ctx, send)
+	require.NoError(t, err)
+	require.True(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+
+	t.Log("verify spending more than the limit fails")
+	_, err = types.NewSendAuthorization(coins500).Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins1000))
+	require.Error(t, err)
+
+	t.Log("verify other messages are rejected")
+	_, err = authorization.Accept(ctx, types.NewMsgMultiSend(nil, nil))
+	require.Error(t, err)
+
+	t.Log("verify an empty spend limit is invalid")
+	require.Error(t, types.NewSendAuthorization(nil).ValidateBasic())
+	require.Error(t, types.NewSendAuthorization(sdk.Coins{sdk.NewInt64Coin("stake", 0)}).ValidateBasic())
+}
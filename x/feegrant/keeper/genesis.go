@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/feegrant/types"
+)
+
+// InitGenesis will initialize the keeper from a *previously validated* GenesisState
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) error {
+	for _, f := range data.Allowances {
+		granter, err := sdk.AccAddressFromBech32(f.Granter)
+		if err != nil {
+			return err
+		}
+		grantee, err := sdk.AccAddressFromBech32(f.Grantee)
+		if err != nil {
+			return err
+		}
+
+		grant, err := f.GetGrant()
+		if err != nil {
+			return err
+		}
+
+		if err := k.GrantAllowance(ctx, granter, grantee, grant); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportGenesis will dump the contents of the keeper into a serializable GenesisState.
+func (k Keeper) ExportGenesis(ctx sdk.Context) (*types.GenesisState, error) {
+	var grants []types.Grant
+
+	err := k.IterateAllFeeAllowances(ctx, func(grant types.Grant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+
+	return &types.GenesisState{
+		Allowances: grants,
+	}, err
+}
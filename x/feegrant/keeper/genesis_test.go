@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	codectypes "github.com/line/lfb-sdk/codec/types"
+	"github.com/line/lfb-sdk/crypto/keys/secp256k1"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/feegrant/types"
+)
+
+var (
+	granteePub  = secp256k1.GenPrivKey().PubKey()
+	granterPub  = secp256k1.GenPrivKey().PubKey()
+	granteeAddr = sdk.AccAddress(granteePub.Address())
+	granterAddr = sdk.AccAddress(granterPub.Address())
+)
+
+func (suite *KeeperTestSuite) TestImportExportGenesis() {
+	coins := sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1_000)))
+	now := suite.ctx.BlockHeader().Time
+	oneYear := now.AddDate(1, 0, 0)
+	msgSrvr := suite.msgSrvr
+
+	allowance := &types.BasicAllowance{SpendLimit: coins, Expiration: &oneYear}
+	err := suite.keeper.GrantAllowance(suite.ctx, granterAddr, granteeAddr, allowance)
+	suite.Require().NoError(err)
+
+	genesis, err := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	// revoke fee allowance
+	_, err = msgSrvr.RevokeAllowance(sdk.WrapSDKContext(suite.ctx), &types.MsgRevokeAllowance{
+		Granter: granterAddr.String(),
+		Grantee: granteeAddr.String(),
+	})
+	suite.Require().NoError(err)
+	err = suite.keeper.InitGenesis(suite.ctx, genesis)
+	suite.Require().NoError(err)
+
+	newGenesis, err := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(genesis, newGenesis)
+}
+
+func (suite *KeeperTestSuite) TestInitGenesis() {
+	any, err := types.NewGrant(granterAddr, granteeAddr, &types.BasicAllowance{})
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name          string
+		feeAllowances []types.Grant
+	}{
+		{
+			"invalid granter",
+			[]types.Grant{
+				{
+					Granter: "invalid granter",
+					Grantee: granteeAddr.String(),
+				},
+			},
+		},
+		{
+			"invalid grantee",
+			[]types.Grant{
+				{
+					Granter: granterAddr.String(),
+					Grantee: "invalid grantee",
+				},
+			},
+		},
+		{
+			"invalid allowance",
+			[]types.Grant{
+				{
+					Granter:   granterAddr.String(),
+					Grantee:   granteeAddr.String(),
+					Allowance: &codectypes.Any{TypeUrl: any.Allowance.TypeUrl, Value: any.Allowance.Value},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			err := suite.keeper.InitGenesis(suite.ctx, &types.GenesisState{Allowances: tc.feeAllowances})
+			suite.Require().Error(err)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/feegrant/types"
+)
+
+func (suite *KeeperTestSuite) TestFeeAllowance() {
+	_, err := suite.keeper.Allowance(sdk.WrapSDKContext(suite.sdkCtx), nil)
+	suite.Require().Error(err)
+
+	testCases := []struct {
+		name      string
+		req       *types.QueryAllowanceRequest
+		expectErr bool
+		preRun    func()
+		postRun   func(_ *types.QueryAllowanceResponse)
+	}{
+		{
+			"fail: invalid granter",
+			&types.QueryAllowanceRequest{
+				Granter: "invalid_granter",
+				Grantee: suite.addrs[0].String(),
+			},
+			true,
+			func() {},
+			func(*types.QueryAllowanceResponse) {},
+		},
+		{
+			"fail: invalid grantee",
+			&types.QueryAllowanceRequest{
+				Granter: suite.addrs[0].String(),
+				Grantee: "invalid_grantee",
+			},
+			true,
+			func() {},
+			func(*types.QueryAllowanceResponse) {},
+		},
+		{
+			"fail: no grants",
+			&types.QueryAllowanceRequest{
+				Granter: suite.addrs[0].String(),
+				Grantee: suite.addrs[1].String(),
+			},
+			true,
+			func() {},
+			func(*types.QueryAllowanceResponse) {},
+		},
+		{
+			"valid query: expect single grant",
+			&types.QueryAllowanceRequest{
+				Granter: suite.addrs[0].String(),
+				Grantee: suite.addrs[1].String(),
+			},
+			false,
+			func() {
+				suite.grantFeeAllowance(suite.addrs[0], suite.addrs[1])
+			},
+			func(response *types.QueryAllowanceResponse) {
+				suite.Require().Equal(response.Allowance.Granter, suite.addrs[0].String())
+				suite.Require().Equal(response.Allowance.Grantee, suite.addrs[1].String())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tc.preRun()
+			resp, err := suite.queryClient.Allowance(suite.sdkCtx.Context(), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestFeeAllowances() {
+	_, err := suite.keeper.Allowances(sdk.WrapSDKContext(suite.sdkCtx), nil)
+	suite.Require().Error(err)
+
+	testCases := []struct {
+		name      string
+		req       *types.QueryAllowancesRequest
+		expectErr bool
+		preRun    func()
+		postRun   func(_ *types.QueryAllowancesResponse)
+	}{
+		{
+			"fail: invalid grantee",
+			&types.QueryAllowancesRequest{
+				Grantee: "invalid_grantee",
+			},
+			true,
+			func() {},
+			func(*types.QueryAllowancesResponse) {},
+		},
+		{
+			"no grants",
+			&types.QueryAllowancesRequest{
+				Grantee: suite.addrs[1].String(),
+			},
+			false,
+			func() {},
+			func(resp *types.QueryAllowancesResponse) {
+				suite.Require().Equal(len(resp.Allowances), 0)
+			},
+		},
+		{
+			"valid query: expect single grant",
+			&types.QueryAllowancesRequest{
+				Grantee: suite.addrs[1].String(),
+			},
+			false,
+			func() {
+				suite.grantFeeAllowance(suite.addrs[0], suite.addrs[1])
+			},
+			func(resp *types.QueryAllowancesResponse) {
+				suite.Require().Equal(len(resp.Allowances), 1)
+				suite.Require().Equal(resp.Allowances[0].Granter, suite.addrs[0].String())
+				suite.Require().Equal(resp.Allowances[0].Grantee, suite.addrs[1].String())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tc.preRun()
+			resp, err := suite.queryClient.Allowances(suite.sdkCtx.Context(), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) grantFeeAllowance(granter, grantee []byte) {
+	exp := suite.sdkCtx.BlockTime().AddDate(1, 0, 0)
+	err := suite.app.FeeGrantKeeper.GrantAllowance(suite.sdkCtx, granter, grantee, &types.BasicAllowance{
+		SpendLimit: suite.atom,
+		Expiration: &exp,
+	})
+	suite.Require().NoError(err)
+}
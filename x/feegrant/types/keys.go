@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feegrant"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+var (
+	// FeeAllowanceKeyPrefix is the set of the kvstore for fee allowance data
+	FeeAllowanceKeyPrefix = []byte{0x00}
+)
+
+// FeeAllowanceKey is the canonical key to store a grant from granter to grantee
+// We store by grantee first to allow searching by everyone who granted to you
+func FeeAllowanceKey(granter, grantee sdk.AccAddress) []byte {
+	return append(FeeAllowancePrefixByGrantee(grantee), granter.Bytes()...)
+}
+
+// FeeAllowancePrefixByGrantee returns a prefix to scan for all grants to this given address.
+func FeeAllowancePrefixByGrantee(grantee sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(FeeAllowanceKeyPrefix)+2*len(grantee))
+	key = append(key, FeeAllowanceKeyPrefix...)
+	return append(key, grantee.Bytes()...)
+}
+
+// ParseAddressesFromFeeAllowanceKey extracts the granter and grantee addresses
+// from a key built by FeeAllowanceKey.
+func ParseAddressesFromFeeAllowanceKey(key []byte) (granter, grantee sdk.AccAddress) {
+	// key is of format:
+	// 0x00<granteeAddressBytes><granterAddressBytes>
+	key = key[len(FeeAllowanceKeyPrefix):]
+	return sdk.AccAddress(key[sdk.AddrLen:]), sdk.AccAddress(key[:sdk.AddrLen])
+}
@@ -0,0 +1,141 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/codec"
+	"github.com/line/lfb-sdk/codec/types"
+	sdk "github.com/line/lfb-sdk/types"
+	feegranttypes "github.com/line/lfb-sdk/x/feegrant/types"
+)
+
+func TestMsgGrantAllowance(t *testing.T) {
+	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+	addr, _ := sdk.AccAddressFromBech32("link1kerpa33qxdwvrgms5qr7xegfxnewzm6s46ce7t")
+	addr2, _ := sdk.AccAddressFromBech32("link10czqumeld9k6dm32ud0xpqs0kn4m6xtrxy024r")
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	threeHours := time.Now().Add(3 * time.Hour)
+	basic := &feegranttypes.BasicAllowance{
+		SpendLimit: atom,
+		Expiration: &threeHours,
+	}
+
+	cases := map[string]struct {
+		grantee sdk.AccAddress
+		granter sdk.AccAddress
+		grant   *feegranttypes.BasicAllowance
+		valid   bool
+	}{
+		"valid": {
+			grantee: addr,
+			granter: addr2,
+			grant:   basic,
+			valid:   true,
+		},
+		"no grantee": {
+			granter: addr2,
+			grantee: sdk.AccAddress{},
+			grant:   basic,
+			valid:   false,
+		},
+		"no granter": {
+			granter: sdk.AccAddress{},
+			grantee: addr,
+			grant:   basic,
+			valid:   false,
+		},
+		"grantee == granter": {
+			grantee: addr,
+			granter: addr,
+			grant:   basic,
+			valid:   false,
+		},
+	}
+
+	for _, tc := range cases {
+		msg, err := feegranttypes.NewMsgGrantAllowance(tc.grant, tc.granter, tc.grantee)
+		require.NoError(t, err)
+		err = msg.ValidateBasic()
+
+		if tc.valid {
+			require.NoError(t, err)
+
+			addrSlice := msg.GetSigners()
+			require.Equal(t, tc.granter, addrSlice[0])
+
+			allowance, err := msg.GetFeeAllowanceI()
+			require.NoError(t, err)
+			require.Equal(t, tc.grant, allowance)
+
+			err = msg.UnpackInterfaces(cdc)
+			require.NoError(t, err)
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
+
+func TestMsgRevokeAllowance(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32("link1kerpa33qxdwvrgms5qr7xegfxnewzm6s46ce7t")
+	addr2, _ := sdk.AccAddressFromBech32("link10czqumeld9k6dm32ud0xpqs0kn4m6xtrxy024r")
+
+	cases := map[string]struct {
+		grantee sdk.AccAddress
+		granter sdk.AccAddress
+		valid   bool
+	}{
+		"valid": {
+			grantee: addr,
+			granter: addr2,
+			valid:   true,
+		},
+		"no grantee": {
+			granter: addr2,
+			grantee: sdk.AccAddress{},
+			valid:   false,
+		},
+		"no granter": {
+			granter: sdk.AccAddress{},
+			grantee: addr,
+			valid:   false,
+		},
+		"grantee == granter": {
+			grantee: addr,
+			granter: addr,
+			valid:   false,
+		},
+	}
+
+	for _, tc := range cases {
+		msg := feegranttypes.NewMsgRevokeAllowance(tc.granter, tc.grantee)
+		err := msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err)
+			addrSlice := msg.GetSigners()
+			require.Equal(t, tc.granter, addrSlice[0])
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
+
+func TestAminoJSON(t *testing.T) {
+	someDate := time.Date(1, 1, 1, 1, 1, 1, 1, time.UTC)
+	grantee, _ := sdk.AccAddressFromBech32("link1kerpa33qxdwvrgms5qr7xegfxnewzm6s46ce7t")
+	granter, _ := sdk.AccAddressFromBech32("link10czqumeld9k6dm32ud0xpqs0kn4m6xtrxy024r")
+	basic := &feegranttypes.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 100)),
+		Expiration: &someDate,
+	}
+
+	msgGrant, err := feegranttypes.NewMsgGrantAllowance(basic, granter, grantee)
+	require.NoError(t, err)
+	require.Contains(t, string(msgGrant.GetSignBytes()), `"type":"lfb-sdk/MsgGrantAllowance"`)
+	require.Contains(t, string(msgGrant.GetSignBytes()), `"type":"lfb-sdk/BasicAllowance"`)
+
+	msgRevoke := feegranttypes.NewMsgRevokeAllowance(granter, grantee)
+	require.Contains(t, string(msgRevoke.GetSignBytes()), `"type":"lfb-sdk/MsgRevokeAllowance"`)
+}
@@ -78,35 +78,29 @@ func QueryDepositsByTxQuery(clientCtx client.Context, params types.QueryProposal
 // marshalled result or any error that occurred.
 func QueryVotesByTxQuery(clientCtx client.Context, params types.QueryProposalVotesParams) ([]byte, error) {
 	var (
-		events = []string{
-			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, types.TypeMsgVote),
-			fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
-		}
 		votes      []types.Vote
 		nextTxPage = defaultPage
 		totalLimit = params.Limit * params.Page
 	)
 	// query interrupted either if we collected enough votes or tx indexer run out of relevant txs
 	for len(votes) < totalLimit {
-		searchResult, err := authclient.QueryTxsByEvents(clientCtx, events, nextTxPage, defaultLimit, "")
+		// search for both plain and weighted votes
+		txs, more, err := queryTxsByEventGroups(clientCtx, nextTxPage,
+			voteEvents(types.TypeMsgVote, params.ProposalID),
+			voteEvents(types.TypeMsgVoteWeighted, params.ProposalID),
+		)
 		if err != nil {
 			return nil, err
 		}
 		nextTxPage++
-		for _, info := range searchResult.Txs {
+		for _, info := range txs {
 			for _, msg := range info.GetTx().GetMsgs() {
-				if msg.Type() == types.TypeMsgVote {
-					voteMsg := msg.(*types.MsgVote)
-
-					votes = append(votes, types.Vote{
-						Voter:      voteMsg.Voter,
-						ProposalId: params.ProposalID,
-						Option:     voteMsg.Option,
-					})
+				if vote, ok := voteFromMsg(msg, params.ProposalID); ok {
+					votes = append(votes, vote)
 				}
 			}
 		}
-		if len(searchResult.Txs) != defaultLimit {
+		if !more {
 			break
 		}
 	}
@@ -127,30 +121,21 @@ func QueryVotesByTxQuery(clientCtx client.Context, params types.QueryProposalVot
 
 // QueryVoteByTxQuery will query for a single vote via a direct txs tags query.
 func QueryVoteByTxQuery(clientCtx client.Context, params types.QueryVoteParams) ([]byte, error) {
-	events := []string{
-		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, types.TypeMsgVote),
-		fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
-		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, []byte(params.Voter.String())),
-	}
+	sender := fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, []byte(params.Voter.String()))
 
 	// NOTE: SearchTxs is used to facilitate the txs query which does not currently
 	// support configurable pagination.
-	searchResult, err := authclient.QueryTxsByEvents(clientCtx, events, defaultPage, defaultLimit, "")
+	txs, _, err := queryTxsByEventGroups(clientCtx, defaultPage,
+		append(voteEvents(types.TypeMsgVote, params.ProposalID), sender),
+		append(voteEvents(types.TypeMsgVoteWeighted, params.ProposalID), sender),
+	)
 	if err != nil {
 		return nil, err
 	}
-	for _, info := range searchResult.Txs {
+	for _, info := range txs {
 		for _, msg := range info.GetTx().GetMsgs() {
 			// there should only be a single vote under the given conditions
-			if msg.Type() == types.TypeMsgVote {
-				voteMsg := msg.(*types.MsgVote)
-
-				vote := types.Vote{
-					Voter:      voteMsg.Voter,
-					ProposalId: params.ProposalID,
-					Option:     voteMsg.Option,
-				}
-
+			if vote, ok := voteFromMsg(msg, params.ProposalID); ok && vote.Voter == params.Voter.String() {
 				bz, err := clientCtx.JSONMarshaler.MarshalJSON(&vote)
 				if err != nil {
 					return nil, err
@@ -248,3 +233,57 @@ func QueryProposalByID(proposalID uint64, clientCtx client.Context, queryRoute s
 
 	return res, err
 }
+
+// voteEvents returns the events of a vote on the given proposal by a Msg of
+// the given type.
+func voteEvents(msgType string, proposalID uint64) []string {
+	return []string{
+		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, msgType),
+		fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", proposalID))),
+	}
+}
+
+// voteFromMsg builds the vote cast by a MsgVote or a MsgVoteWeighted.
+func voteFromMsg(msg sdk.Msg, proposalID uint64) (types.Vote, bool) {
+	switch msg := msg.(type) {
+	case *types.MsgVote:
+		return types.Vote{
+			Voter:      msg.Voter,
+			ProposalId: proposalID,
+			Option:     msg.Option,
+			Options:    types.NewNonSplitVoteOption(msg.Option),
+		}, true
+
+	case *types.MsgVoteWeighted:
+		return types.Vote{
+			Voter:      msg.Voter,
+			ProposalId: proposalID,
+			Options:    msg.Options,
+		}, true
+
+	default:
+		return types.Vote{}, false
+	}
+}
+
+// queryTxsByEventGroups queries the given page of txs matching all the events
+// of each group and returns the txs of all groups, together with whether any
+// group may have more pages.
+func queryTxsByEventGroups(clientCtx client.Context, page int, eventGroups ...[]string) ([]*sdk.TxResponse, bool, error) {
+	var (
+		txs  []*sdk.TxResponse
+		more bool
+	)
+	for _, events := range eventGroups {
+		searchResult, err := authclient.QueryTxsByEvents(clientCtx, events, page, defaultLimit, "")
+		if err != nil {
+			return nil, false, err
+		}
+		txs = append(txs, searchResult.Txs...)
+		if len(searchResult.Txs) == defaultLimit {
+			more = true
+		}
+	}
+
+	return txs, more, nil
+}
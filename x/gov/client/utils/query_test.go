@@ -2,6 +2,8 @@ package utils_test
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/line/ostracon/rpc/client/mock"
@@ -20,7 +22,8 @@ import (
 
 type TxSearchMock struct {
 	mock.Client
-	txs []osttypes.Tx
+	txDecoder sdk.TxDecoder
+	txs       []osttypes.Tx
 }
 
 func (mock TxSearchMock) TxSearch(ctx context.Context, query string, prove bool, page, perPage *int, orderBy string) (*ctypes.ResultTxSearch, error) {
@@ -32,12 +35,20 @@ func (mock TxSearchMock) TxSearch(ctx context.Context, query string, prove bool,
 		*perPage = 0
 	}
 
-	start, end := client.Paginate(len(mock.txs), *page, *perPage, 100)
+	// only the message action of the query is matched
+	matchedTxs := make([]osttypes.Tx, 0, len(mock.txs))
+	for _, tx := range mock.txs {
+		if mock.matchesAction(tx, query) {
+			matchedTxs = append(matchedTxs, tx)
+		}
+	}
+
+	start, end := client.Paginate(len(matchedTxs), *page, *perPage, 100)
 	if start < 0 || end < 0 {
 		// nil result with nil error crashes utils.QueryTxsByEvents
 		return &ctypes.ResultTxSearch{}, nil
 	}
-	txs := mock.txs[start:end]
+	txs := matchedTxs[start:end]
 	rst := &ctypes.ResultTxSearch{Txs: make([]*ctypes.ResultTx, len(txs)), TotalCount: len(txs)}
 	for i := range txs {
 		rst.Txs[i] = &ctypes.ResultTx{Tx: txs[i]}
@@ -45,6 +56,23 @@ func (mock TxSearchMock) TxSearch(ctx context.Context, query string, prove bool,
 	return rst, nil
 }
 
+func (mock TxSearchMock) matchesAction(txBytes osttypes.Tx, query string) bool {
+	if mock.txDecoder == nil {
+		return true
+	}
+
+	tx, err := mock.txDecoder(txBytes)
+	if err != nil {
+		return false
+	}
+	for _, msg := range tx.GetMsgs() {
+		if strings.Contains(query, fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, msg.Type())) {
+			return true
+		}
+	}
+	return false
+}
+
 func (mock TxSearchMock) Block(ctx context.Context, height *int64) (*ctypes.ResultBlock, error) {
 	// any non nil Block needs to be returned. used to get time value
 	return &ctypes.ResultBlock{Block: &osttypes.Block{}}, nil
@@ -77,6 +105,18 @@ func TestGetPaginatedVotes(t *testing.T) {
 		types.NewMsgVote(acc2, 0, types.OptionYes),
 		types.NewMsgVote(acc2, 0, types.OptionYes),
 	}
+	weightedOptions := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(7, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(3, 1)),
+	}
+	acc2WeightedMsgs := []sdk.Msg{
+		types.NewMsgVoteWeighted(acc2, 0, weightedOptions),
+	}
+	yesVote := func(voter sdk.AccAddress) types.Vote {
+		vote := types.NewVote(0, voter, types.NewNonSplitVoteOption(types.OptionYes))
+		vote.Option = types.OptionYes
+		return vote
+	}
 	for _, tc := range []testCase{
 		{
 			description: "1MsgPerTxAll",
@@ -87,8 +127,8 @@ func TestGetPaginatedVotes(t *testing.T) {
 				acc2Msgs[:1],
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc1, types.OptionYes),
-				types.NewVote(0, acc2, types.OptionYes)},
+				yesVote(acc1),
+				yesVote(acc2)},
 		},
 		{
 			description: "2MsgPerTx1Chunk",
@@ -99,8 +139,8 @@ func TestGetPaginatedVotes(t *testing.T) {
 				acc2Msgs,
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc1, types.OptionYes),
-				types.NewVote(0, acc1, types.OptionYes)},
+				yesVote(acc1),
+				yesVote(acc1)},
 		},
 		{
 			description: "2MsgPerTx2Chunk",
@@ -111,8 +151,8 @@ func TestGetPaginatedVotes(t *testing.T) {
 				acc2Msgs,
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc2, types.OptionYes),
-				types.NewVote(0, acc2, types.OptionYes)},
+				yesVote(acc2),
+				yesVote(acc2)},
 		},
 		{
 			description: "IncompleteSearchTx",
@@ -121,7 +161,19 @@ func TestGetPaginatedVotes(t *testing.T) {
 			msgs: [][]sdk.Msg{
 				acc1Msgs[:1],
 			},
-			votes: []types.Vote{types.NewVote(0, acc1, types.OptionYes)},
+			votes: []types.Vote{yesVote(acc1)},
+		},
+		{
+			description: "WeightedVotes",
+			page:        1,
+			limit:       2,
+			msgs: [][]sdk.Msg{
+				acc2WeightedMsgs,
+				acc1Msgs[:1],
+			},
+			votes: []types.Vote{
+				yesVote(acc1),
+				types.NewVote(0, acc2, weightedOptions)},
 		},
 		{
 			description: "InvalidPage",
@@ -148,7 +200,7 @@ func TestGetPaginatedVotes(t *testing.T) {
 			)
 
 			encodingConfig := simapp.MakeTestEncodingConfig()
-			cli := TxSearchMock{txs: marshalled}
+			cli := TxSearchMock{txDecoder: encodingConfig.TxConfig.TxDecoder(), txs: marshalled}
 			clientCtx := client.Context{}.
 				WithLegacyAmino(cdc).
 				WithClient(cli).
@@ -33,3 +33,46 @@ func TestNormalizeProposalStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizeWeightedVoteOptions(t *testing.T) {
+	cases := map[string]struct {
+		options    string
+		normalized string
+	}{
+		"simple Yes": {
+			options:    "Yes",
+			normalized: "VOTE_OPTION_YES=1",
+		},
+		"simple yes": {
+			options:    "yes",
+			normalized: "VOTE_OPTION_YES=1",
+		},
+		"formal yes": {
+			options:    "yes=1",
+			normalized: "VOTE_OPTION_YES=1",
+		},
+		"half yes half no": {
+			options:    "yes=0.5,no=0.5",
+			normalized: "VOTE_OPTION_YES=0.5,VOTE_OPTION_NO=0.5",
+		},
+		"3 options": {
+			options:    "Yes=0.5,No=0.4,NoWithVeto=0.1",
+			normalized: "VOTE_OPTION_YES=0.5,VOTE_OPTION_NO=0.4,VOTE_OPTION_NO_WITH_VETO=0.1",
+		},
+		"zero weight option": {
+			options:    "Yes=0.5,No=0.5,NoWithVeto=0",
+			normalized: "VOTE_OPTION_YES=0.5,VOTE_OPTION_NO=0.5,VOTE_OPTION_NO_WITH_VETO=0",
+		},
+		"invalid option": {
+			options:    "Yes=0.5,No=0.5,unknown=0.1",
+			normalized: "VOTE_OPTION_YES=0.5,VOTE_OPTION_NO=0.5,unknown=0.1",
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.normalized, utils.NormalizeWeightedVoteOptions(tc.options))
+		})
+	}
+}
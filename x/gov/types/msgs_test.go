@@ -119,6 +119,73 @@ func TestMsgVote(t *testing.T) {
 }
 
 // this tests that Amino JSON MsgSubmitProposal.GetSignBytes() still works with Content as Any using the ModuleCdc
+// test ValidateBasic for MsgVoteWeighted
+func TestMsgVoteWeighted(t *testing.T) {
+	tests := []struct {
+		proposalID uint64
+		voterAddr  sdk.AccAddress
+		options    WeightedVoteOptions
+		expectPass bool
+	}{
+		{0, addrs[0], NewNonSplitVoteOption(OptionYes), true},
+		{0, sdk.AccAddress{}, NewNonSplitVoteOption(OptionYes), false},
+		{0, addrs[0], NewNonSplitVoteOption(OptionNo), true},
+		{0, addrs[0], NewNonSplitVoteOption(OptionNoWithVeto), true},
+		{0, addrs[0], NewNonSplitVoteOption(OptionAbstain), true},
+		{0, addrs[0], WeightedVoteOptions{ // weight sum > 1
+			NewWeightedVoteOption(OptionYes, sdk.NewDec(1)),
+			NewWeightedVoteOption(OptionAbstain, sdk.NewDec(1)),
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{ // duplicate option
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(5, 1)),
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(5, 1)),
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{ // zero weight
+			NewWeightedVoteOption(OptionYes, sdk.NewDec(1)),
+			NewWeightedVoteOption(OptionNo, sdk.NewDec(0)),
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{ // negative weight
+			NewWeightedVoteOption(OptionYes, sdk.NewDec(1)),
+			NewWeightedVoteOption(OptionNo, sdk.NewDec(-1)),
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{}, false},
+		{0, addrs[0], NewNonSplitVoteOption(VoteOption(0x13)), false},
+		{0, addrs[0], WeightedVoteOptions{ // weight sum < 1
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(2, 1)),
+			NewWeightedVoteOption(OptionAbstain, sdk.NewDecWithPrec(2, 1)),
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(7, 1)),
+			NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(3, 1)),
+		}, true},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgVoteWeighted(tc.voterAddr, tc.proposalID, tc.options)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestWeightedVoteOptionsFromString(t *testing.T) {
+	options, err := WeightedVoteOptionsFromString("VOTE_OPTION_YES=0.7,VOTE_OPTION_NO=0.3")
+	require.NoError(t, err)
+	require.Equal(t, WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(7, 1)),
+		NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(3, 1)),
+	}, options)
+
+	_, err = WeightedVoteOptionsFromString("VOTE_OPTION_YES")
+	require.Error(t, err)
+	_, err = WeightedVoteOptionsFromString("VOTE_OPTION_MAYBE=1")
+	require.Error(t, err)
+	_, err = WeightedVoteOptionsFromString("VOTE_OPTION_YES=abc")
+	require.Error(t, err)
+}
+
 func TestMsgSubmitProposal_GetSignBytes(t *testing.T) {
 	msg, err := NewMsgSubmitProposal(NewTextProposal("test", "abcd"), sdk.NewCoins(), sdk.AccAddress{})
 	require.NoError(t, err)
@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/line/lfb-sdk/crypto/codec"
 	sdk "github.com/line/lfb-sdk/types"
 	"github.com/line/lfb-sdk/types/msgservice"
+	authztypes "github.com/line/lfb-sdk/x/authz/types"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/staking interfaces and concrete types
@@ -16,6 +17,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDelegate{}, "lfb-sdk/MsgDelegate", nil)
 	cdc.RegisterConcrete(&MsgUndelegate{}, "lfb-sdk/MsgUndelegate", nil)
 	cdc.RegisterConcrete(&MsgBeginRedelegate{}, "lfb-sdk/MsgBeginRedelegate", nil)
+	cdc.RegisterConcrete(&StakeAuthorization{}, "lfb-sdk/StakeAuthorization", nil)
 }
 
 // RegisterInterfaces registers the x/staking interfaces types with the interface registry
@@ -27,6 +29,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgUndelegate{},
 		&MsgBeginRedelegate{},
 	)
+	registry.RegisterImplementations(
+		(*authztypes.Authorization)(nil),
+		&StakeAuthorization{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -47,4 +53,7 @@ func init() {
 	RegisterLegacyAminoCodec(amino)
 	cryptocodec.RegisterCrypto(amino)
 	amino.Seal()
+
+	// the staking Msgs and authorization can be embedded in x/authz Msgs
+	RegisterLegacyAminoCodec(authztypes.Amino)
 }
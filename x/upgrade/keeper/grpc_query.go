@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
 	clienttypes "github.com/line/lfb-sdk/x/ibc/core/02-client/types"
 	"github.com/line/lfb-sdk/x/upgrade/types"
 )
@@ -52,3 +53,28 @@ func (k Keeper) UpgradedConsensusState(c context.Context, req *types.QueryUpgrad
 		UpgradedConsensusState: cs,
 	}, nil
 }
+
+// ModuleVersions implements the Query/QueryModuleVersions gRPC method
+func (k Keeper) ModuleVersions(c context.Context, req *types.QueryModuleVersionsRequest) (*types.QueryModuleVersionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// check if a specific module was requested
+	if len(req.ModuleName) > 0 {
+		if version, ok := k.GetModuleVersionMap(ctx)[req.ModuleName]; ok {
+			// return the requested module
+			res := []types.ModuleVersion{{Name: req.ModuleName, Version: version}}
+			return &types.QueryModuleVersionsResponse{ModuleVersions: res}, nil
+		}
+		// module requested, but not found
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "x/upgrade: QueryModuleVersions module %s not found", req.ModuleName)
+	}
+
+	// if no module requested return all module versions from state
+	mv := k.GetModuleVersions(ctx)
+	res := make([]types.ModuleVersion, len(mv))
+	for i, v := range mv {
+		res[i] = *v
+	}
+
+	return &types.QueryModuleVersionsResponse{ModuleVersions: res}, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/line/lfb-sdk/baseapp"
 	"github.com/line/lfb-sdk/simapp"
 	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/types/module"
 	"github.com/line/lfb-sdk/x/upgrade/types"
 )
 
@@ -110,7 +111,9 @@ func (suite *UpgradeTestSuite) TestAppliedCurrentPlan() {
 				suite.app.UpgradeKeeper.ScheduleUpgrade(suite.ctx, plan)
 
 				suite.ctx = suite.ctx.WithBlockHeight(expHeight)
-				suite.app.UpgradeKeeper.SetUpgradeHandler(planName, func(ctx sdk.Context, plan types.Plan) {})
+				suite.app.UpgradeKeeper.SetUpgradeHandler(planName, func(ctx sdk.Context, plan types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+					return vm, nil
+				})
 				suite.app.UpgradeKeeper.ApplyUpgrade(suite.ctx, plan)
 
 				req = &types.QueryAppliedPlanRequest{Name: planName}
@@ -138,6 +141,58 @@ func (suite *UpgradeTestSuite) TestAppliedCurrentPlan() {
 	}
 }
 
+func (suite *UpgradeTestSuite) TestModuleVersions() {
+	// the version map is stored at genesis by the simapp InitChainer
+	vm := suite.app.UpgradeKeeper.GetModuleVersionMap(suite.ctx)
+	suite.Require().NotEmpty(vm)
+
+	testCases := []struct {
+		msg     string
+		req     types.QueryModuleVersionsRequest
+		expLen  int
+		expPass bool
+	}{
+		{
+			"all module versions",
+			types.QueryModuleVersionsRequest{},
+			len(vm),
+			true,
+		},
+		{
+			"single module version",
+			types.QueryModuleVersionsRequest{ModuleName: "bank"},
+			1,
+			true,
+		},
+		{
+			"unknown module",
+			types.QueryModuleVersionsRequest{ModuleName: "foo"},
+			0,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			res, err := suite.queryClient.ModuleVersions(gocontext.Background(), &tc.req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(res.ModuleVersions, tc.expLen)
+				for i, mv := range res.ModuleVersions {
+					suite.Require().Equal(vm[mv.Name], mv.Version)
+					if i > 0 {
+						suite.Require().True(res.ModuleVersions[i-1].Name < mv.Name)
+					}
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func TestUpgradeTestSuite(t *testing.T) {
 	suite.Run(t, new(UpgradeTestSuite))
 }
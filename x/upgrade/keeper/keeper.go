@@ -7,6 +7,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
 
 	"github.com/line/ostracon/libs/log"
 	ostos "github.com/line/ostracon/libs/os"
@@ -16,6 +17,7 @@ import (
 	store "github.com/line/lfb-sdk/store/types"
 	sdk "github.com/line/lfb-sdk/types"
 	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	"github.com/line/lfb-sdk/types/module"
 	clienttypes "github.com/line/lfb-sdk/x/ibc/core/02-client/types"
 	ibcexported "github.com/line/lfb-sdk/x/ibc/core/exported"
 	"github.com/line/lfb-sdk/x/upgrade/types"
@@ -50,6 +52,70 @@ func (k Keeper) SetUpgradeHandler(name string, upgradeHandler types.UpgradeHandl
 	k.upgradeHandlers[name] = upgradeHandler
 }
 
+// SetModuleVersionMap saves a given version map to state
+func (k Keeper) SetModuleVersionMap(ctx sdk.Context, vm module.VersionMap) {
+	if len(vm) == 0 {
+		return
+	}
+
+	versionStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.VersionMapByte})
+	// Even though the underlying store (cachekv) store is sorted, we still
+	// prefer a deterministic iteration order of the map, to avoid undesired
+	// surprises if we ever change stores.
+	sortedModNames := make([]string, 0, len(vm))
+	for key := range vm {
+		sortedModNames = append(sortedModNames, key)
+	}
+	sort.Strings(sortedModNames)
+
+	for _, modName := range sortedModNames {
+		ver := vm[modName]
+		nameBytes := []byte(modName)
+		verBytes := make([]byte, 8)
+		binary.BigEndian.PutUint64(verBytes, ver)
+		versionStore.Set(nameBytes, verBytes)
+	}
+}
+
+// GetModuleVersionMap returns a map of key module name and value module consensus version
+func (k Keeper) GetModuleVersionMap(ctx sdk.Context) module.VersionMap {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, []byte{types.VersionMapByte})
+
+	vm := make(module.VersionMap)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		moduleBytes := it.Key()
+		// first byte is prefix key, so we remove it here
+		name := string(moduleBytes[1:])
+		moduleVersion := binary.BigEndian.Uint64(it.Value())
+		vm[name] = moduleVersion
+	}
+
+	return vm
+}
+
+// GetModuleVersions gets a slice of module consensus versions, sorted by
+// module name.
+func (k Keeper) GetModuleVersions(ctx sdk.Context) []*types.ModuleVersion {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, []byte{types.VersionMapByte})
+	defer it.Close()
+
+	mv := make([]*types.ModuleVersion, 0)
+	for ; it.Valid(); it.Next() {
+		moduleBytes := it.Key()
+		name := string(moduleBytes[1:])
+		moduleVersion := binary.BigEndian.Uint64(it.Value())
+		mv = append(mv, &types.ModuleVersion{
+			Name:    name,
+			Version: moduleVersion,
+		})
+	}
+
+	return mv
+}
+
 // ScheduleUpgrade schedules an upgrade based on the specified plan.
 // If there is another Plan already scheduled, it will overwrite it
 // (implicitly cancelling the current plan)
@@ -219,7 +285,12 @@ func (k Keeper) ApplyUpgrade(ctx sdk.Context, plan types.Plan) {
 		panic("ApplyUpgrade should never be called without first checking HasHandler")
 	}
 
-	handler(ctx, plan)
+	updatedVM, err := handler(ctx, plan, k.GetModuleVersionMap(ctx))
+	if err != nil {
+		panic(err)
+	}
+
+	k.SetModuleVersionMap(ctx, updatedVM)
 
 	// Must clear IBC state after upgrade is applied as it is stored separately from the upgrade plan.
 	// This will prevent resubmission of upgrade msg after upgrade is already completed.
@@ -237,6 +308,13 @@ func (k Keeper) IsSkipHeight(height int64) bool {
 
 // DumpUpgradeInfoToDisk writes upgrade information to UpgradeInfoFileName.
 func (k Keeper) DumpUpgradeInfoToDisk(height int64, name string) error {
+	return k.DumpUpgradeInfoWithInfoToDisk(height, name, "")
+}
+
+// DumpUpgradeInfoWithInfoToDisk writes upgrade information including the plan
+// info to UpgradeInfoFileName. Process managers like cosmovisor watch this file
+// to know when the node halted for an upgrade.
+func (k Keeper) DumpUpgradeInfoWithInfoToDisk(height int64, name string, info string) error {
 	upgradeInfoFilePath, err := k.GetUpgradeInfoPath()
 	if err != nil {
 		return err
@@ -245,13 +323,14 @@ func (k Keeper) DumpUpgradeInfoToDisk(height int64, name string) error {
 	upgradeInfo := store.UpgradeInfo{
 		Name:   name,
 		Height: height,
+		Info:   info,
 	}
-	info, err := json.Marshal(upgradeInfo)
+	bz, err := json.Marshal(upgradeInfo)
 	if err != nil {
 		return err
 	}
 
-	return ioutil.WriteFile(upgradeInfoFilePath, info, 0600)
+	return ioutil.WriteFile(upgradeInfoFilePath, bz, 0600)
 }
 
 // GetUpgradeInfoPath returns the upgrade info file path
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"path/filepath"
 	"testing"
 	"time"
@@ -9,8 +10,10 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/line/lfb-sdk/simapp"
+	"github.com/line/lfb-sdk/store/prefix"
 	store "github.com/line/lfb-sdk/store/types"
 	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/types/module"
 	clienttypes "github.com/line/lfb-sdk/x/ibc/core/02-client/types"
 	commitmenttypes "github.com/line/lfb-sdk/x/ibc/core/23-commitment/types"
 	ibcexported "github.com/line/lfb-sdk/x/ibc/core/exported"
@@ -58,6 +61,14 @@ func (s *KeeperTestSuite) TestReadUpgradeInfoFromDisk() {
 	ui, err := s.app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
 	s.Require().NoError(err)
 	s.Require().Equal(expected, ui)
+
+	// the plan info is written along
+	expected.Info = `{"binaries":{"any":"https://example.com/binary"}}`
+	s.Require().NoError(s.app.UpgradeKeeper.DumpUpgradeInfoWithInfoToDisk(expected.Height, expected.Name, expected.Info))
+
+	ui, err = s.app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	s.Require().NoError(err)
+	s.Require().Equal(expected, ui)
 }
 
 func (s *KeeperTestSuite) TestScheduleUpgrade() {
@@ -197,7 +208,7 @@ func (s *KeeperTestSuite) TestScheduleUpgrade() {
 				Height: 123450000,
 			},
 			setup: func() {
-				s.app.UpgradeKeeper.SetUpgradeHandler("all-good", func(_ sdk.Context, _ types.Plan) {})
+				s.app.UpgradeKeeper.SetUpgradeHandler("all-good", func(_ sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) { return vm, nil })
 				s.app.UpgradeKeeper.ApplyUpgrade(s.ctx, types.Plan{
 					Name:   "all-good",
 					Info:   "some text here",
@@ -296,6 +307,57 @@ func (s *KeeperTestSuite) TestSetUpgradedClient() {
 
 }
 
+func (s *KeeperTestSuite) TestSetGetModuleVersionMap() {
+	vm := module.VersionMap{"bank": 2, "foo": 1}
+	s.app.UpgradeKeeper.SetModuleVersionMap(s.ctx, vm)
+
+	got := s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx)
+	s.Require().Equal(uint64(2), got["bank"])
+	s.Require().Equal(uint64(1), got["foo"])
+
+	mv := s.app.UpgradeKeeper.GetModuleVersions(s.ctx)
+	s.Require().Len(mv, len(got))
+	for i := 1; i < len(mv); i++ {
+		s.Require().True(mv[i-1].Name < mv[i].Name)
+	}
+}
+
+func (s *KeeperTestSuite) TestApplyUpgradeStoresVersionMap() {
+	plan := types.Plan{Name: "versions", Height: 10}
+	s.app.UpgradeKeeper.SetUpgradeHandler(plan.Name, func(_ sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		s.Require().Equal(uint64(1), vm["bank"])
+		vm["bank"] = 2
+		return vm, nil
+	})
+	s.app.UpgradeKeeper.ApplyUpgrade(s.ctx, plan)
+	s.Require().Equal(uint64(2), s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx)["bank"])
+	s.Require().Equal(int64(10), s.app.UpgradeKeeper.GetDoneHeight(s.ctx, plan.Name))
+
+	// a failing handler aborts the upgrade
+	failing := types.Plan{Name: "failing", Height: 10}
+	s.app.UpgradeKeeper.SetUpgradeHandler(failing.Name, func(_ sdk.Context, _ types.Plan, _ module.VersionMap) (module.VersionMap, error) {
+		return nil, fmt.Errorf("migration failed")
+	})
+	s.Require().Panics(func() { s.app.UpgradeKeeper.ApplyUpgrade(s.ctx, failing) })
+}
+
+func (s *KeeperTestSuite) TestApplyUpgradeWithoutVersionMap() {
+	// a chain started before module versions were stored has no version map
+	store := prefix.NewStore(s.ctx.KVStore(s.app.GetKey(types.StoreKey)), []byte{types.VersionMapByte})
+	for name := range s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx) {
+		store.Delete([]byte(name))
+	}
+	s.Require().Empty(s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx))
+
+	plan := types.Plan{Name: "first-versioned", Height: 10}
+	s.app.UpgradeKeeper.SetUpgradeHandler(plan.Name, func(_ sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		s.Require().Empty(vm)
+		return module.VersionMap{"bank": 1}, nil
+	})
+	s.app.UpgradeKeeper.ApplyUpgrade(s.ctx, plan)
+	s.Require().Equal(module.VersionMap{"bank": 1}, s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx))
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
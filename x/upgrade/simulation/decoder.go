@@ -0,0 +1,30 @@
+package simulation
+
+import (
+	"fmt"
+
+	"github.com/line/lfb-sdk/codec"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/types/kv"
+	"github.com/line/lfb-sdk/x/upgrade/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding upgrade type.
+func NewDecodeStore(cdc codec.BinaryMarshaler) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch kvA.Key[0] {
+		case types.PlanByte:
+			var planA, planB types.Plan
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &planA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &planB)
+			return fmt.Sprintf("%v\n%v", planA, planB)
+
+		case types.DoneByte, types.VersionMapByte:
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		default:
+			panic(fmt.Sprintf("invalid upgrade key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/line/lfb-sdk/codec/types"
@@ -302,6 +303,102 @@ func (m *QueryUpgradedConsensusStateResponse) GetUpgradedConsensusState() *types
 	return nil
 }
 
+// QueryModuleVersionsRequest is the request type for the Query/ModuleVersions
+// RPC method.
+type QueryModuleVersionsRequest struct {
+	// module_name is a field to query a specific module
+	// consensus version from state. Leaving this empty will
+	// fetch the full list of module versions from state
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+}
+
+func (m *QueryModuleVersionsRequest) Reset()         { *m = QueryModuleVersionsRequest{} }
+func (m *QueryModuleVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleVersionsRequest) ProtoMessage()    {}
+func (*QueryModuleVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_771ba3c94d50f804, []int{6}
+}
+func (m *QueryModuleVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleVersionsRequest.Merge(m, src)
+}
+func (m *QueryModuleVersionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleVersionsRequest proto.InternalMessageInfo
+
+func (m *QueryModuleVersionsRequest) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+// QueryModuleVersionsResponse is the response type for the Query/ModuleVersions
+// RPC method.
+type QueryModuleVersionsResponse struct {
+	// module_versions is a list of module names with their consensus versions.
+	ModuleVersions []ModuleVersion `protobuf:"bytes,1,rep,name=module_versions,json=moduleVersions,proto3" json:"module_versions"`
+}
+
+func (m *QueryModuleVersionsResponse) Reset()         { *m = QueryModuleVersionsResponse{} }
+func (m *QueryModuleVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleVersionsResponse) ProtoMessage()    {}
+func (*QueryModuleVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_771ba3c94d50f804, []int{7}
+}
+func (m *QueryModuleVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleVersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleVersionsResponse.Merge(m, src)
+}
+func (m *QueryModuleVersionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleVersionsResponse proto.InternalMessageInfo
+
+func (m *QueryModuleVersionsResponse) GetModuleVersions() []ModuleVersion {
+	if m != nil {
+		return m.ModuleVersions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryCurrentPlanRequest)(nil), "lfb.upgrade.v1beta1.QueryCurrentPlanRequest")
 	proto.RegisterType((*QueryCurrentPlanResponse)(nil), "lfb.upgrade.v1beta1.QueryCurrentPlanResponse")
@@ -309,43 +406,51 @@ func init() {
 	proto.RegisterType((*QueryAppliedPlanResponse)(nil), "lfb.upgrade.v1beta1.QueryAppliedPlanResponse")
 	proto.RegisterType((*QueryUpgradedConsensusStateRequest)(nil), "lfb.upgrade.v1beta1.QueryUpgradedConsensusStateRequest")
 	proto.RegisterType((*QueryUpgradedConsensusStateResponse)(nil), "lfb.upgrade.v1beta1.QueryUpgradedConsensusStateResponse")
+	proto.RegisterType((*QueryModuleVersionsRequest)(nil), "lfb.upgrade.v1beta1.QueryModuleVersionsRequest")
+	proto.RegisterType((*QueryModuleVersionsResponse)(nil), "lfb.upgrade.v1beta1.QueryModuleVersionsResponse")
 }
 
 func init() { proto.RegisterFile("lfb/upgrade/v1beta1/query.proto", fileDescriptor_771ba3c94d50f804) }
 
 var fileDescriptor_771ba3c94d50f804 = []byte{
-	// 484 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x31, 0x8f, 0xd3, 0x30,
-	0x18, 0x6d, 0xa0, 0x9c, 0x84, 0xbb, 0x19, 0x54, 0xda, 0x0a, 0xe5, 0xb8, 0xdc, 0xc0, 0x81, 0xa8,
-	0xcd, 0x95, 0x01, 0x24, 0xc4, 0x50, 0x2a, 0x24, 0x58, 0x10, 0x14, 0xb1, 0xb0, 0x54, 0x4e, 0xe3,
-	0xa6, 0x11, 0x3e, 0xdb, 0x17, 0xdb, 0x88, 0xea, 0x74, 0x0b, 0xbf, 0x00, 0x84, 0xd8, 0xf8, 0x41,
-	0x8c, 0x95, 0x58, 0x18, 0x51, 0xcb, 0x0f, 0x41, 0x71, 0x5c, 0x94, 0x53, 0x7d, 0x11, 0xb7, 0x25,
-	0xf1, 0xfb, 0xde, 0x7b, 0xfe, 0xde, 0x0b, 0xd8, 0x65, 0xb3, 0x18, 0x1b, 0x99, 0xe6, 0x24, 0xa1,
-	0xf8, 0xc3, 0x61, 0x4c, 0x35, 0x39, 0xc4, 0xc7, 0x86, 0xe6, 0x0b, 0x24, 0x73, 0xa1, 0x05, 0xbc,
-	0xc6, 0x66, 0x31, 0x72, 0x00, 0xe4, 0x00, 0xbd, 0x6e, 0x2a, 0x44, 0xca, 0x28, 0xb6, 0x90, 0xd8,
-	0xcc, 0x30, 0xe1, 0x0e, 0xdf, 0xbb, 0xe9, 0x8e, 0x88, 0xcc, 0x30, 0xe1, 0x5c, 0x68, 0xa2, 0x33,
-	0xc1, 0x95, 0x3b, 0xdd, 0xf3, 0xc9, 0x6d, 0xd8, 0x2d, 0x24, 0xea, 0x82, 0x1b, 0xaf, 0x0b, 0xfd,
-	0x91, 0xc9, 0x73, 0xca, 0xf5, 0x2b, 0x46, 0xf8, 0x98, 0x1e, 0x1b, 0xaa, 0x74, 0xf4, 0x02, 0x74,
-	0xb6, 0x8f, 0x94, 0x14, 0x5c, 0x51, 0xd8, 0x07, 0x4d, 0xc9, 0x08, 0xef, 0x04, 0xb7, 0x82, 0x83,
-	0xd6, 0xa0, 0x8b, 0x3c, 0xb6, 0x91, 0x1d, 0xb0, 0xb0, 0xa8, 0xef, 0x54, 0x86, 0x52, 0xb2, 0x8c,
-	0x26, 0x15, 0x15, 0x08, 0x41, 0x93, 0x93, 0x23, 0x6a, 0x99, 0xae, 0x8e, 0xed, 0x73, 0x34, 0x70,
-	0xca, 0x67, 0xe0, 0x4e, 0xb9, 0x0d, 0x76, 0xe6, 0x34, 0x4b, 0xe7, 0xda, 0x4e, 0x5c, 0x1e, 0xbb,
-	0xb7, 0xe8, 0x19, 0x88, 0xec, 0xcc, 0xdb, 0xd2, 0x45, 0x32, 0x2a, 0xd0, 0x5c, 0x19, 0xf5, 0x46,
-	0x13, 0x4d, 0x37, 0x6a, 0xbb, 0xa0, 0xc5, 0x88, 0xd2, 0x93, 0x33, 0x14, 0xa0, 0xf8, 0xf4, 0xbc,
-	0xa4, 0x31, 0x60, 0xbf, 0x96, 0xc6, 0xb9, 0x78, 0x09, 0x3a, 0xee, 0xba, 0xc9, 0x64, 0xba, 0x81,
-	0x4c, 0x54, 0x81, 0x71, 0x3b, 0xb9, 0x8e, 0xca, 0x68, 0xd0, 0x26, 0x35, 0x34, 0xe4, 0x8b, 0x71,
-	0xdb, 0x78, 0x79, 0x07, 0x5f, 0x9a, 0xe0, 0x8a, 0xd5, 0x85, 0xdf, 0x02, 0xd0, 0xaa, 0x6c, 0x1c,
-	0xde, 0xf3, 0xee, 0xf6, 0x9c, 0xcc, 0x7a, 0xfd, 0xff, 0x44, 0x97, 0xd7, 0x88, 0xee, 0x7c, 0xfa,
-	0xf9, 0xe7, 0xeb, 0xa5, 0x7d, 0xb8, 0x87, 0x7d, 0x4d, 0x99, 0x96, 0x13, 0x93, 0x22, 0x42, 0xf8,
-	0x3d, 0x00, 0xad, 0x4a, 0x1e, 0x75, 0xbe, 0xb6, 0x53, 0xae, 0xf3, 0xe5, 0x09, 0x39, 0xba, 0x6f,
-	0x7d, 0xdd, 0x85, 0x07, 0x5e, 0x5f, 0xa4, 0x9c, 0xb0, 0xbe, 0xf0, 0x49, 0xd1, 0x98, 0x53, 0xb8,
-	0x0c, 0x40, 0xdb, 0x9f, 0x19, 0x7c, 0x78, 0xbe, 0x76, 0x6d, 0x59, 0x7a, 0x8f, 0x2e, 0x3e, 0xe8,
-	0xfc, 0x8f, 0xac, 0xff, 0x27, 0xf0, 0x31, 0xae, 0xf9, 0x03, 0xb7, 0x9a, 0x83, 0x4f, 0x2a, 0xdd,
-	0x3c, 0x7d, 0x3a, 0xfc, 0xb1, 0x0a, 0x83, 0xe5, 0x2a, 0x0c, 0x7e, 0xaf, 0xc2, 0xe0, 0xf3, 0x3a,
-	0x6c, 0x2c, 0xd7, 0x61, 0xe3, 0xd7, 0x3a, 0x6c, 0xbc, 0xbb, 0x9d, 0x66, 0x7a, 0x6e, 0x62, 0x34,
-	0x15, 0x47, 0x98, 0x65, 0x9c, 0x16, 0x2a, 0x7d, 0x95, 0xbc, 0xc7, 0x1f, 0xff, 0x69, 0xe9, 0x85,
-	0xa4, 0x2a, 0xde, 0xb1, 0xe5, 0x7b, 0xf0, 0x37, 0x00, 0x00, 0xff, 0xff, 0x61, 0x3c, 0xe9, 0xe3,
-	0x78, 0x04, 0x00, 0x00,
+	// 592 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x6e, 0xd3, 0x3e,
+	0x1c, 0x6f, 0x7e, 0xfb, 0x23, 0xfd, 0x5c, 0x69, 0x48, 0x66, 0x2a, 0x6d, 0x40, 0xe9, 0x96, 0x21,
+	0x28, 0x68, 0x8d, 0xb7, 0x72, 0x00, 0x09, 0xed, 0xd0, 0x55, 0x48, 0x70, 0x60, 0x62, 0x45, 0x70,
+	0xe0, 0x52, 0x39, 0x8d, 0x9b, 0x46, 0xa4, 0x76, 0x16, 0x3b, 0x13, 0xd5, 0xb4, 0x0b, 0x4f, 0x80,
+	0x84, 0xb8, 0x71, 0xe1, 0x21, 0x78, 0x87, 0x1d, 0x2b, 0x71, 0xe1, 0x84, 0x50, 0xcb, 0x83, 0xa0,
+	0x38, 0x2e, 0x4a, 0xa9, 0x5b, 0x95, 0x9b, 0x6b, 0x7f, 0xfe, 0xd9, 0xdf, 0x4f, 0x0a, 0xaa, 0x61,
+	0xcf, 0x45, 0x49, 0xe4, 0xc7, 0xd8, 0x23, 0xe8, 0xfc, 0xd0, 0x25, 0x02, 0x1f, 0xa2, 0xb3, 0x84,
+	0xc4, 0x43, 0x27, 0x8a, 0x99, 0x60, 0xf0, 0x7a, 0xd8, 0x73, 0x1d, 0x05, 0x70, 0x14, 0xc0, 0xac,
+	0xf8, 0x8c, 0xf9, 0x21, 0x41, 0x12, 0xe2, 0x26, 0x3d, 0x84, 0xa9, 0xc2, 0x9b, 0xdb, 0x3e, 0xf3,
+	0x99, 0x5c, 0xa2, 0x74, 0xa5, 0x76, 0x6f, 0x29, 0x02, 0x8e, 0x02, 0x84, 0x29, 0x65, 0x02, 0x8b,
+	0x80, 0x51, 0xae, 0x4e, 0x77, 0x75, 0x21, 0xa6, 0x9e, 0x12, 0x62, 0x57, 0xc0, 0x8d, 0xd3, 0x34,
+	0x55, 0x2b, 0x89, 0x63, 0x42, 0xc5, 0x8b, 0x10, 0xd3, 0x36, 0x39, 0x4b, 0x08, 0x17, 0xf6, 0x33,
+	0x50, 0x9e, 0x3f, 0xe2, 0x11, 0xa3, 0x9c, 0xc0, 0x3a, 0x58, 0x8f, 0x42, 0x4c, 0xcb, 0xc6, 0x8e,
+	0x51, 0x2b, 0x36, 0x2a, 0x8e, 0xe6, 0x32, 0x8e, 0x24, 0x48, 0x98, 0x5d, 0x57, 0x2e, 0xcd, 0x28,
+	0x0a, 0x03, 0xe2, 0xe5, 0x5c, 0x20, 0x04, 0xeb, 0x14, 0x0f, 0x88, 0x54, 0xfa, 0xbf, 0x2d, 0xd7,
+	0x76, 0x03, 0x94, 0xe7, 0xe1, 0xca, 0xb9, 0x04, 0x36, 0xfb, 0x24, 0xf0, 0xfb, 0x42, 0x32, 0xd6,
+	0xda, 0xea, 0x97, 0xfd, 0x04, 0xd8, 0x92, 0xf3, 0x2a, 0x4b, 0xe1, 0xb5, 0x52, 0x34, 0xe5, 0x09,
+	0x7f, 0x29, 0xb0, 0x20, 0x53, 0xb7, 0x2a, 0x28, 0x86, 0x98, 0x8b, 0xce, 0x8c, 0x04, 0x48, 0xb7,
+	0x9e, 0x66, 0x32, 0x09, 0xd8, 0x5b, 0x2a, 0xa3, 0x52, 0x9c, 0x80, 0xb2, 0xba, 0xae, 0xd7, 0xe9,
+	0x4e, 0x21, 0x1d, 0x9e, 0x62, 0xd4, 0x9b, 0x6c, 0x3b, 0xd9, 0x68, 0x9c, 0xe9, 0x2c, 0x9d, 0x26,
+	0x1d, 0xb6, 0x4b, 0x89, 0x56, 0xd7, 0x3e, 0x02, 0xa6, 0xb4, 0x7d, 0xce, 0xbc, 0x24, 0x24, 0xaf,
+	0x49, 0xcc, 0xd3, 0x31, 0xe6, 0x52, 0x0f, 0xe4, 0x41, 0x27, 0xf7, 0x54, 0x20, 0xdb, 0x3a, 0x49,
+	0x1f, 0x2c, 0x02, 0x37, 0xb5, 0x74, 0x95, 0xf6, 0x14, 0x5c, 0x53, 0xfc, 0x73, 0x75, 0x54, 0x36,
+	0x76, 0xd6, 0x6a, 0xc5, 0x86, 0xad, 0x1d, 0xdc, 0x8c, 0xca, 0xf1, 0xfa, 0xd5, 0x8f, 0x6a, 0xa1,
+	0xbd, 0x35, 0x98, 0x91, 0x6e, 0x7c, 0xdd, 0x00, 0x1b, 0xd2, 0x12, 0x7e, 0x32, 0x40, 0x31, 0x57,
+	0x11, 0xb8, 0xaf, 0xd5, 0x5c, 0x50, 0x32, 0xb3, 0xbe, 0x22, 0x3a, 0xbb, 0x89, 0x7d, 0xef, 0xfd,
+	0xb7, 0x5f, 0x1f, 0xff, 0xdb, 0x83, 0xbb, 0x48, 0x57, 0xed, 0x6e, 0xc6, 0xe8, 0xa4, 0x9d, 0x83,
+	0x9f, 0x0d, 0x50, 0xcc, 0x15, 0x68, 0x59, 0xae, 0xf9, 0x5a, 0x9a, 0xf5, 0x15, 0xd1, 0x2a, 0xd7,
+	0x81, 0xcc, 0x75, 0x1f, 0xd6, 0xb4, 0xb9, 0x70, 0xc6, 0x90, 0xb9, 0xd0, 0x45, 0x3a, 0xc3, 0x4b,
+	0x38, 0x32, 0x40, 0x49, 0x5f, 0x32, 0xf8, 0x70, 0xb1, 0xf7, 0xd2, 0x76, 0x9b, 0x8f, 0xfe, 0x9d,
+	0xa8, 0xf2, 0xb7, 0x64, 0xfe, 0x23, 0xf8, 0x18, 0x2d, 0xf9, 0xcb, 0x98, 0xab, 0x3a, 0xba, 0xc8,
+	0x7d, 0x4c, 0x97, 0xf0, 0x8b, 0x01, 0xb6, 0x66, 0x1b, 0x08, 0xd1, 0xe2, 0x44, 0xda, 0xaa, 0x9b,
+	0x07, 0xab, 0x13, 0x54, 0xf4, 0x7d, 0x19, 0xfd, 0x0e, 0xbc, 0xad, 0x8d, 0xfe, 0x57, 0xef, 0x8f,
+	0x9b, 0x57, 0x63, 0xcb, 0x18, 0x8d, 0x2d, 0xe3, 0xe7, 0xd8, 0x32, 0x3e, 0x4c, 0xac, 0xc2, 0x68,
+	0x62, 0x15, 0xbe, 0x4f, 0xac, 0xc2, 0x9b, 0xbb, 0x7e, 0x20, 0xfa, 0x89, 0xeb, 0x74, 0xd9, 0x00,
+	0x85, 0x01, 0x25, 0xa9, 0x5c, 0x9d, 0x7b, 0x6f, 0xd1, 0xbb, 0x3f, 0xa2, 0x62, 0x18, 0x11, 0xee,
+	0x6e, 0xca, 0x2f, 0xfa, 0xc1, 0xef, 0x01, 0x00, 0x8a, 0xee, 0xeb, 0x4c, 0xe3, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -369,6 +474,8 @@ type QueryClient interface {
 	// stored at the last height of this chain.
 	// UpgradedConsensusState RPC not supported with legacy querier
 	UpgradedConsensusState(ctx context.Context, in *QueryUpgradedConsensusStateRequest, opts ...grpc.CallOption) (*QueryUpgradedConsensusStateResponse, error)
+	// ModuleVersions queries the list of module versions from state.
+	ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error)
 }
 
 type queryClient struct {
@@ -406,6 +513,15 @@ func (c *queryClient) UpgradedConsensusState(ctx context.Context, in *QueryUpgra
 	return out, nil
 }
 
+func (c *queryClient) ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error) {
+	out := new(QueryModuleVersionsResponse)
+	err := c.cc.Invoke(ctx, "/lfb.upgrade.v1beta1.Query/ModuleVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// CurrentPlan queries the current upgrade plan.
@@ -417,6 +533,8 @@ type QueryServer interface {
 	// stored at the last height of this chain.
 	// UpgradedConsensusState RPC not supported with legacy querier
 	UpgradedConsensusState(context.Context, *QueryUpgradedConsensusStateRequest) (*QueryUpgradedConsensusStateResponse, error)
+	// ModuleVersions queries the list of module versions from state.
+	ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -432,6 +550,9 @@ func (*UnimplementedQueryServer) AppliedPlan(ctx context.Context, req *QueryAppl
 func (*UnimplementedQueryServer) UpgradedConsensusState(ctx context.Context, req *QueryUpgradedConsensusStateRequest) (*QueryUpgradedConsensusStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpgradedConsensusState not implemented")
 }
+func (*UnimplementedQueryServer) ModuleVersions(ctx context.Context, req *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleVersions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -491,6 +612,24 @@ func _Query_UpgradedConsensusState_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ModuleVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lfb.upgrade.v1beta1.Query/ModuleVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleVersions(ctx, req.(*QueryModuleVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lfb.upgrade.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -507,6 +646,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpgradedConsensusState",
 			Handler:    _Query_UpgradedConsensusState_Handler,
 		},
+		{
+			MethodName: "ModuleVersions",
+			Handler:    _Query_ModuleVersions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lfb/upgrade/v1beta1/query.proto",
@@ -691,6 +834,73 @@ func (m *QueryUpgradedConsensusStateResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryModuleVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModuleVersions) > 0 {
+		for iNdEx := len(m.ModuleVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ModuleVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -774,6 +984,34 @@ func (m *QueryUpgradedConsensusStateResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryModuleVersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryModuleVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ModuleVersions) > 0 {
+		for _, e := range m.ModuleVersions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1222,6 +1460,172 @@ func (m *QueryUpgradedConsensusStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryModuleVersionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleVersionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleVersionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleVersionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleVersions = append(m.ModuleVersions, ModuleVersion{})
+			if err := m.ModuleVersions[len(m.ModuleVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -8,8 +8,8 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	types "github.com/line/lfb-sdk/codec/types"
-	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -166,43 +166,87 @@ func (m *CancelSoftwareUpgradeProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CancelSoftwareUpgradeProposal proto.InternalMessageInfo
 
+// ModuleVersion specifies a module and its consensus version.
+type ModuleVersion struct {
+	// name of the app module
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// consensus version of the app module
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *ModuleVersion) Reset()         { *m = ModuleVersion{} }
+func (m *ModuleVersion) String() string { return proto.CompactTextString(m) }
+func (*ModuleVersion) ProtoMessage()    {}
+func (*ModuleVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f163ec069f25263, []int{3}
+}
+func (m *ModuleVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleVersion.Merge(m, src)
+}
+func (m *ModuleVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleVersion proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Plan)(nil), "lfb.upgrade.v1beta1.Plan")
 	proto.RegisterType((*SoftwareUpgradeProposal)(nil), "lfb.upgrade.v1beta1.SoftwareUpgradeProposal")
 	proto.RegisterType((*CancelSoftwareUpgradeProposal)(nil), "lfb.upgrade.v1beta1.CancelSoftwareUpgradeProposal")
+	proto.RegisterType((*ModuleVersion)(nil), "lfb.upgrade.v1beta1.ModuleVersion")
 }
 
 func init() { proto.RegisterFile("lfb/upgrade/v1beta1/upgrade.proto", fileDescriptor_6f163ec069f25263) }
 
 var fileDescriptor_6f163ec069f25263 = []byte{
-	// 424 bytes of a gzipped FileDescriptorProto
+	// 457 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0x31, 0x6f, 0xd4, 0x30,
-	0x18, 0x8d, 0x69, 0x5a, 0x51, 0xdf, 0xe6, 0x1e, 0x90, 0x9e, 0xc0, 0x09, 0x59, 0xb8, 0x05, 0x5b,
-	0x6d, 0x17, 0xd4, 0x8d, 0x74, 0x62, 0xab, 0x52, 0x58, 0x90, 0x50, 0xe5, 0x24, 0x4e, 0xce, 0xe0,
-	0xd8, 0x51, 0xe2, 0x03, 0xf2, 0x1f, 0x18, 0xfa, 0x13, 0xf8, 0x39, 0x37, 0x76, 0xec, 0x54, 0xe8,
-	0xdd, 0xc2, 0xdc, 0x5f, 0x80, 0xe2, 0x24, 0x08, 0x41, 0xc7, 0x6e, 0xef, 0x7b, 0x7a, 0xdf, 0x7b,
-	0xf6, 0xb3, 0xe1, 0x73, 0x99, 0x27, 0x74, 0x59, 0x15, 0x35, 0xcb, 0x38, 0xfd, 0x7c, 0x90, 0x70,
-	0xc3, 0x0e, 0xc6, 0x99, 0x54, 0xb5, 0x36, 0x1a, 0xed, 0xc9, 0x3c, 0x21, 0x23, 0x35, 0x48, 0x66,
-	0xfb, 0x85, 0xd6, 0x85, 0xe4, 0xd4, 0x4a, 0x92, 0x65, 0x4e, 0x99, 0x6a, 0x7b, 0xfd, 0x6c, 0x5a,
-	0xe8, 0x42, 0x5b, 0x48, 0x3b, 0x34, 0xb0, 0xfe, 0xbf, 0x0b, 0x46, 0x94, 0xbc, 0x31, 0xac, 0xac,
-	0x7a, 0x41, 0x78, 0x0b, 0xa0, 0x7b, 0x2a, 0x99, 0x42, 0x08, 0xba, 0x8a, 0x95, 0xdc, 0x03, 0x01,
-	0x98, 0xef, 0xc6, 0x16, 0xa3, 0x57, 0xd0, 0xed, 0xf4, 0xde, 0x83, 0x00, 0xcc, 0x27, 0x87, 0x33,
-	0xd2, 0x9b, 0x91, 0xd1, 0x8c, 0xbc, 0x1d, 0xcd, 0xa2, 0x87, 0xab, 0x6b, 0xdf, 0xb9, 0xf8, 0xe1,
-	0x83, 0xd8, 0x6e, 0xa0, 0xc7, 0x70, 0x67, 0xc1, 0x45, 0xb1, 0x30, 0xde, 0x56, 0x00, 0xe6, 0x5b,
-	0xf1, 0x30, 0x75, 0x29, 0x42, 0xe5, 0xda, 0x73, 0xfb, 0x94, 0x0e, 0xa3, 0x8f, 0xf0, 0xd1, 0x70,
-	0xcf, 0xec, 0x3c, 0x95, 0x82, 0x2b, 0x73, 0xde, 0x18, 0x66, 0xb8, 0xb7, 0x6d, 0x63, 0xa7, 0xff,
-	0xc5, 0xbe, 0x56, 0x6d, 0x14, 0xdc, 0x5e, 0xfb, 0x4f, 0x5b, 0x56, 0xca, 0xe3, 0xf0, 0xce, 0xe5,
-	0x30, 0xde, 0x1b, 0xf9, 0x13, 0x4b, 0x9f, 0x75, 0xec, 0xb1, 0xfb, 0xeb, 0xbb, 0x0f, 0xc2, 0x6f,
-	0x00, 0x3e, 0x39, 0xd3, 0xb9, 0xf9, 0xc2, 0x6a, 0xfe, 0xae, 0x57, 0x9d, 0xd6, 0xba, 0xd2, 0x0d,
-	0x93, 0x68, 0x0a, 0xb7, 0x8d, 0x30, 0x72, 0x2c, 0xa2, 0x1f, 0x50, 0x00, 0x27, 0x19, 0x6f, 0xd2,
-	0x5a, 0x54, 0x46, 0x68, 0x65, 0x0b, 0xd9, 0x8d, 0xff, 0xa6, 0xd0, 0x11, 0x74, 0x2b, 0xc9, 0x94,
-	0xbd, 0xef, 0xe4, 0x70, 0x9f, 0xdc, 0xf1, 0x7c, 0xa4, 0x2b, 0x3a, 0x72, 0xbb, 0xaa, 0x62, 0x2b,
-	0x1e, 0x8e, 0xf3, 0x01, 0x3e, 0x3b, 0x61, 0x2a, 0xe5, 0xf2, 0x9e, 0xcf, 0xd4, 0xdb, 0x47, 0x6f,
-	0x56, 0x37, 0xd8, 0xb9, 0xba, 0xc1, 0xce, 0x6a, 0x8d, 0xc1, 0xe5, 0x1a, 0x83, 0x9f, 0x6b, 0x0c,
-	0x2e, 0x36, 0xd8, 0xb9, 0xdc, 0x60, 0xe7, 0x6a, 0x83, 0x9d, 0xf7, 0x2f, 0x0a, 0x61, 0x16, 0xcb,
-	0x84, 0xa4, 0xba, 0xa4, 0x52, 0x28, 0x4e, 0x65, 0x9e, 0xbc, 0x6c, 0xb2, 0x4f, 0xf4, 0xeb, 0x9f,
-	0x4f, 0x6a, 0xda, 0x8a, 0x37, 0xc9, 0x8e, 0x7d, 0x83, 0xa3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x14, 0x97, 0x7b, 0x56, 0xc0, 0x02, 0x00, 0x00,
+	0x14, 0x8e, 0x69, 0x5a, 0x5a, 0x9f, 0x58, 0xdc, 0x03, 0xd2, 0x13, 0x24, 0xe1, 0x16, 0x6e, 0xc1,
+	0x56, 0xdb, 0x05, 0xdd, 0xc6, 0x75, 0x40, 0x0c, 0x48, 0x55, 0x0a, 0x0c, 0x48, 0xa8, 0x72, 0x2e,
+	0x4e, 0xce, 0xe0, 0xd8, 0x51, 0xe2, 0x2b, 0xe4, 0x3f, 0x30, 0x74, 0x64, 0xec, 0xcf, 0xb9, 0xb1,
+	0x63, 0xa7, 0x42, 0xef, 0x16, 0xe6, 0xfe, 0x02, 0x64, 0x27, 0x41, 0x08, 0x6e, 0x64, 0x7b, 0xef,
+	0xcb, 0xf7, 0xbe, 0xcf, 0xef, 0x7b, 0x81, 0x4f, 0x44, 0x1a, 0x93, 0x79, 0x91, 0x95, 0x34, 0x61,
+	0xe4, 0x6c, 0x3f, 0x66, 0x9a, 0xee, 0x77, 0x3d, 0x2e, 0x4a, 0xa5, 0x15, 0xda, 0x15, 0x69, 0x8c,
+	0x3b, 0xa8, 0xa5, 0x0c, 0xf6, 0x32, 0xa5, 0x32, 0xc1, 0x88, 0xa5, 0xc4, 0xf3, 0x94, 0x50, 0x59,
+	0x37, 0xfc, 0x41, 0x3f, 0x53, 0x99, 0xb2, 0x25, 0x31, 0x55, 0x8b, 0x06, 0x7f, 0x0f, 0x68, 0x9e,
+	0xb3, 0x4a, 0xd3, 0xbc, 0x68, 0x08, 0xc3, 0x5b, 0x00, 0xdd, 0x63, 0x41, 0x25, 0x42, 0xd0, 0x95,
+	0x34, 0x67, 0x1e, 0x08, 0xc1, 0x68, 0x27, 0xb2, 0x35, 0x7a, 0x0e, 0x5d, 0xc3, 0xf7, 0xee, 0x84,
+	0x60, 0xd4, 0x3b, 0x18, 0xe0, 0x46, 0x0c, 0x77, 0x62, 0xf8, 0x4d, 0x27, 0x36, 0xd9, 0x5e, 0x5c,
+	0x07, 0xce, 0xf9, 0xf7, 0x00, 0x44, 0x76, 0x02, 0x3d, 0x80, 0x5b, 0x33, 0xc6, 0xb3, 0x99, 0xf6,
+	0x36, 0x42, 0x30, 0xda, 0x88, 0xda, 0xce, 0xb8, 0x70, 0x99, 0x2a, 0xcf, 0x6d, 0x5c, 0x4c, 0x8d,
+	0x3e, 0xc2, 0xfb, 0xed, 0x9e, 0xc9, 0xe9, 0x54, 0x70, 0x26, 0xf5, 0x69, 0xa5, 0xa9, 0x66, 0xde,
+	0xa6, 0xb5, 0xed, 0xff, 0x63, 0xfb, 0x42, 0xd6, 0x93, 0xf0, 0xf6, 0x3a, 0x78, 0x54, 0xd3, 0x5c,
+	0x8c, 0x87, 0x6b, 0x87, 0x87, 0xd1, 0x6e, 0x87, 0x1f, 0x59, 0xf8, 0xc4, 0xa0, 0x63, 0xf7, 0xe7,
+	0x45, 0x00, 0x86, 0x5f, 0x01, 0x7c, 0x78, 0xa2, 0x52, 0xfd, 0x99, 0x96, 0xec, 0x6d, 0xc3, 0x3a,
+	0x2e, 0x55, 0xa1, 0x2a, 0x2a, 0x50, 0x1f, 0x6e, 0x6a, 0xae, 0x45, 0x17, 0x44, 0xd3, 0xa0, 0x10,
+	0xf6, 0x12, 0x56, 0x4d, 0x4b, 0x5e, 0x68, 0xae, 0xa4, 0x0d, 0x64, 0x27, 0xfa, 0x13, 0x42, 0x87,
+	0xd0, 0x2d, 0x04, 0x95, 0x76, 0xdf, 0xde, 0xc1, 0x1e, 0x5e, 0x73, 0x3e, 0x6c, 0x82, 0x9e, 0xb8,
+	0x26, 0xaa, 0xc8, 0x92, 0xdb, 0xe7, 0x7c, 0x80, 0x8f, 0x8f, 0xa8, 0x9c, 0x32, 0xf1, 0x9f, 0xdf,
+	0xd4, 0xca, 0xbf, 0x84, 0xf7, 0x5e, 0xab, 0x64, 0x2e, 0xd8, 0x3b, 0x56, 0x56, 0x5c, 0xad, 0x3f,
+	0xb5, 0x07, 0xef, 0x9e, 0x35, 0x9f, 0xad, 0x90, 0x1b, 0x75, 0xed, 0x78, 0xfb, 0xdb, 0x45, 0x00,
+	0x8c, 0xd0, 0xe4, 0xd5, 0xe2, 0xc6, 0x77, 0xae, 0x6e, 0x7c, 0x67, 0xb1, 0xf4, 0xc1, 0xe5, 0xd2,
+	0x07, 0x3f, 0x96, 0x3e, 0x38, 0x5f, 0xf9, 0xce, 0xe5, 0xca, 0x77, 0xae, 0x56, 0xbe, 0xf3, 0xfe,
+	0x69, 0xc6, 0xf5, 0x6c, 0x1e, 0xe3, 0xa9, 0xca, 0x89, 0xe0, 0x92, 0x11, 0x91, 0xc6, 0xcf, 0xaa,
+	0xe4, 0x13, 0xf9, 0xf2, 0xfb, 0x6f, 0xd7, 0x75, 0xc1, 0xaa, 0x78, 0xcb, 0x1e, 0xf3, 0xf0, 0xd7,
+	0x00, 0x5f, 0xac, 0x6d, 0xb7, 0x09, 0x03, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -298,6 +342,33 @@ func (this *CancelSoftwareUpgradeProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ModuleVersion) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ModuleVersion)
+	if !ok {
+		that2, ok := that.(ModuleVersion)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
+}
 func (m *Plan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -444,6 +515,41 @@ func (m *CancelSoftwareUpgradeProposal) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *ModuleVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintUpgrade(dAtA []byte, offset int, v uint64) int {
 	offset -= sovUpgrade(v)
 	base := offset
@@ -517,6 +623,22 @@ func (m *CancelSoftwareUpgradeProposal) Size() (n int) {
 	return n
 }
 
+func (m *ModuleVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovUpgrade(uint64(m.Version))
+	}
+	return n
+}
+
 func sovUpgrade(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -986,6 +1108,107 @@ func (m *CancelSoftwareUpgradeProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ModuleVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipUpgrade(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
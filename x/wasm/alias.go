@@ -31,6 +31,10 @@ const (
 	QueryGetContractState           = keeper.QueryGetContractState
 	QueryGetCode                    = keeper.QueryGetCode
 	QueryListCode                   = keeper.QueryListCode
+	QueryListPinnedCode             = keeper.QueryListPinnedCode
+	QueryListCodeByHash             = keeper.QueryListCodeByHash
+	QueryListContractByCreator      = keeper.QueryListContractByCreator
+	QueryListContractByAdmin        = keeper.QueryListContractByAdmin
 	QueryMethodContractStateSmart   = keeper.QueryMethodContractStateSmart
 	QueryMethodContractStateAll     = keeper.QueryMethodContractStateAll
 	QueryMethodContractStateRaw     = keeper.QueryMethodContractStateRaw
@@ -38,40 +42,43 @@ const (
 
 var (
 	// functions aliases
-	RegisterCodec             = types.RegisterLegacyAminoCodec
-	RegisterInterfaces        = types.RegisterInterfaces
-	ValidateGenesis           = types.ValidateGenesis
-	ConvertToProposals        = types.ConvertToProposals
-	GetCodeKey                = types.GetCodeKey
-	GetContractAddressKey     = types.GetContractAddressKey
-	GetContractStorePrefixKey = types.GetContractStorePrefix
-	NewCodeInfo               = types.NewCodeInfo
-	NewAbsoluteTxPosition     = types.NewAbsoluteTxPosition
-	NewContractInfo           = types.NewContractInfo
-	NewEnv                    = types.NewEnv
-	NewWasmCoins              = types.NewWasmCoins
-	ParseEvents               = types.ParseEvents
-	DefaultWasmConfig         = types.DefaultWasmConfig
-	DefaultParams             = types.DefaultParams
-	InitGenesis               = keeper.InitGenesis
-	ExportGenesis             = keeper.ExportGenesis
-	NewMessageHandler         = keeper.NewDefaultMessageHandler
-	DefaultEncoders           = keeper.DefaultEncoders
-	EncodeBankMsg             = keeper.EncodeBankMsg
-	EncodeStakingMsg          = keeper.EncodeStakingMsg
-	EncodeWasmMsg             = keeper.EncodeWasmMsg
-	NewKeeper                 = keeper.NewKeeper
-	NewLegacyQuerier          = keeper.NewLegacyQuerier
-	DefaultQueryPlugins       = keeper.DefaultQueryPlugins
-	BankQuerier               = keeper.BankQuerier
-	StakingQuerier            = keeper.StakingQuerier
-	WasmQuerier               = keeper.WasmQuerier
-	CreateTestInput           = keeper.CreateTestInput
-	TestHandler               = keeper.TestHandler
-	NewWasmProposalHandler    = keeper.NewWasmProposalHandler
-	NewQuerier                = keeper.NewQuerier
-	ContractFromPortID        = keeper.ContractFromPortID
-	WithWasmEngine            = keeper.WithWasmEngine
+	RegisterCodec                     = types.RegisterLegacyAminoCodec
+	RegisterInterfaces                = types.RegisterInterfaces
+	ValidateGenesis                   = types.ValidateGenesis
+	ConvertToProposals                = types.ConvertToProposals
+	GetCodeKey                        = types.GetCodeKey
+	GetContractAddressKey             = types.GetContractAddressKey
+	GetContractStorePrefixKey         = types.GetContractStorePrefix
+	NewCodeInfo                       = types.NewCodeInfo
+	NewAbsoluteTxPosition             = types.NewAbsoluteTxPosition
+	NewContractInfo                   = types.NewContractInfo
+	NewEnv                            = types.NewEnv
+	NewWasmCoins                      = types.NewWasmCoins
+	NewContractExecutionAuthorization = types.NewContractExecutionAuthorization
+	ParseEvents                       = types.ParseEvents
+	DefaultWasmConfig                 = types.DefaultWasmConfig
+	DefaultParams                     = types.DefaultParams
+	InitGenesis                       = keeper.InitGenesis
+	ExportGenesis                     = keeper.ExportGenesis
+	NewMessageHandler                 = keeper.NewDefaultMessageHandler
+	DefaultEncoders                   = keeper.DefaultEncoders
+	EncodeBankMsg                     = keeper.EncodeBankMsg
+	EncodeStakingMsg                  = keeper.EncodeStakingMsg
+	EncodeWasmMsg                     = keeper.EncodeWasmMsg
+	NewKeeper                         = keeper.NewKeeper
+	NewLegacyQuerier                  = keeper.NewLegacyQuerier
+	DefaultQueryPlugins               = keeper.DefaultQueryPlugins
+	BankQuerier                       = keeper.BankQuerier
+	StakingQuerier                    = keeper.StakingQuerier
+	WasmQuerier                       = keeper.WasmQuerier
+	CreateTestInput                   = keeper.CreateTestInput
+	TestHandler                       = keeper.TestHandler
+	NewWasmProposalHandler            = keeper.NewWasmProposalHandler
+	NewQuerier                        = keeper.NewQuerier
+	ContractFromPortID                = keeper.ContractFromPortID
+	WithWasmEngine                    = keeper.WithWasmEngine
+	NewWasmSnapshotter                = keeper.NewWasmSnapshotter
+	NewMigrator                       = keeper.NewMigrator
 
 	// variable aliases
 	ModuleCdc            = types.ModuleCdc
@@ -114,6 +121,12 @@ type (
 	MsgClearAdmin                              = types.MsgClearAdmin
 	MsgWasmIBCCall                             = types.MsgIBCSend
 	MsgClearAdminResponse                      = types.MsgClearAdminResponse
+	MsgUpdateInstantiateConfig                 = types.MsgUpdateInstantiateConfig
+	MsgUpdateInstantiateConfigResponse         = types.MsgUpdateInstantiateConfigResponse
+	UpdateInstantiateConfigProposal            = types.UpdateInstantiateConfigProposal
+	SudoContractProposal                       = types.SudoContractProposal
+	ExecuteContractProposal                    = types.ExecuteContractProposal
+	AccessConfigUpdate                         = types.AccessConfigUpdate
 	MsgServer                                  = types.MsgServer
 	Model                                      = types.Model
 	CodeInfo                                   = types.CodeInfo
@@ -122,6 +135,7 @@ type (
 	Config                                     = types.WasmConfig
 	ContractInfoWithAddress                    = types.ContractInfoWithAddress
 	CodeInfoResponse                           = types.CodeInfoResponse
+	ContractExecutionAuthorization             = types.ContractExecutionAuthorization
 	MessageHandler                             = keeper.DefaultMessageHandler
 	BankEncoder                                = keeper.BankEncoder
 	CustomEncoder                              = keeper.CustomEncoder
@@ -129,6 +143,7 @@ type (
 	WasmEncoder                                = keeper.WasmEncoder
 	MessageEncoders                            = keeper.MessageEncoders
 	Keeper                                     = keeper.Keeper
+	WasmSnapshotter                            = keeper.WasmSnapshotter
 	QueryHandler                               = keeper.QueryHandler
 	CustomQuerier                              = keeper.CustomQuerier
 	QueryPlugins                               = keeper.QueryPlugins
@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/x/wasm/internal/keeper"
+	"github.com/line/lfb-sdk/x/wasm/internal/types"
+)
+
+func TestParseAccessConfigUpdates(t *testing.T) {
+	anyAddr := keeper.RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		src    []string
+		exp    []types.AccessConfigUpdate
+		expErr bool
+	}{
+		"nobody": {
+			src: []string{"1:nobody"},
+			exp: []types.AccessConfigUpdate{{CodeID: 1, InstantiatePermission: types.AllowNobody}},
+		},
+		"everybody": {
+			src: []string{"1:Everybody"},
+			exp: []types.AccessConfigUpdate{{CodeID: 1, InstantiatePermission: types.AllowEverybody}},
+		},
+		"only address": {
+			src: []string{"1:" + anyAddr.String()},
+			exp: []types.AccessConfigUpdate{{CodeID: 1, InstantiatePermission: types.AccessTypeOnlyAddress.With(anyAddr)}},
+		},
+		"multiple": {
+			src: []string{"1:nobody", "2:everybody"},
+			exp: []types.AccessConfigUpdate{
+				{CodeID: 1, InstantiatePermission: types.AllowNobody},
+				{CodeID: 2, InstantiatePermission: types.AllowEverybody},
+			},
+		},
+		"missing permission": {
+			src:    []string{"1"},
+			expErr: true,
+		},
+		"invalid code id": {
+			src:    []string{"foo:nobody"},
+			expErr: true,
+		},
+		"invalid address": {
+			src:    []string{"1:foo"},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := parseAccessConfigUpdates(spec.src)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
@@ -1,14 +1,17 @@
 package cli
 
 import (
+	"fmt"
 	"strconv"
 
 	"github.com/line/lfb-sdk/client"
 	"github.com/line/lfb-sdk/client/flags"
 	"github.com/line/lfb-sdk/client/tx"
+	sdk "github.com/line/lfb-sdk/types"
 	sdkerrors "github.com/line/lfb-sdk/types/errors"
 	"github.com/line/lfb-sdk/x/wasm/internal/types"
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 )
 
 // MigrateContractCmd will migrate a contract to a new code version
@@ -145,3 +148,79 @@ func UpdateContractStatusCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// UpdateInstantiateConfigCmd updates the instantiate permission of a code
+func UpdateInstantiateConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-instantiate-config [code_id_int64]",
+		Short: "Set new instantiate config for a code",
+		Long:  "Set new instantiate config for a code. Only the code creator can change it.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseUpdateInstantiateConfigArgs(args, clientCtx.GetFromAddress(), cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().Bool(flagInstantiateNobody, false, "Nobody except the governance process can instantiate a contract from the code")
+	cmd.Flags().Bool(flagInstantiateByEverybody, false, "Everybody can instantiate a contract from the code")
+	cmd.Flags().String(flagInstantiateByAddress, "", "Only this address can instantiate a contract instance from the code")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseUpdateInstantiateConfigArgs(args []string, sender sdk.AccAddress, flags *flag.FlagSet) (types.MsgUpdateInstantiateConfig, error) {
+	codeID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return types.MsgUpdateInstantiateConfig{}, sdkerrors.Wrap(err, "code id")
+	}
+
+	nobody, err := flags.GetBool(flagInstantiateNobody)
+	if err != nil {
+		return types.MsgUpdateInstantiateConfig{}, fmt.Errorf("instantiate by nobody: %s", err)
+	}
+	everybody, err := flags.GetBool(flagInstantiateByEverybody)
+	if err != nil {
+		return types.MsgUpdateInstantiateConfig{}, fmt.Errorf("instantiate by everybody: %s", err)
+	}
+	onlyAddrStr, err := flags.GetString(flagInstantiateByAddress)
+	if err != nil {
+		return types.MsgUpdateInstantiateConfig{}, fmt.Errorf("instantiate by address: %s", err)
+	}
+
+	var perms []types.AccessConfig
+	if nobody {
+		perms = append(perms, types.AllowNobody)
+	}
+	if everybody {
+		perms = append(perms, types.AllowEverybody)
+	}
+	if onlyAddrStr != "" {
+		allowedAddr, err := sdk.AccAddressFromBech32(onlyAddrStr)
+		if err != nil {
+			return types.MsgUpdateInstantiateConfig{}, sdkerrors.Wrap(err, flagInstantiateByAddress)
+		}
+		perms = append(perms, types.AccessTypeOnlyAddress.With(allowedAddr))
+	}
+	if len(perms) != 1 {
+		return types.MsgUpdateInstantiateConfig{}, fmt.Errorf("exactly one of --%s, --%s or --%s is required",
+			flagInstantiateNobody, flagInstantiateByEverybody, flagInstantiateByAddress)
+	}
+
+	msg := types.MsgUpdateInstantiateConfig{
+		Sender:                   sender.String(),
+		CodeID:                   codeID,
+		NewInstantiatePermission: &perms[0],
+	}
+	return msg, nil
+}
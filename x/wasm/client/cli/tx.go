@@ -5,12 +5,15 @@ import (
 	"fmt"
 	"io/ioutil"
 	"strconv"
+	"time"
 
 	"github.com/line/lfb-sdk/client"
 	"github.com/line/lfb-sdk/client/flags"
 	"github.com/line/lfb-sdk/client/tx"
 	sdk "github.com/line/lfb-sdk/types"
 	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	authzcli "github.com/line/lfb-sdk/x/authz/client/cli"
+	authztypes "github.com/line/lfb-sdk/x/authz/types"
 	wasmUtils "github.com/line/lfb-sdk/x/wasm/client/utils"
 	"github.com/line/lfb-sdk/x/wasm/internal/types"
 	"github.com/spf13/cobra"
@@ -26,6 +29,7 @@ const (
 	flagRunAs                  = "run-as"
 	flagInstantiateByEverybody = "instantiate-everybody"
 	flagInstantiateByAddress   = "instantiate-only-address"
+	flagInstantiateNobody      = "instantiate-nobody"
 	flagProposalType           = "type"
 )
 
@@ -47,6 +51,8 @@ func GetTxCmd() *cobra.Command {
 		UpdateContractAdminCmd(),
 		ClearContractAdminCmd(),
 		UpdateContractStatusCmd(),
+		UpdateInstantiateConfigCmd(),
+		GrantContractExecutionCmd(),
 	)
 	return txCmd
 }
@@ -383,3 +389,60 @@ func parseExecuteArgs(contractAddr string, execMsg string, sender sdk.AccAddress
 		Msg:      []byte(execMsg),
 	}, nil
 }
+
+// GrantContractExecutionCmd will grant another account the right to execute
+// the given contracts on behalf of the signer
+func GrantContractExecutionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-execute [grantee_bech32] [contract_addr_bech32]... --spend-limit [coins,optional] --expiration [unix_timestamp,optional]",
+		Short: "Grant an account the right to execute the given contracts on your behalf",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "grantee")
+			}
+
+			contracts := make([]sdk.AccAddress, len(args)-1)
+			for i, arg := range args[1:] {
+				if contracts[i], err = sdk.AccAddressFromBech32(arg); err != nil {
+					return sdkerrors.Wrap(err, "contract")
+				}
+			}
+
+			limit, err := cmd.Flags().GetString(authzcli.FlagSpendLimit)
+			if err != nil {
+				return err
+			}
+			spendLimit, err := sdk.ParseCoinsNormalized(limit)
+			if err != nil {
+				return sdkerrors.Wrap(err, "spend limit")
+			}
+
+			exp, err := cmd.Flags().GetInt64(authzcli.FlagExpiration)
+			if err != nil {
+				return err
+			}
+
+			msg, err := authztypes.NewMsgGrant(clientCtx.GetFromAddress(), grantee,
+				types.NewContractExecutionAuthorization(spendLimit, contracts...), time.Unix(exp, 0))
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(authzcli.FlagSpendLimit, "", "The total funds that can be sent with the executions, none if empty")
+	cmd.Flags().Int64(authzcli.FlagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "The Unix timestamp. Default is one year.")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
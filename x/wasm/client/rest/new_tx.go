@@ -2,6 +2,7 @@ package rest
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 	"github.com/line/lfb-sdk/client"
@@ -13,6 +14,7 @@ import (
 func registerNewTxRoutes(cliCtx client.Context, r *mux.Router) {
 	r.HandleFunc("/wasm/contract/{contractAddr}/admin", setContractAdminHandlerFn(cliCtx)).Methods("PUT")
 	r.HandleFunc("/wasm/contract/{contractAddr}/code", migrateContractHandlerFn(cliCtx)).Methods("PUT")
+	r.HandleFunc("/wasm/code/{codeId}/instantiate_config", updateInstantiateConfigHandlerFn(cliCtx)).Methods("PUT")
 }
 
 type migrateContractReq struct {
@@ -22,6 +24,11 @@ type migrateContractReq struct {
 	MigrateMsg []byte       `json:"migrate_msg,omitempty" yaml:"migrate_msg"`
 }
 
+type updateInstantiateConfigReq struct {
+	BaseReq               rest.BaseReq       `json:"base_req" yaml:"base_req"`
+	InstantiatePermission types.AccessConfig `json:"instantiate_permission" yaml:"instantiate_permission"`
+}
+
 type updateContractAdministrateReq struct {
 	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
 	Admin   string       `json:"admin,omitempty" yaml:"admin"`
@@ -83,3 +90,35 @@ func migrateContractHandlerFn(cliCtx client.Context) http.HandlerFunc {
 		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
 	}
 }
+
+func updateInstantiateConfigHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req updateInstantiateConfigReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+		vars := mux.Vars(r)
+		codeID, err := strconv.ParseUint(vars["codeId"], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := &types.MsgUpdateInstantiateConfig{
+			Sender:                   req.BaseReq.From,
+			CodeID:                   codeID,
+			NewInstantiatePermission: &req.InstantiatePermission,
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
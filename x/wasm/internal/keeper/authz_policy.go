@@ -10,6 +10,7 @@ type AuthorizationPolicy interface {
 	CanInstantiateContract(c types.AccessConfig, actor sdk.AccAddress) bool
 	CanModifyContract(admin, actor sdk.AccAddress) bool
 	CanUpdateContractStatus(c types.AccessConfig, actor sdk.AccAddress) bool
+	CanModifyCodeAccessConfig(creator, actor sdk.AccAddress) bool
 }
 
 type DefaultAuthorizationPolicy struct {
@@ -31,6 +32,10 @@ func (p DefaultAuthorizationPolicy) CanUpdateContractStatus(config types.AccessC
 	return config.Allowed(actor)
 }
 
+func (p DefaultAuthorizationPolicy) CanModifyCodeAccessConfig(creator, actor sdk.AccAddress) bool {
+	return creator != nil && creator.Equals(actor)
+}
+
 // GovAuthorizationPolicy is for the gov handler(proposal_handler.go) authorities
 type GovAuthorizationPolicy struct {
 }
@@ -54,3 +59,8 @@ func (p GovAuthorizationPolicy) CanUpdateContractStatus(types.AccessConfig, sdk.
 	// The gov handler can update contract status regardless of the current access config
 	return true
 }
+
+func (p GovAuthorizationPolicy) CanModifyCodeAccessConfig(sdk.AccAddress, sdk.AccAddress) bool {
+	// The gov handler can update the code access config regardless of the code creator
+	return true
+}
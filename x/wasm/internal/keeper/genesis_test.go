@@ -94,6 +94,7 @@ func TestGenesisExportImport(t *testing.T) {
 	// reset ContractInfo in source DB for comparison with dest DB
 	srcKeeper.IterateContractInfo(srcCtx, func(address sdk.AccAddress, info wasmTypes.ContractInfo) bool {
 		srcKeeper.deleteContractSecondIndex(srcCtx, address, &info)
+		srcCtx.KVStore(srcKeeper.storeKey).Delete(types.GetContractByCreatorSecondaryIndexKey(info.CreatorAddr(), address, info.Created))
 		info.ResetFromGenesis(srcCtx)
 		srcKeeper.storeContractInfo(srcCtx, address, &info)
 		return false
@@ -3,8 +3,10 @@ package keeper
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"strconv"
 
 	"github.com/line/lfb-sdk/codec"
 	"github.com/line/lfb-sdk/store/prefix"
@@ -48,6 +50,7 @@ type Keeper struct {
 	cdc              codec.Marshaler
 	accountKeeper    types.AccountKeeper
 	bank             coinTransferrer
+	distKeeper       types.DistributionKeeper
 	ChannelKeeper    types.ChannelKeeper
 	portKeeper       types.PortKeeper
 	capabilityKeeper types.CapabilityKeeper
@@ -99,6 +102,7 @@ func NewKeeper(
 		wasmer:           wasmer,
 		accountKeeper:    accountKeeper,
 		bank:             NewBankCoinTransferrer(bankKeeper),
+		distKeeper:       distKeeper,
 		ChannelKeeper:    channelKeeper,
 		portKeeper:       portKeeper,
 		capabilityKeeper: capabilityKeeper,
@@ -200,6 +204,7 @@ func (k Keeper) storeCodeInfo(ctx sdk.Context, codeID uint64, codeInfo types.Cod
 	store := ctx.KVStore(k.storeKey)
 	// 0x01 | codeID (uint64) -> ContractInfo
 	store.Set(types.GetCodeKey(codeID), k.cdc.MustMarshalBinaryBare(&codeInfo))
+	store.Set(types.GetCodeByHashSecondaryIndexKey(codeInfo.CodeHash, codeID), []byte{})
 }
 
 func (k Keeper) importCode(ctx sdk.Context, codeID uint64, codeInfo types.CodeInfo, wasmCode []byte) error {
@@ -215,13 +220,10 @@ func (k Keeper) importCode(ctx sdk.Context, codeID uint64, codeInfo types.CodeIn
 		return sdkerrors.Wrap(types.ErrInvalid, "code hashes not same")
 	}
 
-	store := ctx.KVStore(k.storeKey)
-	key := types.GetCodeKey(codeID)
-	if store.Has(key) {
+	if k.containsCodeInfo(ctx, codeID) {
 		return sdkerrors.Wrapf(types.ErrDuplicate, "duplicate code: %d", codeID)
 	}
-	// 0x01 | codeID (uint64) -> ContractInfo
-	store.Set(key, k.cdc.MustMarshalBinaryBare(&codeInfo))
+	k.storeCodeInfo(ctx, codeID, codeInfo)
 	return nil
 }
 
@@ -452,9 +454,9 @@ func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	}, nil
 }
 
-// Sudo allows priviledged access to a contract. This can never be called by governance or external tx, but only by
-// another native Go module directly. Thus, the keeper doesn't place any access controls on it, that is the
-// responsibility or the app developer (who passes the wasm.Keeper in app.go)
+// Sudo allows priviledged access to a contract. This can never be called by an external tx, but only by
+// another native Go module directly or by a governance proposal. Thus, the keeper doesn't place any access controls
+// on it, that is the responsibility or the app developer (who passes the wasm.Keeper in app.go)
 func (k Keeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) (*sdk.Result, error) {
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
@@ -540,6 +542,12 @@ func (k Keeper) deleteContractSecondIndex(ctx sdk.Context, contractAddress sdk.A
 	ctx.KVStore(k.storeKey).Delete(types.GetContractByCreatedSecondaryIndexKey(contractAddress, contractInfo))
 }
 
+func (k Keeper) deleteContractAdminSecondIndex(ctx sdk.Context, contractAddress sdk.AccAddress, contractInfo *types.ContractInfo) {
+	if admin := contractInfo.AdminAddr(); admin != nil {
+		ctx.KVStore(k.storeKey).Delete(types.GetContractByAdminSecondaryIndexKey(admin, contractAddress))
+	}
+}
+
 // UpdateContractAdmin sets the admin value on the ContractInfo. It must be a valid address (use ClearContractAdmin to remove it)
 func (k Keeper) UpdateContractAdmin(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newAdmin sdk.AccAddress) error {
 	return k.setContractAdmin(ctx, contractAddress, caller, newAdmin, k.authZPolicy)
@@ -571,6 +579,49 @@ func (k Keeper) updateContractStatus(ctx sdk.Context, contractAddress sdk.AccAdd
 	return nil
 }
 
+// SetAccessConfig updates the instantiate permission of a code. Only the code creator can change it.
+func (k Keeper) SetAccessConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, newConfig types.AccessConfig) error {
+	return k.setAccessConfig(ctx, codeID, caller, newConfig, k.authZPolicy)
+}
+
+func (k Keeper) setAccessConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, newConfig types.AccessConfig, authZ AuthorizationPolicy) error {
+	codeInfo := k.GetCodeInfo(ctx, codeID)
+	if codeInfo == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "code info")
+	}
+	creator, err := sdk.AccAddressFromBech32(codeInfo.Creator)
+	if err != nil {
+		return sdkerrors.Wrap(err, "creator")
+	}
+	if !authZ.CanModifyCodeAccessConfig(creator, caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify code access config")
+	}
+	if err := newConfig.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "instantiate permission")
+	}
+
+	prevConfig := codeInfo.InstantiateConfig
+	codeInfo.InstantiateConfig = newConfig
+	k.storeCodeInfo(ctx, codeID, *codeInfo)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateInstantiateConfig,
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
+		sdk.NewAttribute(types.AttributeKeyPrevCodePermission, accessConfigAttribute(prevConfig)),
+		sdk.NewAttribute(types.AttributeKeyCodePermission, accessConfigAttribute(newConfig)),
+	))
+	return nil
+}
+
+// accessConfigAttribute renders an access config as json for event attributes
+func accessConfigAttribute(c types.AccessConfig) string {
+	bz, err := json.Marshal(c)
+	if err != nil {
+		panic(err)
+	}
+	return string(bz)
+}
+
 func (k Keeper) setContractAdmin(ctx sdk.Context, contractAddress, caller, newAdmin sdk.AccAddress, authZ AuthorizationPolicy) error {
 	contractInfo := k.GetContractInfo(ctx, contractAddress)
 	if contractInfo == nil {
@@ -582,11 +633,18 @@ func (k Keeper) setContractAdmin(ctx sdk.Context, contractAddress, caller, newAd
 	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
 		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
 	}
+	// delete old admin secondary index entry
+	k.deleteContractAdminSecondIndex(ctx, contractAddress, contractInfo)
 	contractInfo.Admin = newAdmin.String()
 	k.storeContractInfo(ctx, contractAddress, contractInfo)
 	return nil
 }
 
+// distributeFromCommunityPool pays the given amount out of the community pool to the receiver
+func (k Keeper) distributeFromCommunityPool(ctx sdk.Context, amount sdk.Coins, receiver sdk.AccAddress) error {
+	return k.distKeeper.DistributeFromFeePool(ctx, amount, receiver)
+}
+
 func (k Keeper) appendToContractHistory(ctx sdk.Context, contractAddr sdk.AccAddress, newEntries ...types.ContractCodeHistoryEntry) {
 	store := ctx.KVStore(k.storeKey)
 	// find last element position
@@ -689,6 +747,10 @@ func (k Keeper) storeContractInfo(ctx sdk.Context, contractAddress sdk.AccAddres
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.GetContractAddressKey(contractAddress), k.cdc.MustMarshalBinaryBare(contract))
 	store.Set(types.GetContractByCreatedSecondaryIndexKey(contractAddress, contract), []byte{})
+	store.Set(types.GetContractByCreatorSecondaryIndexKey(contract.CreatorAddr(), contractAddress, contract.Created), []byte{})
+	if admin := contract.AdminAddr(); admin != nil {
+		store.Set(types.GetContractByAdminSecondaryIndexKey(admin, contractAddress), []byte{})
+	}
 }
 
 func (k Keeper) IterateContractInfo(ctx sdk.Context, cb func(sdk.AccAddress, types.ContractInfo) bool) {
@@ -807,7 +869,7 @@ func (k Keeper) InitializePinnedCodes(ctx sdk.Context) error {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PinnedCodeIndexPrefix)
 	iter := store.Iterator(nil, nil)
 	for ; iter.Valid(); iter.Next() {
-		codeInfo := k.GetCodeInfo(ctx, types.ParsePinnedCodeIndex(iter.Value()))
+		codeInfo := k.GetCodeInfo(ctx, types.ParsePinnedCodeIndex(iter.Key()))
 		if codeInfo == nil {
 			return sdkerrors.Wrap(types.ErrNotFound, "code info")
 		}
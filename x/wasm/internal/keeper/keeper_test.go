@@ -287,7 +287,7 @@ func TestInstantiate(t *testing.T) {
 
 	gasAfter := ctx.GasMeter().GasConsumed()
 	if types.EnableGasVerification {
-		require.Equal(t, uint64(0x10f84), gasAfter-gasBefore)
+		require.Equal(t, uint64(0x11754), gasAfter-gasBefore)
 	}
 
 	// ensure it is stored properly
@@ -1533,3 +1533,101 @@ func runUpdateStatusSpec(t *testing.T, msg string, keeper *Keeper, ctx sdk.Conte
 		assert.Equal(t, spec.newStatus, cInfo.Status)
 	})
 }
+
+func TestSetAccessConfig(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	accKeeper, keeper, bankKeeper := keepers.AccountKeeper, keepers.WasmKeeper, keepers.BankKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(t, ctx, accKeeper, bankKeeper, deposit)
+	fred := createFakeFundedAccount(t, ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+	codeID, err := keeper.Create(ctx, creator, wasmCode, "", "", &types.AllowNobody)
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		codeID    uint64
+		caller    sdk.AccAddress
+		newConfig types.AccessConfig
+		expErr    *sdkerrors.Error
+	}{
+		"creator can open the code": {
+			codeID:    codeID,
+			caller:    creator,
+			newConfig: types.AllowEverybody,
+		},
+		"creator can restrict the code to an address": {
+			codeID:    codeID,
+			caller:    creator,
+			newConfig: types.AccessTypeOnlyAddress.With(fred),
+		},
+		"other can not update": {
+			codeID:    codeID,
+			caller:    fred,
+			newConfig: types.AllowEverybody,
+			expErr:    sdkerrors.ErrUnauthorized,
+		},
+		"unknown code": {
+			codeID:    999,
+			caller:    creator,
+			newConfig: types.AllowEverybody,
+			expErr:    types.ErrNotFound,
+		},
+		"invalid config": {
+			codeID:    codeID,
+			caller:    creator,
+			newConfig: types.AccessConfig{Permission: types.AccessTypeUnspecified},
+			expErr:    types.ErrEmpty,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+
+			err := keeper.SetAccessConfig(ctx, spec.codeID, spec.caller, spec.newConfig)
+			require.True(t, spec.expErr.Is(err), "expected %v but got %+v", spec.expErr, err)
+			if spec.expErr != nil {
+				assert.Equal(t, types.AllowNobody, keeper.GetCodeInfo(ctx, codeID).InstantiateConfig)
+				return
+			}
+			assert.Equal(t, spec.newConfig, keeper.GetCodeInfo(ctx, codeID).InstantiateConfig)
+
+			// and the change is recorded in an event
+			require.Len(t, em.Events(), 1)
+			event := em.Events()[0]
+			assert.Equal(t, types.EventTypeUpdateInstantiateConfig, event.Type)
+			attrs := make(map[string]string)
+			for _, a := range event.Attributes {
+				attrs[string(a.Key)] = string(a.Value)
+			}
+			assert.Equal(t, "1", attrs[types.AttributeKeyCodeID])
+			assert.Equal(t, `{"permission":"Nobody"}`, attrs[types.AttributeKeyPrevCodePermission])
+			expConfig, err := json.Marshal(spec.newConfig)
+			require.NoError(t, err)
+			assert.Equal(t, string(expConfig), attrs[types.AttributeKeyCodePermission])
+		})
+	}
+}
+
+func TestInitializePinnedCodes(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	pinned := StoreHackatomExampleContract(t, ctx, keepers)
+	StoreHackatomExampleContract(t, ctx, keepers)
+	require.NoError(t, keepers.WasmKeeper.PinCode(ctx, pinned.CodeID))
+
+	// when the keeper is restarted with an empty wasmvm cache
+	var gotPinnedChecksums []wasmvm.Checksum
+	restarted := *keepers.WasmKeeper
+	restarted.wasmer = &wasmtesting.MockWasmer{PinFn: func(checksum wasmvm.Checksum) error {
+		gotPinnedChecksums = append(gotPinnedChecksums, checksum)
+		return nil
+	}}
+	require.NoError(t, restarted.InitializePinnedCodes(ctx))
+
+	// then only the pinned code is pinned again
+	assert.Equal(t, []wasmvm.Checksum{restarted.GetCodeInfo(ctx, pinned.CodeID).CodeHash}, gotPinnedChecksums)
+}
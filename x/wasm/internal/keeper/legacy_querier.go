@@ -1,12 +1,15 @@
 package keeper
 
 import (
+	"encoding/binary"
+	"encoding/hex"
 	"encoding/json"
 	"reflect"
 	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/line/lfb-sdk/store/prefix"
 	sdk "github.com/line/lfb-sdk/types"
 	sdkerrors "github.com/line/lfb-sdk/types/errors"
 	"github.com/line/lfb-sdk/x/wasm/internal/types"
@@ -20,6 +23,11 @@ const (
 	QueryGetCode            = "code"
 	QueryListCode           = "list-code"
 	QueryContractHistory    = "contract-history"
+
+	QueryListPinnedCode        = "list-pinned-code"
+	QueryListCodeByHash        = "list-code-by-hash"
+	QueryListContractByCreator = "list-contracts-by-creator"
+	QueryListContractByAdmin   = "list-contracts-by-admin"
 )
 
 const (
@@ -72,6 +80,30 @@ func NewLegacyQuerier(keeper *Keeper) sdk.Querier {
 			}
 			//nolint:staticcheck
 			rsp, err = queryContractHistory(ctx, contractAddr, *keeper)
+		case QueryListPinnedCode:
+			//nolint:staticcheck
+			rsp, err = queryPinnedCodeList(ctx, *keeper)
+		case QueryListCodeByHash:
+			codeHash, err := hex.DecodeString(path[1])
+			if err != nil {
+				return nil, sdkerrors.Wrapf(types.ErrInvalid, "code hash: %s", err.Error())
+			}
+			//nolint:staticcheck
+			rsp, err = queryCodeListByHash(ctx, codeHash, *keeper)
+		case QueryListContractByCreator:
+			creatorAddr, err := sdk.AccAddressFromBech32(path[1])
+			if err != nil {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+			}
+			//nolint:staticcheck
+			rsp, err = queryContractListByCreator(ctx, creatorAddr, *keeper)
+		case QueryListContractByAdmin:
+			adminAddr, err := sdk.AccAddressFromBech32(path[1])
+			if err != nil {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+			}
+			//nolint:staticcheck
+			rsp, err = queryContractListByAdmin(ctx, adminAddr, *keeper)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown data query endpoint")
 		}
@@ -182,3 +214,62 @@ func queryContractListByCode(ctx sdk.Context, codeID uint64, keeper Keeper) ([]t
 	}
 	return contracts, nil
 }
+
+//nolint:unparam
+func queryPinnedCodeList(ctx sdk.Context, keeper Keeper) ([]uint64, error) {
+	var codeIDs []uint64
+	iter := prefix.NewStore(ctx.KVStore(keeper.storeKey), types.PinnedCodeIndexPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		codeIDs = append(codeIDs, types.ParsePinnedCodeIndex(iter.Key()))
+	}
+	return codeIDs, nil
+}
+
+//nolint:unparam
+func queryCodeListByHash(ctx sdk.Context, codeHash []byte, keeper Keeper) ([]types.CodeInfoResponse, error) {
+	var info []types.CodeInfoResponse
+	iter := prefix.NewStore(ctx.KVStore(keeper.storeKey), types.GetCodeByHashSecondaryIndexPrefix(codeHash)).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if len(iter.Key()) != 8 { // longer code hash with the requested one as prefix
+			continue
+		}
+		codeID := binary.BigEndian.Uint64(iter.Key())
+		res := keeper.GetCodeInfo(ctx, codeID)
+		if res == nil {
+			return nil, sdkerrors.Wrapf(types.ErrNotFound, "code id: %d", codeID)
+		}
+		info = append(info, types.CodeInfoResponse{
+			CodeID:   codeID,
+			Creator:  res.Creator,
+			DataHash: res.CodeHash,
+			Source:   res.Source,
+			Builder:  res.Builder,
+		})
+	}
+	return info, nil
+}
+
+//nolint:unparam
+func queryContractListByCreator(ctx sdk.Context, creatorAddr sdk.AccAddress, keeper Keeper) ([]string, error) {
+	var contracts []string
+	iter := prefix.NewStore(ctx.KVStore(keeper.storeKey), types.GetContractByCreatorSecondaryIndexPrefix(creatorAddr)).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var contractAddr sdk.AccAddress = iter.Key()[types.AbsoluteTxPositionLen:]
+		contracts = append(contracts, contractAddr.String())
+	}
+	return contracts, nil
+}
+
+//nolint:unparam
+func queryContractListByAdmin(ctx sdk.Context, adminAddr sdk.AccAddress, keeper Keeper) ([]string, error) {
+	var contracts []string
+	iter := prefix.NewStore(ctx.KVStore(keeper.storeKey), types.GetContractByAdminSecondaryIndexPrefix(adminAddr)).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		contracts = append(contracts, sdk.AccAddress(iter.Key()).String())
+	}
+	return contracts, nil
+}
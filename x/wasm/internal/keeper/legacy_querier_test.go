@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"bytes"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -359,3 +360,48 @@ func TestLegacyQueryCodeList(t *testing.T) {
 		})
 	}
 }
+
+func TestLegacyQueryIndexedLists(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	require.NoError(t, keeper.PinCode(ctx, example.CodeID))
+	codeHash := keeper.GetCodeInfo(ctx, example.CodeID).CodeHash
+
+	specs := map[string]struct {
+		path []string
+		exp  string
+	}{
+		"pinned codes": {
+			path: []string{QueryListPinnedCode},
+			exp:  fmt.Sprintf("[%d]", example.CodeID),
+		},
+		"codes by hash": {
+			path: []string{QueryListCodeByHash, hex.EncodeToString(codeHash)},
+			exp:  fmt.Sprintf(`[{"id":%d,"creator":%q,"data_hash":"%X"}]`, example.CodeID, example.CreatorAddr.String(), codeHash),
+		},
+		"contracts by creator": {
+			path: []string{QueryListContractByCreator, example.CreatorAddr.String()},
+			exp:  fmt.Sprintf("[%q]", example.Contract.String()),
+		},
+		"contracts by admin": {
+			path: []string{QueryListContractByAdmin, example.CreatorAddr.String()},
+			exp:  fmt.Sprintf("[%q]", example.Contract.String()),
+		},
+		"contracts by unknown admin": {
+			path: []string{QueryListContractByAdmin, RandomBech32AccountAddress(t)},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			resData, err := NewLegacyQuerier(keeper)(ctx, spec.path, abci.RequestQuery{})
+			require.NoError(t, err)
+			if spec.exp == "" {
+				require.Nil(t, resData)
+				return
+			}
+			assert.JSONEq(t, spec.exp, string(resData))
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/wasm/internal/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. It populates the code by hash, contract by creator and
+// contract by admin secondary indexes for the codes and contracts stored before.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	type code struct {
+		id   uint64
+		info types.CodeInfo
+	}
+	var codes []code
+	m.keeper.IterateCodeInfos(ctx, func(codeID uint64, info types.CodeInfo) bool {
+		codes = append(codes, code{id: codeID, info: info})
+		return false
+	})
+	for _, c := range codes {
+		m.keeper.storeCodeInfo(ctx, c.id, c.info)
+	}
+
+	type contract struct {
+		addr sdk.AccAddress
+		info types.ContractInfo
+	}
+	var contracts []contract
+	m.keeper.IterateContractInfo(ctx, func(addr sdk.AccAddress, info types.ContractInfo) bool {
+		contracts = append(contracts, contract{addr: addr, info: info})
+		return false
+	})
+	for i := range contracts {
+		m.keeper.storeContractInfo(ctx, contracts[i].addr, &contracts[i].info)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/wasm/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+	store := ctx.KVStore(keeper.storeKey)
+
+	// given code and contract infos stored without the secondary indexes of version 2
+	codeInfo := types.CodeInfoFixture()
+	store.Set(types.GetCodeKey(1), keeper.cdc.MustMarshalBinaryBare(&codeInfo))
+
+	var (
+		creator      = RandomAccountAddress(t)
+		admin        = RandomAccountAddress(t)
+		contractAddr = contractAddress(1, 1)
+	)
+	contractInfo := types.ContractInfoFixture(func(c *types.ContractInfo) {
+		c.Creator = creator.String()
+		c.Admin = admin.String()
+	})
+	store.Set(types.GetContractAddressKey(contractAddr), keeper.cdc.MustMarshalBinaryBare(&contractInfo))
+
+	// when
+	require.NoError(t, NewMigrator(keeper).Migrate1to2(ctx))
+
+	// then
+	assert.True(t, store.Has(types.GetCodeByHashSecondaryIndexKey(codeInfo.CodeHash, 1)))
+	assert.True(t, store.Has(types.GetContractByCreatorSecondaryIndexKey(creator, contractAddr, contractInfo.Created)))
+	assert.True(t, store.Has(types.GetContractByAdminSecondaryIndexKey(admin, contractAddr)))
+	assert.True(t, store.Has(types.GetContractByCreatedSecondaryIndexKey(contractAddr, &contractInfo)))
+
+	// and stored infos unchanged
+	assert.Equal(t, &codeInfo, keeper.GetCodeInfo(ctx, 1))
+	assert.Equal(t, &contractInfo, keeper.GetContractInfo(ctx, contractAddr))
+
+	q := NewQuerier(keeper)
+	rsp, err := q.ContractsByCreator(sdk.WrapSDKContext(ctx), &types.QueryContractsByCreatorRequest{CreatorAddress: creator.String()})
+	require.NoError(t, err)
+	assert.Equal(t, []string{contractAddr.String()}, rsp.ContractAddresses)
+}
@@ -283,3 +283,28 @@ func (m msgServer) UpdateContractStatus(goCtx context.Context, msg *types.MsgUpd
 
 	return &types.MsgUpdateContractStatusResponse{}, nil
 }
+
+// UpdateInstantiateConfig handles MsgUpdateInstantiateConfig
+// CONTRACT: msg.validateBasic() must be called before calling this
+func (m msgServer) UpdateInstantiateConfig(goCtx context.Context, msg *types.MsgUpdateInstantiateConfig) (*types.MsgUpdateInstantiateConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	if msg.NewInstantiatePermission == nil {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "instantiate permission")
+	}
+
+	if err := m.keeper.SetAccessConfig(ctx, msg.CodeID, senderAddr, *msg.NewInstantiatePermission); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	return &types.MsgUpdateInstantiateConfigResponse{}, nil
+}
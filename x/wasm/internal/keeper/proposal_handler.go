@@ -16,10 +16,14 @@ type governing interface {
 	create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, source string, builder string, instantiateAccess *types.AccessConfig, authZ AuthorizationPolicy) (codeID uint64, err error)
 	instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, authZ AuthorizationPolicy) (sdk.AccAddress, []byte, error)
 	migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte, authZ AuthorizationPolicy) (*sdk.Result, error)
+	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) (*sdk.Result, error)
+	Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) (*sdk.Result, error)
+	distributeFromCommunityPool(ctx sdk.Context, amount sdk.Coins, receiver sdk.AccAddress) error
 	setContractAdmin(ctx sdk.Context, contractAddress, caller, newAdmin sdk.AccAddress, authZ AuthorizationPolicy) error
 	PinCode(ctx sdk.Context, codeID uint64) error
 	UnpinCode(ctx sdk.Context, codeID uint64) error
 	updateContractStatus(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, status types.ContractStatus, authZ AuthorizationPolicy) error
+	setAccessConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, newConfig types.AccessConfig, authZ AuthorizationPolicy) error
 }
 
 // NewWasmProposalHandler creates a new governance Handler for wasm proposals
@@ -42,6 +46,10 @@ func NewWasmProposalHandler(k governing, enabledProposalTypes []types.ProposalTy
 			return handleInstantiateProposal(ctx, k, *c)
 		case *types.MigrateContractProposal:
 			return handleMigrateProposal(ctx, k, *c)
+		case *types.SudoContractProposal:
+			return handleSudoProposal(ctx, k, *c)
+		case *types.ExecuteContractProposal:
+			return handleExecuteProposal(ctx, k, *c)
 		case *types.UpdateAdminProposal:
 			return handleUpdateAdminProposal(ctx, k, *c)
 		case *types.ClearAdminProposal:
@@ -52,6 +60,8 @@ func NewWasmProposalHandler(k governing, enabledProposalTypes []types.ProposalTy
 			return handleUnpinCodesProposal(ctx, k, *c)
 		case *types.UpdateContractStatusProposal:
 			return handleUpdateContractStatusProposal(ctx, k, *c)
+		case *types.UpdateInstantiateConfigProposal:
+			return handleUpdateInstantiateConfigProposal(ctx, k, *c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized wasm proposal content type: %T", c)
 		}
@@ -141,6 +151,58 @@ func handleMigrateProposal(ctx sdk.Context, k governing, p types.MigrateContract
 	return nil
 }
 
+func handleSudoProposal(ctx sdk.Context, k governing, p types.SudoContractProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if _, err = k.Sudo(ctx, contractAddr, p.Msg); err != nil {
+		return err
+	}
+
+	ourEvent := sdk.NewEvent(
+		types.EventTypeSudoContract,
+		sdk.NewAttribute(types.AttributeKeyContract, p.Contract),
+	)
+	ctx.EventManager().EmitEvent(ourEvent)
+	return nil
+}
+
+func handleExecuteProposal(ctx sdk.Context, k governing, p types.ExecuteContractProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	runAsAddr, err := sdk.AccAddressFromBech32(p.RunAs)
+	if err != nil {
+		return sdkerrors.Wrap(err, "run as address")
+	}
+	// the funds are paid out of the community pool and then sent to the contract by the run as address
+	if !p.Funds.IsZero() {
+		if err := k.distributeFromCommunityPool(ctx, p.Funds, runAsAddr); err != nil {
+			return sdkerrors.Wrap(err, "community pool")
+		}
+	}
+	if _, err = k.Execute(ctx, contractAddr, runAsAddr, p.Msg, p.Funds); err != nil {
+		return err
+	}
+
+	ourEvent := sdk.NewEvent(
+		types.EventTypeExecuteContract,
+		sdk.NewAttribute(types.AttributeKeyContract, p.Contract),
+	)
+	ctx.EventManager().EmitEvent(ourEvent)
+	return nil
+}
+
 func handleUpdateAdminProposal(ctx sdk.Context, k governing, p types.UpdateAdminProposal) error {
 	if err := p.ValidateBasic(); err != nil {
 		return err
@@ -253,3 +315,16 @@ func handleUpdateContractStatusProposal(ctx sdk.Context, k governing, p types.Up
 	))
 	return nil
 }
+
+func handleUpdateInstantiateConfigProposal(ctx sdk.Context, k governing, p types.UpdateInstantiateConfigProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	for _, accessConfigUpdate := range p.AccessConfigUpdates {
+		err := k.setAccessConfig(ctx, accessConfigUpdate.CodeID, nil, accessConfigUpdate.InstantiatePermission, GovAuthorizationPolicy{})
+		if err != nil {
+			return sdkerrors.Wrapf(err, "code id: %d", accessConfigUpdate.CodeID)
+		}
+	}
+	return nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/line/lfb-sdk/x/wasm/internal/keeper/wasmtesting"
 	"github.com/line/lfb-sdk/x/wasm/internal/types"
 	wasmvm "github.com/line/wasmvm"
+	wasmvmtypes "github.com/line/wasmvm/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -605,3 +606,185 @@ func TestUpdateContractStatusProposals(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateInstantiateConfigProposal(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, "staking", nil, nil)
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+
+	mock := wasmtesting.MockWasmer{
+		CreateFn:      wasmtesting.NoOpCreateFn,
+		AnalyzeCodeFn: wasmtesting.WithoutIBCAnalyzeFn,
+	}
+	anyAddress := RandomAccountAddress(t)
+	var (
+		nobody    = StoreRandomContract(t, ctx, keepers, &mock)
+		everybody = StoreRandomContract(t, ctx, keepers, &mock)
+	)
+	require.NoError(t, wasmKeeper.setAccessConfig(ctx, nobody.CodeID, nil, types.AllowNobody, GovAuthorizationPolicy{}))
+
+	specs := map[string]struct {
+		accessConfigUpdates []types.AccessConfigUpdate
+		expErr              bool
+	}{
+		"update one": {
+			accessConfigUpdates: []types.AccessConfigUpdate{
+				{CodeID: nobody.CodeID, InstantiatePermission: types.AllowEverybody},
+			},
+		},
+		"update multiple": {
+			accessConfigUpdates: []types.AccessConfigUpdate{
+				{CodeID: nobody.CodeID, InstantiatePermission: types.AccessTypeOnlyAddress.With(anyAddress)},
+				{CodeID: everybody.CodeID, InstantiatePermission: types.AllowNobody},
+			},
+		},
+		"update same code id": {
+			accessConfigUpdates: []types.AccessConfigUpdate{
+				{CodeID: nobody.CodeID, InstantiatePermission: types.AllowEverybody},
+				{CodeID: nobody.CodeID, InstantiatePermission: types.AllowNobody},
+			},
+			expErr: true,
+		},
+		"update non existing code id": {
+			accessConfigUpdates: []types.AccessConfigUpdate{
+				{CodeID: 100, InstantiatePermission: types.AllowEverybody},
+			},
+			expErr: true,
+		},
+		"update empty list": {
+			accessConfigUpdates: []types.AccessConfigUpdate{},
+			expErr:              true,
+		},
+	}
+	parentCtx := ctx
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			proposal := types.UpdateInstantiateConfigProposal{
+				Title:               "Foo",
+				Description:         "Bar",
+				AccessConfigUpdates: spec.accessConfigUpdates,
+			}
+
+			// when stored
+			storedProposal, gotErr := govKeeper.SubmitProposal(ctx, &proposal)
+			if spec.expErr {
+				if gotErr == nil {
+					// errors not caught by validate basic surface on execution
+					handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+					gotErr = handler(ctx, storedProposal.GetContent())
+				}
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+
+			// and proposal execute
+			handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+			gotErr = handler(ctx, storedProposal.GetContent())
+			require.NoError(t, gotErr)
+
+			// then
+			for _, update := range spec.accessConfigUpdates {
+				c := wasmKeeper.GetCodeInfo(ctx, update.CodeID)
+				assert.Equal(t, update.InstantiatePermission, c.InstantiateConfig)
+			}
+		})
+	}
+}
+
+func TestSudoContractProposal(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	govKeeper, accKeeper, bankKeeper := keepers.GovKeeper, keepers.AccountKeeper, keepers.BankKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	_, _, community := keyPubAddr()
+	msg := sudoMsg{
+		StealFunds: stealFundsMsg{
+			Recipient: community.String(),
+			Amount:    wasmvmtypes.Coins{wasmvmtypes.NewCoin(76, "denom")},
+		},
+	}
+	src := types.SudoContractProposalFixture(func(p *types.SudoContractProposal) {
+		p.Contract = example.Contract.String()
+		p.Msg = mustMarshal(t, msg)
+	})
+
+	// when stored
+	storedProposal, err := govKeeper.SubmitProposal(ctx, src)
+	require.NoError(t, err)
+
+	// and proposal execute
+	em := sdk.NewEventManager()
+	handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+	err = handler(ctx.WithEventManager(em), storedProposal.GetContent())
+	require.NoError(t, err)
+
+	// then
+	comAcct := accKeeper.GetAccount(ctx, community)
+	require.NotNil(t, comAcct)
+	assert.Equal(t, sdk.NewInt64Coin("denom", 76), bankKeeper.GetBalance(ctx, community, "denom"))
+	require.NotEmpty(t, em.Events())
+	assert.Equal(t, types.EventTypeSudoContract, em.Events()[len(em.Events())-1].Type)
+}
+
+func TestExecuteContractProposal(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	govKeeper, distKeeper, bankKeeper := keepers.GovKeeper, keepers.DistKeeper, keepers.BankKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	// the community pool holds some funds
+	poolFunds := sdk.NewCoins(sdk.NewInt64Coin("denom", 500))
+	funder := createFakeFundedAccount(t, ctx, keepers.AccountKeeper, bankKeeper, poolFunds)
+	require.NoError(t, distKeeper.FundCommunityPool(ctx, poolFunds, funder))
+
+	specs := map[string]struct {
+		funds          sdk.Coins
+		expErr         bool
+		expBeneficiary sdk.Coins
+		expPool        sdk.Coins
+	}{
+		"release with funds from community pool": {
+			funds:          sdk.NewCoins(sdk.NewInt64Coin("denom", 200)),
+			expBeneficiary: sdk.NewCoins(sdk.NewInt64Coin("denom", 300)),
+			expPool:        sdk.NewCoins(sdk.NewInt64Coin("denom", 300)),
+		},
+		"release without funds": {
+			expBeneficiary: sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+			expPool:        poolFunds,
+		},
+		"funds exceed community pool": {
+			funds:  sdk.NewCoins(sdk.NewInt64Coin("denom", 501)),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			src := types.ExecuteContractProposalFixture(func(p *types.ExecuteContractProposal) {
+				p.Contract = example.Contract.String()
+				p.RunAs = example.VerifierAddr.String()
+				p.Msg = []byte(`{"release":{}}`)
+				p.Funds = spec.funds
+			})
+
+			// when stored
+			storedProposal, err := govKeeper.SubmitProposal(ctx, src)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			// and proposal execute
+			handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+			err = handler(ctx, storedProposal.GetContent())
+			require.NoError(t, err)
+
+			// then
+			assert.Equal(t, spec.expBeneficiary, bankKeeper.GetAllBalances(ctx, example.BeneficiaryAddr))
+			assert.True(t, bankKeeper.GetAllBalances(ctx, example.Contract).IsZero())
+			pool, _ := distKeeper.GetFeePoolCommunityCoins(ctx).TruncateDecimal()
+			assert.Equal(t, spec.expPool, pool)
+		})
+	}
+}
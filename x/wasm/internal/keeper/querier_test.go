@@ -1,10 +1,12 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"sort"
 	"testing"
 
 	"github.com/line/lfb-sdk/x/wasm/internal/keeper/wasmtesting"
@@ -156,6 +158,7 @@ func TestQuerySmartContractPanics(t *testing.T) {
 	keepers.WasmKeeper.storeCodeInfo(ctx, 1, types.CodeInfo{})
 	keepers.WasmKeeper.storeContractInfo(ctx, contractAddr, &types.ContractInfo{
 		CodeID:  1,
+		Creator: RandomBech32AccountAddress(t),
 		Created: types.NewAbsoluteTxPosition(ctx),
 	})
 	ctx = ctx.WithGasMeter(sdk.NewGasMeter(types.DefaultInstanceCost)).WithLogger(log.TestingLogger())
@@ -531,3 +534,185 @@ func fromBase64(s string) []byte {
 	}
 	return r
 }
+
+func TestQueryPinnedCodes(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+
+	exampleContract1 := StoreHackatomExampleContract(t, ctx, keepers)
+	StoreHackatomExampleContract(t, ctx, keepers)
+	exampleContract3 := StoreHackatomExampleContract(t, ctx, keepers)
+	require.NoError(t, keeper.PinCode(ctx, exampleContract1.CodeID))
+	require.NoError(t, keeper.PinCode(ctx, exampleContract3.CodeID))
+
+	specs := map[string]struct {
+		req        types.QueryPinnedCodesRequest
+		expCodeIDs []uint64
+	}{
+		"all": {
+			expCodeIDs: []uint64{exampleContract1.CodeID, exampleContract3.CodeID},
+		},
+		"with pagination offset": {
+			req: types.QueryPinnedCodesRequest{
+				Pagination: &query.PageRequest{
+					Offset: 1,
+				},
+			},
+			expCodeIDs: []uint64{exampleContract3.CodeID},
+		},
+		"with pagination limit": {
+			req: types.QueryPinnedCodesRequest{
+				Pagination: &query.PageRequest{
+					Limit: 1,
+				},
+			},
+			expCodeIDs: []uint64{exampleContract1.CodeID},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := NewQuerier(keeper).PinnedCodes(sdk.WrapSDKContext(ctx), &spec.req)
+			require.NoError(t, err)
+			assert.Equal(t, spec.expCodeIDs, got.CodeIDs)
+		})
+	}
+}
+
+func TestQueryCodesByHash(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+
+	hackatom1 := StoreHackatomExampleContract(t, ctx, keepers)
+	reflectID := StoreReflectContract(t, ctx, keepers)
+	hackatom2 := StoreHackatomExampleContract(t, ctx, keepers)
+	hackatomHash := keeper.GetCodeInfo(ctx, hackatom1.CodeID).CodeHash
+	reflectHash := keeper.GetCodeInfo(ctx, reflectID).CodeHash
+
+	specs := map[string]struct {
+		req        types.QueryCodesByHashRequest
+		expCodeIDs []uint64
+		expErr     error
+	}{
+		"shared code hash": {
+			req:        types.QueryCodesByHashRequest{CodeHash: hackatomHash},
+			expCodeIDs: []uint64{hackatom1.CodeID, hackatom2.CodeID},
+		},
+		"single code": {
+			req:        types.QueryCodesByHashRequest{CodeHash: reflectHash},
+			expCodeIDs: []uint64{reflectID},
+		},
+		"with pagination limit": {
+			req: types.QueryCodesByHashRequest{
+				CodeHash:   hackatomHash,
+				Pagination: &query.PageRequest{Limit: 1},
+			},
+			expCodeIDs: []uint64{hackatom1.CodeID},
+		},
+		"prefix of a code hash": {
+			req:        types.QueryCodesByHashRequest{CodeHash: hackatomHash[:10]},
+			expCodeIDs: []uint64{},
+		},
+		"unknown code hash": {
+			req:        types.QueryCodesByHashRequest{CodeHash: make([]byte, 32)},
+			expCodeIDs: []uint64{},
+		},
+		"empty code hash": {
+			expErr: types.ErrEmpty,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := NewQuerier(keeper).CodesByHash(sdk.WrapSDKContext(ctx), &spec.req)
+			if spec.expErr != nil {
+				assert.ErrorIs(t, err, spec.expErr)
+				return
+			}
+			require.NoError(t, err)
+			gotCodeIDs := make([]uint64, 0)
+			for _, info := range got.CodeInfos {
+				gotCodeIDs = append(gotCodeIDs, info.CodeID)
+				assert.Equal(t, spec.req.CodeHash, []byte(info.DataHash))
+			}
+			assert.Equal(t, spec.expCodeIDs, gotCodeIDs)
+		})
+	}
+}
+
+func TestQueryContractsByCreatorAndAdmin(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+	creator := example.CreatorAddr
+	_, _, otherCreator := keyPubAddr()
+	admin, otherAdmin := RandomAccountAddress(t), RandomAccountAddress(t)
+	initMsg := HackatomExampleInitMsg{
+		Verifier:    RandomAccountAddress(t),
+		Beneficiary: RandomAccountAddress(t),
+	}.GetBytes(t)
+
+	var contracts []sdk.AccAddress
+	for i := 0; i < 3; i++ {
+		// instantiate in separate blocks to order by creation
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		addr, _, err := keeper.Instantiate(ctx, example.CodeID, creator, admin, initMsg, "label", nil)
+		require.NoError(t, err)
+		contracts = append(contracts, addr)
+	}
+	otherContract, _, err := keeper.Instantiate(ctx, example.CodeID, otherCreator, nil, initMsg, "label", nil)
+	require.NoError(t, err)
+
+	q := NewQuerier(keeper)
+	queryByCreator := func(t *testing.T, creator sdk.AccAddress, page *query.PageRequest) []string {
+		got, err := q.ContractsByCreator(sdk.WrapSDKContext(ctx), &types.QueryContractsByCreatorRequest{
+			CreatorAddress: creator.String(),
+			Pagination:     page,
+		})
+		require.NoError(t, err)
+		return got.ContractAddresses
+	}
+	queryByAdmin := func(t *testing.T, admin sdk.AccAddress) []string {
+		got, err := q.ContractsByAdmin(sdk.WrapSDKContext(ctx), &types.QueryContractsByAdminRequest{
+			AdminAddress: admin.String(),
+		})
+		require.NoError(t, err)
+		return got.ContractAddresses
+	}
+	sortedStrings := func(addrs ...sdk.AccAddress) []string {
+		sorted := append([]sdk.AccAddress{}, addrs...)
+		sort.Slice(sorted, func(i, j int) bool { return bytes.Compare(sorted[i], sorted[j]) < 0 })
+		r := make([]string, len(sorted))
+		for i, a := range sorted {
+			r[i] = a.String()
+		}
+		return r
+	}
+
+	// creator index in instantiation order
+	assert.Equal(t, []string{contracts[0].String(), contracts[1].String(), contracts[2].String()}, queryByCreator(t, creator, nil))
+	assert.Equal(t, []string{contracts[1].String()}, queryByCreator(t, creator, &query.PageRequest{Offset: 1, Limit: 1}))
+	assert.Equal(t, []string{otherContract.String()}, queryByCreator(t, otherCreator, nil))
+	assert.Empty(t, queryByCreator(t, RandomAccountAddress(t), nil))
+
+	// admin index
+	assert.Equal(t, sortedStrings(contracts...), queryByAdmin(t, admin))
+	assert.Empty(t, queryByAdmin(t, otherAdmin))
+
+	// when admin changed
+	require.NoError(t, keeper.UpdateContractAdmin(ctx, contracts[0], admin, otherAdmin))
+	assert.Equal(t, sortedStrings(contracts[1:]...), queryByAdmin(t, admin))
+	assert.Equal(t, []string{contracts[0].String()}, queryByAdmin(t, otherAdmin))
+
+	// when admin cleared
+	require.NoError(t, keeper.ClearContractAdmin(ctx, contracts[1], admin))
+	assert.Equal(t, []string{contracts[2].String()}, queryByAdmin(t, admin))
+
+	// creator index is not affected by admin changes
+	assert.Len(t, queryByCreator(t, creator, nil), 3)
+
+	// invalid addresses
+	_, err = q.ContractsByCreator(sdk.WrapSDKContext(ctx), &types.QueryContractsByCreatorRequest{CreatorAddress: "invalid"})
+	require.Error(t, err)
+	_, err = q.ContractsByAdmin(sdk.WrapSDKContext(ctx), &types.QueryContractsByAdminRequest{AdminAddress: "invalid"})
+	require.Error(t, err)
+}
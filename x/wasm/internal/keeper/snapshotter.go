@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"io"
+
+	"github.com/line/ostracon/libs/log"
+	tmproto "github.com/line/ostracon/proto/ostracon/types"
+
+	snapshottypes "github.com/line/lfb-sdk/snapshots/types"
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	"github.com/line/lfb-sdk/x/wasm/internal/types"
+)
+
+var _ snapshottypes.ExtensionSnapshotter = &WasmSnapshotter{}
+
+// SnapshotFormat format 1 is the raw wasm bytecode of every stored code, one payload per code hash.
+const SnapshotFormat = 1
+
+// WasmSnapshotter is a snapshot extension that carries the wasm bytecode kept in the wasmvm cache
+// directory, which is not part of the multistore.
+type WasmSnapshotter struct {
+	cms  sdk.MultiStore
+	wasm *Keeper
+}
+
+// NewWasmSnapshotter creates a new WasmSnapshotter reading the code infos from the given multistore.
+func NewWasmSnapshotter(cms sdk.MultiStore, wasm *Keeper) *WasmSnapshotter {
+	return &WasmSnapshotter{
+		cms:  cms,
+		wasm: wasm,
+	}
+}
+
+// SnapshotName implements types.ExtensionSnapshotter.
+func (ws *WasmSnapshotter) SnapshotName() string {
+	return types.ModuleName
+}
+
+// SnapshotFormat implements types.ExtensionSnapshotter.
+func (ws *WasmSnapshotter) SnapshotFormat() uint32 {
+	return SnapshotFormat
+}
+
+// SupportedFormats implements types.ExtensionSnapshotter.
+func (ws *WasmSnapshotter) SupportedFormats() []uint32 {
+	// If we support older formats, add them here and handle them in Restore
+	return []uint32{SnapshotFormat}
+}
+
+// Snapshot implements types.ExtensionSnapshotter. It writes the bytecode of every code stored at
+// the given height, skipping codes that share a code hash.
+func (ws *WasmSnapshotter) Snapshot(height uint64, payloadWriter snapshottypes.ExtensionPayloadWriter) error {
+	cacheMS, err := ws.cms.CacheMultiStoreWithVersion(int64(height))
+	if err != nil {
+		return err
+	}
+	ctx := sdk.NewContext(cacheMS, tmproto.Header{}, false, log.NewNopLogger())
+
+	seenBefore := make(map[string]bool)
+	var rerr error
+	ws.wasm.IterateCodeInfos(ctx, func(id uint64, info types.CodeInfo) bool {
+		hash := hex.EncodeToString(info.CodeHash)
+		if seenBefore[hash] {
+			return false
+		}
+		seenBefore[hash] = true
+
+		code, err := ws.wasm.wasmer.GetCode(info.CodeHash)
+		if err != nil {
+			rerr = sdkerrors.Wrapf(err, "code id %d", id)
+			return true
+		}
+		if err := payloadWriter(code); err != nil {
+			rerr = err
+			return true
+		}
+		return false
+	})
+	return rerr
+}
+
+// Restore implements types.ExtensionSnapshotter. It stores the bytecode in the wasmvm cache, then
+// checks that the code of every restored code info is available and pins the pinned codes again.
+func (ws *WasmSnapshotter) Restore(
+	height uint64, format uint32, payloadReader snapshottypes.ExtensionPayloadReader,
+) error {
+	if format != SnapshotFormat {
+		return sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	}
+
+	for {
+		payload, err := payloadReader()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		if _, err := ws.wasm.wasmer.Create(payload); err != nil {
+			return sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
+		}
+	}
+
+	return ws.finalizeRestore(height)
+}
+
+func (ws *WasmSnapshotter) finalizeRestore(height uint64) error {
+	cacheMS, err := ws.cms.CacheMultiStoreWithVersion(int64(height))
+	if err != nil {
+		return err
+	}
+	ctx := sdk.NewContext(cacheMS, tmproto.Header{}, false, log.NewNopLogger())
+
+	var rerr error
+	ws.wasm.IterateCodeInfos(ctx, func(id uint64, info types.CodeInfo) bool {
+		code, err := ws.wasm.wasmer.GetCode(info.CodeHash)
+		if err != nil || len(code) == 0 {
+			rerr = sdkerrors.Wrapf(types.ErrNotFound, "wasm code of code id %d", id)
+			return true
+		}
+		return false
+	})
+	if rerr != nil {
+		return rerr
+	}
+	return ws.wasm.InitializePinnedCodes(ctx)
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/line/tm-db/v2/memdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	snapshottypes "github.com/line/lfb-sdk/snapshots/types"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/wasm/internal/types"
+)
+
+func TestSnapshotter(t *testing.T) {
+	db := memdb.NewDB()
+	ctx, keepers := createTestInput(t, false, SupportedFeatures, nil, nil, types.DefaultWasmConfig(), db)
+	cms := ctx.MultiStore().(sdk.CommitMultiStore)
+
+	hackatom := StoreHackatomExampleContract(t, ctx, keepers)
+	reflectID := StoreReflectContract(t, ctx, keepers)
+	// same code again to check that the bytecode is written only once
+	duplicate := StoreHackatomExampleContract(t, ctx, keepers)
+	require.NoError(t, keepers.WasmKeeper.PinCode(ctx, reflectID))
+	height := uint64(cms.Commit().Version)
+
+	var payloads [][]byte
+	srcSnapshotter := NewWasmSnapshotter(cms, keepers.WasmKeeper)
+	err := srcSnapshotter.Snapshot(height, func(payload []byte) error {
+		payloads = append(payloads, payload)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, payloads, 2)
+
+	// a fresh keeper on the same state but with an empty wasmvm cache
+	destCtx, destKeepers := createTestInput(t, false, SupportedFeatures, nil, nil, types.DefaultWasmConfig(), db)
+	destCMS := destCtx.MultiStore().(sdk.CommitMultiStore)
+	_, err = destKeepers.WasmKeeper.GetByteCode(destCtx, hackatom.CodeID)
+	require.Error(t, err)
+
+	destSnapshotter := NewWasmSnapshotter(destCMS, destKeepers.WasmKeeper)
+	err = destSnapshotter.Restore(height, SnapshotFormat, payloadReader(payloads))
+	require.NoError(t, err)
+
+	hackatomCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+	reflectCode, err := ioutil.ReadFile("./testdata/reflect.wasm")
+	require.NoError(t, err)
+	for codeID, exp := range map[uint64][]byte{
+		hackatom.CodeID:  hackatomCode,
+		duplicate.CodeID: hackatomCode,
+		reflectID:        reflectCode,
+	} {
+		code, err := destKeepers.WasmKeeper.GetByteCode(destCtx, codeID)
+		require.NoError(t, err)
+		assert.Equal(t, exp, code)
+	}
+	assert.True(t, destKeepers.WasmKeeper.IsPinnedCode(destCtx, reflectID))
+}
+
+func TestSnapshotterRestoreErrors(t *testing.T) {
+	db := memdb.NewDB()
+	ctx, keepers := createTestInput(t, false, SupportedFeatures, nil, nil, types.DefaultWasmConfig(), db)
+	cms := ctx.MultiStore().(sdk.CommitMultiStore)
+	StoreHackatomExampleContract(t, ctx, keepers)
+	height := uint64(cms.Commit().Version)
+
+	destCtx, destKeepers := createTestInput(t, false, SupportedFeatures, nil, nil, types.DefaultWasmConfig(), db)
+	destSnapshotter := NewWasmSnapshotter(destCtx.MultiStore(), destKeepers.WasmKeeper)
+
+	specs := map[string]struct {
+		format   uint32
+		payloads [][]byte
+		expErr   error
+	}{
+		"unknown format": {
+			format: SnapshotFormat + 1,
+			expErr: snapshottypes.ErrUnknownFormat,
+		},
+		"invalid wasm code": {
+			format:   SnapshotFormat,
+			payloads: [][]byte{[]byte("not wasm")},
+			expErr:   types.ErrCreateFailed,
+		},
+		"missing wasm code": {
+			format: SnapshotFormat,
+			expErr: types.ErrNotFound,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := destSnapshotter.Restore(height, spec.format, payloadReader(spec.payloads))
+			assert.ErrorIs(t, err, spec.expErr)
+		})
+	}
+}
+
+func payloadReader(payloads [][]byte) snapshottypes.ExtensionPayloadReader {
+	return func() ([]byte, error) {
+		if len(payloads) == 0 {
+			return nil, io.EOF
+		}
+		next := payloads[0]
+		payloads = payloads[1:]
+		return next, nil
+	}
+}
@@ -0,0 +1,78 @@
+package types
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	authztypes "github.com/line/lfb-sdk/x/authz/types"
+)
+
+var _ authztypes.Authorization = &ContractExecutionAuthorization{}
+
+// NewContractExecutionAuthorization creates a new ContractExecutionAuthorization
+// for the given contracts, allowing to send up to spendLimit with the executions.
+func NewContractExecutionAuthorization(spendLimit sdk.Coins, contracts ...sdk.AccAddress) *ContractExecutionAuthorization {
+	addrs := make([]string, len(contracts))
+	for i, c := range contracts {
+		addrs[i] = c.String()
+	}
+	return &ContractExecutionAuthorization{Contracts: addrs, SpendLimit: spendLimit}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a ContractExecutionAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgExecuteContract{})
+}
+
+// Accept implements Authorization.Accept. Only executions of the listed
+// contracts are accepted, and their funds are deducted from the spend limit.
+// The authorization is deleted once the spend limit is used up.
+func (a ContractExecutionAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authztypes.AcceptResponse, error) {
+	exec, ok := msg.(*MsgExecuteContract)
+	if !ok {
+		return authztypes.AcceptResponse{}, sdkerrors.Wrap(sdkerrors.ErrInvalidType, "type mismatch")
+	}
+
+	allowed := false
+	for _, c := range a.Contracts {
+		if c == exec.Contract {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return authztypes.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "contract %s is not allowed", exec.Contract)
+	}
+
+	if exec.Funds.Empty() {
+		return authztypes.AcceptResponse{Accept: true}, nil
+	}
+
+	limitLeft, isNegative := a.SpendLimit.SafeSub(exec.Funds)
+	if isNegative {
+		return authztypes.AcceptResponse{}, sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "requested funds are more than spend limit")
+	}
+	if limitLeft.IsZero() {
+		return authztypes.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authztypes.AcceptResponse{
+		Accept:  true,
+		Updated: &ContractExecutionAuthorization{Contracts: a.Contracts, SpendLimit: limitLeft},
+	}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a ContractExecutionAuthorization) ValidateBasic() error {
+	if len(a.Contracts) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "contracts")
+	}
+	for _, c := range a.Contracts {
+		if _, err := sdk.AccAddressFromBech32(c); err != nil {
+			return sdkerrors.Wrap(err, "contract")
+		}
+	}
+	if !a.SpendLimit.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "spend limit must be valid and positive")
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+)
+
+func TestContractExecutionAuthorization(t *testing.T) {
+	contract := sdk.AccAddress(make([]byte, sdk.AddrLen))
+	other := sdk.AccAddress(append(make([]byte, sdk.AddrLen-1), 1))
+	sender := sdk.AccAddress(append(make([]byte, sdk.AddrLen-1), 2))
+
+	a := NewContractExecutionAuthorization(nil, contract)
+	require.NoError(t, a.ValidateBasic())
+	require.Equal(t, "/cosmwasm.wasm.v1beta1.MsgExecuteContract", a.MsgTypeURL())
+
+	resp, err := a.Accept(sdk.Context{}, &MsgExecuteContract{Sender: sender.String(), Contract: contract.String()})
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+
+	_, err = a.Accept(sdk.Context{}, &MsgExecuteContract{Sender: sender.String(), Contract: other.String()})
+	require.Error(t, err)
+
+	_, err = a.Accept(sdk.Context{}, &MsgClearAdmin{Sender: sender.String(), Contract: contract.String()})
+	require.Error(t, err)
+
+	// no funds can be sent without a spend limit
+	_, err = a.Accept(sdk.Context{}, &MsgExecuteContract{Sender: sender.String(), Contract: contract.String(), Funds: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))})
+	require.True(t, sdkerrors.ErrInsufficientFunds.Is(err))
+
+	require.Error(t, NewContractExecutionAuthorization(nil).ValidateBasic())
+	require.Error(t, (&ContractExecutionAuthorization{Contracts: []string{"invalid"}}).ValidateBasic())
+	require.Error(t, (&ContractExecutionAuthorization{Contracts: []string{contract.String()}, SpendLimit: sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.ZeroInt()}}}).ValidateBasic())
+}
+
+func TestContractExecutionAuthorizationSpendLimit(t *testing.T) {
+	contract := sdk.AccAddress(make([]byte, sdk.AddrLen))
+	sender := sdk.AccAddress(append(make([]byte, sdk.AddrLen-1), 2))
+	execute := func(funds ...sdk.Coin) *MsgExecuteContract {
+		return &MsgExecuteContract{Sender: sender.String(), Contract: contract.String(), Funds: sdk.NewCoins(funds...)}
+	}
+
+	a := NewContractExecutionAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), contract)
+	require.NoError(t, a.ValidateBasic())
+
+	// funds over the spend limit are rejected
+	_, err := a.Accept(sdk.Context{}, execute(sdk.NewInt64Coin("stake", 101)))
+	require.True(t, sdkerrors.ErrInsufficientFunds.Is(err))
+	_, err = a.Accept(sdk.Context{}, execute(sdk.NewInt64Coin("other", 1)))
+	require.True(t, sdkerrors.ErrInsufficientFunds.Is(err))
+
+	// executions without funds leave the spend limit unchanged
+	resp, err := a.Accept(sdk.Context{}, execute())
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.Nil(t, resp.Updated)
+
+	// funds are deducted from the spend limit
+	resp, err = a.Accept(sdk.Context{}, execute(sdk.NewInt64Coin("stake", 40)))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Equal(t, NewContractExecutionAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), contract), resp.Updated)
+
+	// the authorization is deleted once the spend limit is used up
+	resp, err = resp.Updated.Accept(sdk.Context{}, execute(sdk.NewInt64Coin("stake", 60)))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+}
@@ -1,19 +1,23 @@
 package types
 
 const (
-	EventTypeStoreCode            = "store_code"
-	EventTypeInstantiateContract  = "instantiate_contract"
-	EventTypeExecuteContract      = "execute_contract"
-	EventTypeMigrateContract      = "migrate_contract"
-	EventTypeUpdateAdmin          = "update_admin"
-	EventTypeClearAdmin           = "clear_admin"
-	EventTypePinCode              = "pin_code"
-	EventTypeUnpinCode            = "unpin_code"
-	EventTypeUpdateContractStatus = "update_contract_status"
+	EventTypeStoreCode               = "store_code"
+	EventTypeInstantiateContract     = "instantiate_contract"
+	EventTypeExecuteContract         = "execute_contract"
+	EventTypeMigrateContract         = "migrate_contract"
+	EventTypeSudoContract            = "sudo_contract"
+	EventTypeUpdateAdmin             = "update_admin"
+	EventTypeClearAdmin              = "clear_admin"
+	EventTypePinCode                 = "pin_code"
+	EventTypeUnpinCode               = "unpin_code"
+	EventTypeUpdateContractStatus    = "update_contract_status"
+	EventTypeUpdateInstantiateConfig = "update_instantiate_config"
 )
 const ( // event attributes
-	AttributeKeyContract       = "contract_address"
-	AttributeKeyCodeID         = "code_id"
-	AttributeKeyCodeIDs        = "code_ids"
-	AttributeKeyContractStatus = "contract_status"
+	AttributeKeyContract           = "contract_address"
+	AttributeKeyCodeID             = "code_id"
+	AttributeKeyCodeIDs            = "code_ids"
+	AttributeKeyContractStatus     = "contract_status"
+	AttributeKeyCodePermission     = "code_permission"
+	AttributeKeyPrevCodePermission = "prev_code_permission"
 )
@@ -39,6 +39,8 @@ type AccountKeeper interface {
 // DistributionKeeper defines a subset of methods implemented by the cosmos-sdk distribution keeper
 type DistributionKeeper interface {
 	DelegationRewards(c context.Context, req *types.QueryDelegationRewardsRequest) (*types.QueryDelegationRewardsResponse, error)
+	// DistributeFromFeePool distributes funds from the community pool to a receiver address
+	DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error
 }
 
 // StakingKeeper defines a subset of methods implemented by the cosmos-sdk staking keeper
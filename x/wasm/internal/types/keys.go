@@ -30,6 +30,9 @@ var (
 	ContractCodeHistoryElementPrefix               = []byte{0x05}
 	ContractByCodeIDAndCreatedSecondaryIndexPrefix = []byte{0x06}
 	PinnedCodeIndexPrefix                          = []byte{0x07}
+	ContractByCreatorSecondaryIndexPrefix          = []byte{0x08}
+	ContractByAdminSecondaryIndexPrefix            = []byte{0x09}
+	CodeByHashSecondaryIndexPrefix                 = []byte{0x0a}
 
 	KeyLastCodeID     = append(SequenceKeyPrefix, []byte("lastCodeId")...)
 	KeyLastInstanceID = append(SequenceKeyPrefix, []byte("lastContractId")...)
@@ -73,6 +76,65 @@ func GetContractByCodeIDSecondaryIndexPrefix(codeID uint64) []byte {
 	return r
 }
 
+// GetContractByCreatorSecondaryIndexKey returns the key for the secondary index:
+// `<prefix><creatorAddr><created><contractAddr>`
+func GetContractByCreatorSecondaryIndexKey(creatorAddr, contractAddr sdk.AccAddress, created *AbsoluteTxPosition) []byte {
+	prefix := GetContractByCreatorSecondaryIndexPrefix(creatorAddr)
+	prefixLen := len(prefix)
+	r := make([]byte, prefixLen+AbsoluteTxPositionLen+sdk.AddrLen)
+	copy(r[0:], prefix)
+	copy(r[prefixLen:], created.Bytes())
+	copy(r[prefixLen+AbsoluteTxPositionLen:], contractAddr)
+	return r
+}
+
+// GetContractByCreatorSecondaryIndexPrefix returns the prefix for the second index: `<prefix><creatorAddr>`
+func GetContractByCreatorSecondaryIndexPrefix(creatorAddr sdk.AccAddress) []byte {
+	prefixLen := len(ContractByCreatorSecondaryIndexPrefix)
+	r := make([]byte, prefixLen+sdk.AddrLen)
+	copy(r[0:], ContractByCreatorSecondaryIndexPrefix)
+	copy(r[prefixLen:], creatorAddr)
+	return r
+}
+
+// GetContractByAdminSecondaryIndexKey returns the key for the secondary index: `<prefix><adminAddr><contractAddr>`
+func GetContractByAdminSecondaryIndexKey(adminAddr, contractAddr sdk.AccAddress) []byte {
+	prefix := GetContractByAdminSecondaryIndexPrefix(adminAddr)
+	prefixLen := len(prefix)
+	r := make([]byte, prefixLen+sdk.AddrLen)
+	copy(r[0:], prefix)
+	copy(r[prefixLen:], contractAddr)
+	return r
+}
+
+// GetContractByAdminSecondaryIndexPrefix returns the prefix for the second index: `<prefix><adminAddr>`
+func GetContractByAdminSecondaryIndexPrefix(adminAddr sdk.AccAddress) []byte {
+	prefixLen := len(ContractByAdminSecondaryIndexPrefix)
+	r := make([]byte, prefixLen+sdk.AddrLen)
+	copy(r[0:], ContractByAdminSecondaryIndexPrefix)
+	copy(r[prefixLen:], adminAddr)
+	return r
+}
+
+// GetCodeByHashSecondaryIndexKey returns the key for the secondary index: `<prefix><codeHash><codeID>`
+func GetCodeByHashSecondaryIndexKey(codeHash []byte, codeID uint64) []byte {
+	prefix := GetCodeByHashSecondaryIndexPrefix(codeHash)
+	prefixLen := len(prefix)
+	r := make([]byte, prefixLen+8)
+	copy(r[0:], prefix)
+	copy(r[prefixLen:], sdk.Uint64ToBigEndian(codeID))
+	return r
+}
+
+// GetCodeByHashSecondaryIndexPrefix returns the prefix for the second index: `<prefix><codeHash>`
+func GetCodeByHashSecondaryIndexPrefix(codeHash []byte) []byte {
+	prefixLen := len(CodeByHashSecondaryIndexPrefix)
+	r := make([]byte, prefixLen+len(codeHash))
+	copy(r[0:], CodeByHashSecondaryIndexPrefix)
+	copy(r[prefixLen:], codeHash)
+	return r
+}
+
 // GetContractCodeHistoryElementKey returns the key a contract code history entry: `<prefix><contractAddr><position>`
 func GetContractCodeHistoryElementKey(contractAddr sdk.AccAddress, pos uint64) []byte {
 	prefix := GetContractCodeHistoryElementPrefix(contractAddr)
@@ -43,3 +43,36 @@ func TestGetContractByCreatedSecondaryIndexKey(t *testing.T) {
 	}
 	assert.Equal(t, exp, got)
 }
+
+func TestGetContractByCreatorSecondaryIndexKey(t *testing.T) {
+	creator := bytes.Repeat([]byte{5}, sdk.AddrLen)
+	addr := bytes.Repeat([]byte{4}, sdk.AddrLen)
+	got := GetContractByCreatorSecondaryIndexKey(creator, addr, &AbsoluteTxPosition{2 + 1<<(8*7), 3 + 1<<(8*7)})
+	exp := []byte{8, // prefix
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, // creator
+		1, 0, 0, 0, 0, 0, 0, 2, // height
+		1, 0, 0, 0, 0, 0, 0, 3, // index
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, // address
+	}
+	assert.Equal(t, exp, got)
+}
+
+func TestGetContractByAdminSecondaryIndexKey(t *testing.T) {
+	admin := bytes.Repeat([]byte{5}, sdk.AddrLen)
+	addr := bytes.Repeat([]byte{4}, sdk.AddrLen)
+	got := GetContractByAdminSecondaryIndexKey(admin, addr)
+	exp := []byte{9, // prefix
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, // admin
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, // address
+	}
+	assert.Equal(t, exp, got)
+}
+
+func TestGetCodeByHashSecondaryIndexKey(t *testing.T) {
+	got := GetCodeByHashSecondaryIndexKey([]byte{0xaa, 0xbb}, 1)
+	exp := []byte{0x0a, // prefix
+		0xaa, 0xbb, // code hash
+		0, 0, 0, 0, 0, 0, 0, 1, // codeID
+	}
+	assert.Equal(t, exp, got)
+}
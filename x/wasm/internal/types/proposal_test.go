@@ -485,6 +485,184 @@ func TestValidateUpdateContractStatusProposal(t *testing.T) {
 	}
 }
 
+func TestValidateUpdateInstantiateConfigProposal(t *testing.T) {
+	specs := map[string]struct {
+		src    *UpdateInstantiateConfigProposal
+		expErr bool
+	}{
+		"all good": {
+			src: UpdateInstantiateConfigProposalFixture(),
+		},
+		"base data missing": {
+			src: UpdateInstantiateConfigProposalFixture(func(p *UpdateInstantiateConfigProposal) {
+				p.Title = ""
+			}),
+			expErr: true,
+		},
+		"updates missing": {
+			src: UpdateInstantiateConfigProposalFixture(func(p *UpdateInstantiateConfigProposal) {
+				p.AccessConfigUpdates = nil
+			}),
+			expErr: true,
+		},
+		"code id missing": {
+			src: UpdateInstantiateConfigProposalFixture(func(p *UpdateInstantiateConfigProposal) {
+				p.AccessConfigUpdates[0].CodeID = 0
+			}),
+			expErr: true,
+		},
+		"duplicate code id": {
+			src: UpdateInstantiateConfigProposalFixture(func(p *UpdateInstantiateConfigProposal) {
+				p.AccessConfigUpdates[1].CodeID = p.AccessConfigUpdates[0].CodeID
+			}),
+			expErr: true,
+		},
+		"permission missing": {
+			src: UpdateInstantiateConfigProposalFixture(func(p *UpdateInstantiateConfigProposal) {
+				p.AccessConfigUpdates[0].InstantiatePermission = AccessConfig{}
+			}),
+			expErr: true,
+		},
+		"permission address invalid": {
+			src: UpdateInstantiateConfigProposalFixture(func(p *UpdateInstantiateConfigProposal) {
+				p.AccessConfigUpdates[1].InstantiatePermission.Address = "invalid address"
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSudoContractProposal(t *testing.T) {
+	var (
+		invalidAddress = "invalid address"
+	)
+
+	specs := map[string]struct {
+		src    *SudoContractProposal
+		expErr bool
+	}{
+		"all good": {
+			src: SudoContractProposalFixture(),
+		},
+		"base data missing": {
+			src: SudoContractProposalFixture(func(p *SudoContractProposal) {
+				p.Title = ""
+			}),
+			expErr: true,
+		},
+		"contract missing": {
+			src: SudoContractProposalFixture(func(p *SudoContractProposal) {
+				p.Contract = ""
+			}),
+			expErr: true,
+		},
+		"contract invalid": {
+			src: SudoContractProposalFixture(func(p *SudoContractProposal) {
+				p.Contract = invalidAddress
+			}),
+			expErr: true,
+		},
+		"msg missing": {
+			src: SudoContractProposalFixture(func(p *SudoContractProposal) {
+				p.Msg = nil
+			}),
+			expErr: true,
+		},
+		"msg not json": {
+			src: SudoContractProposalFixture(func(p *SudoContractProposal) {
+				p.Msg = []byte("not json")
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateExecuteContractProposal(t *testing.T) {
+	var (
+		invalidAddress = "invalid address"
+	)
+
+	specs := map[string]struct {
+		src    *ExecuteContractProposal
+		expErr bool
+	}{
+		"all good": {
+			src: ExecuteContractProposalFixture(),
+		},
+		"without funds": {
+			src: ExecuteContractProposalFixture(func(p *ExecuteContractProposal) {
+				p.Funds = nil
+			}),
+		},
+		"base data missing": {
+			src: ExecuteContractProposalFixture(func(p *ExecuteContractProposal) {
+				p.Title = ""
+			}),
+			expErr: true,
+		},
+		"contract invalid": {
+			src: ExecuteContractProposalFixture(func(p *ExecuteContractProposal) {
+				p.Contract = invalidAddress
+			}),
+			expErr: true,
+		},
+		"run as missing": {
+			src: ExecuteContractProposalFixture(func(p *ExecuteContractProposal) {
+				p.RunAs = ""
+			}),
+			expErr: true,
+		},
+		"run as invalid": {
+			src: ExecuteContractProposalFixture(func(p *ExecuteContractProposal) {
+				p.RunAs = invalidAddress
+			}),
+			expErr: true,
+		},
+		"msg not json": {
+			src: ExecuteContractProposalFixture(func(p *ExecuteContractProposal) {
+				p.Msg = []byte("not json")
+			}),
+			expErr: true,
+		},
+		"funds invalid": {
+			src: ExecuteContractProposalFixture(func(p *ExecuteContractProposal) {
+				p.Funds = sdk.Coins{{Denom: "foo", Amount: sdk.NewInt(-1)}}
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestProposalStrings(t *testing.T) {
 	specs := map[string]struct {
 		src govtypes.Content
@@ -594,6 +772,26 @@ func TestProposalStrings(t *testing.T) {
   Title:       Foo
   Description: Bar
   Codes:       [3 2 1]
+`,
+		},
+		"sudo contract": {
+			src: SudoContractProposalFixture(),
+			exp: `Sudo Contract Proposal:
+  Title:       Foo
+  Description: Bar
+  Contract:    link1hcttwju93d5m39467gjcq63p5kc4fdcn30dgd8
+  Msg:         "{\"do\":\"something\"}"
+`,
+		},
+		"execute contract": {
+			src: ExecuteContractProposalFixture(),
+			exp: `Execute Contract Proposal:
+  Title:       Foo
+  Description: Bar
+  Contract:    link1hcttwju93d5m39467gjcq63p5kc4fdcn30dgd8
+  Run as:      link1qyqszqgpqyqszqgpqyqszqgpqyqszqgp8apuk5
+  Msg:         "{\"do\":\"something\"}"
+  Funds:       1stake
 `,
 		},
 	}
@@ -687,6 +885,21 @@ contract: link1hcttwju93d5m39467gjcq63p5kc4fdcn30dgd8
 			exp: `title: Foo
 description: Bar
 contract: link1hcttwju93d5m39467gjcq63p5kc4fdcn30dgd8
+`,
+		},
+		"update instantiate config": {
+			src: UpdateInstantiateConfigProposalFixture(),
+			exp: `title: Foo
+description: Bar
+access_config_updates:
+- code_id: 1
+  instantiate_permission:
+    permission: Nobody
+    address: ""
+- code_id: 2
+  instantiate_permission:
+    permission: OnlyAddress
+    address: link1hcttwju93d5m39467gjcq63p5kc4fdcn30dgd8
 `,
 		},
 		"pin codes": {
@@ -701,6 +914,26 @@ code_ids:
 - 1
 - 2
 - 3
+`,
+		},
+		"sudo contract": {
+			src: SudoContractProposalFixture(),
+			exp: `title: Foo
+description: Bar
+contract: link1hcttwju93d5m39467gjcq63p5kc4fdcn30dgd8
+msg: '{"do":"something"}'
+`,
+		},
+		"execute contract": {
+			src: ExecuteContractProposalFixture(),
+			exp: `title: Foo
+description: Bar
+contract: link1hcttwju93d5m39467gjcq63p5kc4fdcn30dgd8
+msg: '{"do":"something"}'
+run_as: link1qyqszqgpqyqszqgpqyqszqgpqyqszqgp8apuk5
+funds:
+- denom: stake
+  amount: "1"
 `,
 		},
 	}
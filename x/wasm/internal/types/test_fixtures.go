@@ -265,6 +265,44 @@ func MigrateContractProposalFixture(mutators ...func(p *MigrateContractProposal)
 	return p
 }
 
+func SudoContractProposalFixture(mutators ...func(p *SudoContractProposal)) *SudoContractProposal {
+	const contractAddr = "link1hcttwju93d5m39467gjcq63p5kc4fdcn30dgd8"
+
+	p := &SudoContractProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		Contract:    contractAddr,
+		Msg:         []byte(`{"do":"something"}`),
+	}
+	for _, m := range mutators {
+		m(p)
+	}
+	return p
+}
+
+func ExecuteContractProposalFixture(mutators ...func(p *ExecuteContractProposal)) *ExecuteContractProposal {
+	const (
+		contractAddr = "link1hcttwju93d5m39467gjcq63p5kc4fdcn30dgd8"
+		anyAddress   = "link1qyqszqgpqyqszqgpqyqszqgpqyqszqgp8apuk5"
+	)
+
+	p := &ExecuteContractProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		Contract:    contractAddr,
+		RunAs:       anyAddress,
+		Msg:         []byte(`{"do":"something"}`),
+		Funds: sdk.Coins{{
+			Denom:  "stake",
+			Amount: sdk.NewInt(1),
+		}},
+	}
+	for _, m := range mutators {
+		m(p)
+	}
+	return p
+}
+
 func UpdateAdminProposalFixture(mutators ...func(p *UpdateAdminProposal)) *UpdateAdminProposal {
 	const (
 		contractAddr = "link1hcttwju93d5m39467gjcq63p5kc4fdcn30dgd8"
@@ -310,3 +348,20 @@ func UpdateContractStatusProposalFixture(mutators ...func(p *UpdateContractStatu
 	}
 	return p
 }
+
+func UpdateInstantiateConfigProposalFixture(mutators ...func(p *UpdateInstantiateConfigProposal)) *UpdateInstantiateConfigProposal {
+	const anyAddress = "link1hcttwju93d5m39467gjcq63p5kc4fdcn30dgd8"
+
+	p := &UpdateInstantiateConfigProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		AccessConfigUpdates: []AccessConfigUpdate{
+			{CodeID: 1, InstantiatePermission: AllowNobody},
+			{CodeID: 2, InstantiatePermission: AccessConfig{Permission: AccessTypeOnlyAddress, Address: anyAddress}},
+		},
+	}
+	for _, m := range mutators {
+		m(p)
+	}
+	return p
+}
@@ -716,6 +716,86 @@ func (m *MsgUpdateContractStatusResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateContractStatusResponse proto.InternalMessageInfo
 
+// MsgUpdateInstantiateConfig updates the instantiate permission of a stored code
+type MsgUpdateInstantiateConfig struct {
+	// Sender is the that actor that signed the messages
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// CodeID references the stored WASM code
+	CodeID uint64 `protobuf:"varint,2,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// NewInstantiatePermission is the new access control
+	NewInstantiatePermission *AccessConfig `protobuf:"bytes,3,opt,name=new_instantiate_permission,json=newInstantiatePermission,proto3" json:"new_instantiate_permission,omitempty"`
+}
+
+func (m *MsgUpdateInstantiateConfig) Reset()         { *m = MsgUpdateInstantiateConfig{} }
+func (m *MsgUpdateInstantiateConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateInstantiateConfig) ProtoMessage()    {}
+func (*MsgUpdateInstantiateConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{16}
+}
+func (m *MsgUpdateInstantiateConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateInstantiateConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateInstantiateConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateInstantiateConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateInstantiateConfig.Merge(m, src)
+}
+func (m *MsgUpdateInstantiateConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateInstantiateConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateInstantiateConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateInstantiateConfig proto.InternalMessageInfo
+
+// MsgUpdateInstantiateConfigResponse returns empty data
+type MsgUpdateInstantiateConfigResponse struct {
+}
+
+func (m *MsgUpdateInstantiateConfigResponse) Reset()         { *m = MsgUpdateInstantiateConfigResponse{} }
+func (m *MsgUpdateInstantiateConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateInstantiateConfigResponse) ProtoMessage()    {}
+func (*MsgUpdateInstantiateConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{17}
+}
+func (m *MsgUpdateInstantiateConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateInstantiateConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateInstantiateConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateInstantiateConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateInstantiateConfigResponse.Merge(m, src)
+}
+func (m *MsgUpdateInstantiateConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateInstantiateConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateInstantiateConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateInstantiateConfigResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgStoreCode)(nil), "cosmwasm.wasm.v1beta1.MsgStoreCode")
 	proto.RegisterType((*MsgStoreCodeResponse)(nil), "cosmwasm.wasm.v1beta1.MsgStoreCodeResponse")
@@ -733,71 +813,76 @@ func init() {
 	proto.RegisterType((*MsgClearAdminResponse)(nil), "cosmwasm.wasm.v1beta1.MsgClearAdminResponse")
 	proto.RegisterType((*MsgUpdateContractStatus)(nil), "cosmwasm.wasm.v1beta1.MsgUpdateContractStatus")
 	proto.RegisterType((*MsgUpdateContractStatusResponse)(nil), "cosmwasm.wasm.v1beta1.MsgUpdateContractStatusResponse")
+	proto.RegisterType((*MsgUpdateInstantiateConfig)(nil), "cosmwasm.wasm.v1beta1.MsgUpdateInstantiateConfig")
+	proto.RegisterType((*MsgUpdateInstantiateConfigResponse)(nil), "cosmwasm.wasm.v1beta1.MsgUpdateInstantiateConfigResponse")
 }
 
 func init() { proto.RegisterFile("tx.proto", fileDescriptor_0fd2153dc07d3b5c) }
 
 var fileDescriptor_0fd2153dc07d3b5c = []byte{
-	// 931 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0xd7, 0x6d, 0x7e, 0xbc, 0x84, 0x82, 0x4c, 0xdb, 0xb5, 0xbc, 0x28, 0x29, 0x2e, 0x2b,
-	0x15, 0x96, 0xda, 0xb4, 0x88, 0x45, 0x08, 0xed, 0x21, 0x09, 0x1c, 0x2a, 0x64, 0x84, 0x5c, 0x21,
-	0xc4, 0x4a, 0x28, 0x8c, 0xed, 0x89, 0x19, 0x70, 0x66, 0x22, 0xcf, 0x84, 0xb6, 0x42, 0x82, 0x7f,
-	0x80, 0x03, 0x17, 0xce, 0xdc, 0xd1, 0xfe, 0x15, 0x9c, 0x7a, 0x5c, 0x89, 0x0b, 0xa7, 0x02, 0xe9,
-	0x95, 0xbf, 0x80, 0x13, 0xf2, 0x8f, 0x38, 0x6e, 0x88, 0x53, 0xb7, 0xec, 0x89, 0x4b, 0xe5, 0x97,
-	0x7c, 0xef, 0x7d, 0xef, 0x7d, 0xfe, 0xe6, 0x4d, 0x03, 0x75, 0x71, 0x6a, 0x8c, 0x43, 0x26, 0x98,
-	0xb2, 0xe5, 0x32, 0x3e, 0x3a, 0x41, 0x7c, 0x64, 0xc4, 0x7f, 0xbe, 0x3e, 0x70, 0xb0, 0x40, 0x07,
-	0xda, 0xbd, 0x60, 0xe8, 0x98, 0x0e, 0xe2, 0xd8, 0x4c, 0x3f, 0x31, 0x5d, 0x46, 0x68, 0x92, 0xa3,
-	0x6d, 0xfa, 0xcc, 0x67, 0xf1, 0xa3, 0x19, 0x3d, 0xa5, 0x9f, 0x36, 0xc5, 0xd9, 0x18, 0xf3, 0x24,
-	0xd0, 0xff, 0x92, 0xa0, 0x65, 0x71, 0xff, 0x58, 0xb0, 0x10, 0xf7, 0x99, 0x87, 0x95, 0x6d, 0xa8,
-	0x72, 0x4c, 0x3d, 0x1c, 0xaa, 0xd2, 0x8e, 0xb4, 0xd7, 0xb0, 0xd3, 0x48, 0x79, 0x08, 0x1b, 0x11,
-	0xf1, 0xc0, 0x39, 0x13, 0x78, 0xe0, 0x32, 0x0f, 0xab, 0x77, 0x76, 0xa4, 0xbd, 0x56, 0xef, 0x85,
-	0xe9, 0x45, 0xa7, 0xf5, 0x49, 0xf7, 0xd8, 0xea, 0x9d, 0x89, 0xb8, 0x82, 0xdd, 0x8a, 0x70, 0xb3,
-	0x28, 0xae, 0xc7, 0x26, 0xa1, 0x8b, 0x55, 0x39, 0xad, 0x17, 0x47, 0x8a, 0x0a, 0x35, 0x67, 0x42,
-	0x82, 0x88, 0x68, 0x2d, 0xfe, 0x62, 0x16, 0x2a, 0x8f, 0x61, 0x9b, 0x50, 0x2e, 0x10, 0x15, 0x04,
-	0x09, 0x3c, 0x18, 0xe3, 0x70, 0x44, 0x38, 0x27, 0x8c, 0xaa, 0xeb, 0x3b, 0xd2, 0x5e, 0xf3, 0x70,
-	0xd7, 0x58, 0x2a, 0x85, 0xd1, 0x75, 0x5d, 0xcc, 0x79, 0x9f, 0xd1, 0x21, 0xf1, 0xed, 0xad, 0x5c,
-	0x89, 0x8f, 0xb2, 0x0a, 0xfa, 0xbb, 0xb0, 0x99, 0x9f, 0xd6, 0xc6, 0x7c, 0xcc, 0x28, 0xc7, 0xca,
-	0x2e, 0xd4, 0xa2, 0x99, 0x06, 0xc4, 0x8b, 0xc7, 0x5e, 0xeb, 0xc1, 0xf4, 0xa2, 0x53, 0x8d, 0x20,
-	0x47, 0xef, 0xd9, 0xd5, 0xe8, 0xab, 0x23, 0x4f, 0xff, 0xf1, 0x0e, 0x6c, 0x5b, 0xdc, 0x3f, 0x9a,
-	0x57, 0xee, 0x33, 0x2a, 0x42, 0xe4, 0x8a, 0x42, 0xd5, 0x36, 0x61, 0x1d, 0x79, 0x23, 0x42, 0x63,
-	0xb1, 0x1a, 0x76, 0x12, 0xe4, 0xd9, 0xe4, 0x22, 0xb6, 0x28, 0x35, 0x40, 0x0e, 0x0e, 0x52, 0x79,
-	0x92, 0x40, 0x79, 0x1b, 0xea, 0x84, 0x12, 0x31, 0x18, 0x71, 0x3f, 0x96, 0xa3, 0xd5, 0x7b, 0xe9,
-	0xef, 0x8b, 0x8e, 0x8a, 0xa9, 0xcb, 0x3c, 0x42, 0x7d, 0xf3, 0x4b, 0xce, 0xa8, 0x61, 0xa3, 0x13,
-	0x0b, 0x73, 0x8e, 0x7c, 0x6c, 0xd7, 0x22, 0xb4, 0xc5, 0x7d, 0xe5, 0x53, 0x58, 0x1f, 0x4e, 0xa8,
-	0xc7, 0xd5, 0xea, 0x8e, 0xbc, 0xd7, 0x3c, 0xdc, 0x36, 0x82, 0xa1, 0x63, 0x44, 0xc6, 0xc9, 0xf4,
-	0xeb, 0x33, 0x42, 0x7b, 0x0f, 0xce, 0x2f, 0x3a, 0x95, 0x9f, 0x7f, 0xef, 0xec, 0xfa, 0x44, 0x7c,
-	0x31, 0x71, 0x0c, 0x97, 0x8d, 0xcc, 0x80, 0x50, 0x6c, 0x06, 0x43, 0x67, 0x9f, 0x7b, 0x5f, 0x99,
-	0x89, 0x79, 0x22, 0x2c, 0xb7, 0x93, 0x8a, 0xfa, 0x87, 0xd0, 0x5e, 0x2e, 0x4b, 0x26, 0xaf, 0x0a,
-	0x35, 0xe4, 0x79, 0x21, 0xe6, 0x3c, 0xd5, 0x67, 0x16, 0x2a, 0x0a, 0xac, 0x79, 0x48, 0xa0, 0xc4,
-	0x4c, 0x76, 0xfc, 0xac, 0xff, 0x22, 0x83, 0x9e, 0x7f, 0x4b, 0x5d, 0xea, 0xdd, 0x44, 0xf3, 0xff,
-	0x85, 0x53, 0xe7, 0xce, 0xa9, 0xe6, 0x9d, 0x93, 0x99, 0xa2, 0x56, 0x64, 0x8a, 0xfa, 0xad, 0x4c,
-	0xd1, 0x78, 0xe6, 0xa6, 0xf8, 0x0e, 0x5e, 0xbb, 0xfe, 0x1d, 0xde, 0xe8, 0xfc, 0xe5, 0x5d, 0x74,
-	0x67, 0xb9, 0x8b, 0xe4, 0x9c, 0x8b, 0x7e, 0x95, 0x40, 0xb1, 0xb8, 0xff, 0xfe, 0x29, 0x76, 0x27,
-	0x25, 0x5c, 0xa3, 0x41, 0xdd, 0x4d, 0x31, 0x69, 0xf5, 0x2c, 0x56, 0x0c, 0x90, 0x23, 0x69, 0xe5,
-	0x12, 0xd2, 0x46, 0xc0, 0xb9, 0xac, 0xeb, 0xcf, 0x5c, 0xd6, 0x37, 0x40, 0xfb, 0xf7, 0x50, 0x99,
-	0x8c, 0x33, 0x1d, 0xa4, 0x9c, 0x0e, 0x4f, 0x12, 0x1d, 0x2c, 0xe2, 0x87, 0xe8, 0x3f, 0xea, 0x50,
-	0x6a, 0x6f, 0x3d, 0x82, 0xe6, 0x28, 0xe1, 0x8a, 0xfd, 0xb8, 0x56, 0x42, 0x34, 0x48, 0x13, 0x2c,
-	0xee, 0xa7, 0x03, 0x2e, 0x74, 0xbb, 0x72, 0x40, 0x04, 0x1b, 0x16, 0xf7, 0x3f, 0x1e, 0x7b, 0x48,
-	0xe0, 0x6e, 0x7c, 0x4a, 0x8a, 0x66, 0xbb, 0x07, 0x0d, 0x8a, 0x4f, 0x06, 0xf9, 0x8d, 0x5c, 0xa7,
-	0xf8, 0x24, 0x49, 0xca, 0x0f, 0x2e, 0x5f, 0x1d, 0x5c, 0x57, 0xe3, 0xc5, 0x9f, 0xa3, 0x98, 0x35,
-	0xa4, 0xf7, 0xe1, 0x39, 0x8b, 0xfb, 0xfd, 0x00, 0xa3, 0x70, 0x35, 0xf7, 0xaa, 0xf2, 0x77, 0x61,
-	0xeb, 0x4a, 0x91, 0xac, 0xfa, 0xf7, 0x12, 0xdc, 0xcd, 0x88, 0x67, 0x62, 0x1c, 0x0b, 0x24, 0x26,
-	0xfc, 0x56, 0x2f, 0xf0, 0x11, 0x54, 0x79, 0x9c, 0x1d, 0xb7, 0xb0, 0x71, 0x78, 0xbf, 0x60, 0x41,
-	0x5d, 0xa5, 0xb2, 0xd3, 0x24, 0xfd, 0x65, 0xe8, 0x14, 0x74, 0x33, 0xeb, 0xf8, 0xf0, 0x49, 0x0d,
-	0xe4, 0x68, 0xb3, 0x7c, 0x06, 0x8d, 0xf9, 0xff, 0x14, 0x45, 0x7b, 0x30, 0xbf, 0x20, 0xb4, 0x07,
-	0x25, 0x40, 0x99, 0x0f, 0xbe, 0x81, 0x17, 0x97, 0x5d, 0x09, 0xfb, 0xc5, 0x35, 0x96, 0xc0, 0xb5,
-	0xb7, 0x6e, 0x04, 0xcf, 0xc8, 0x7f, 0x92, 0xa0, 0x73, 0xdd, 0xe5, 0xf4, 0x4e, 0x89, 0x69, 0x96,
-	0xa7, 0x6a, 0xdd, 0x5b, 0xa7, 0x66, 0x1d, 0x32, 0x78, 0x7e, 0x71, 0xef, 0xbd, 0x5a, 0x5c, 0x75,
-	0x01, 0xaa, 0x1d, 0x94, 0x86, 0xe6, 0x09, 0x17, 0x17, 0xcc, 0x0a, 0xc2, 0x05, 0xe8, 0x2a, 0xc2,
-	0xa2, 0x45, 0xe0, 0x42, 0x33, 0x7f, 0xe2, 0xef, 0x17, 0x57, 0xc8, 0xc1, 0xb4, 0xfd, 0x52, 0xb0,
-	0x8c, 0xe4, 0x73, 0x80, 0xdc, 0xc9, 0x7e, 0xa5, 0x38, 0x79, 0x8e, 0xd2, 0x5e, 0x2f, 0x83, 0xca,
-	0x18, 0xbe, 0x85, 0xcd, 0xa5, 0x87, 0xdb, 0xb8, 0xae, 0xd1, 0xab, 0x78, 0xed, 0xe1, 0xcd, 0xf0,
-	0x33, 0xfe, 0xde, 0x07, 0xe7, 0x7f, 0xb6, 0x2b, 0xe7, 0xd3, 0xb6, 0xf4, 0x74, 0xda, 0x96, 0xfe,
-	0x98, 0xb6, 0xa5, 0x1f, 0x2e, 0xdb, 0x95, 0xa7, 0x97, 0xed, 0xca, 0x6f, 0x97, 0xed, 0xca, 0xe3,
-	0xfd, 0xa2, 0x8b, 0xe9, 0xd4, 0x8c, 0x58, 0x4c, 0x42, 0x05, 0x0e, 0x29, 0x0a, 0x92, 0x8b, 0xca,
-	0xa9, 0xc6, 0x3f, 0x29, 0xde, 0xfc, 0x27, 0x00, 0x00, 0xff, 0xff, 0xd7, 0x12, 0x0b, 0x9c, 0xb5,
-	0x0c, 0x00, 0x00,
+	// 990 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xbf, 0x6f, 0xeb, 0x54,
+	0x14, 0x8e, 0xeb, 0x36, 0x3f, 0x4e, 0x42, 0x41, 0xa6, 0x4d, 0x2d, 0x3f, 0x94, 0x14, 0xf7, 0x3d,
+	0xa9, 0xf0, 0xa8, 0x4d, 0x8a, 0x78, 0xe8, 0x09, 0xbd, 0x21, 0x09, 0x0c, 0x15, 0x32, 0x42, 0xae,
+	0x10, 0xe2, 0x49, 0x28, 0x5c, 0xdb, 0x37, 0xc6, 0x90, 0xdc, 0x1b, 0xf9, 0xde, 0x90, 0x56, 0x48,
+	0x30, 0xb2, 0x30, 0xb0, 0x30, 0xb3, 0x23, 0x66, 0x26, 0x26, 0xa6, 0x8e, 0x4f, 0x62, 0x61, 0x2a,
+	0x90, 0xae, 0xfc, 0x05, 0x4c, 0xc8, 0x3f, 0xe2, 0x3a, 0x79, 0x71, 0xeb, 0x94, 0x4e, 0x6f, 0xa9,
+	0x7c, 0x92, 0xef, 0x9c, 0xef, 0x9c, 0xef, 0x7e, 0x3e, 0xb9, 0x85, 0x32, 0x3f, 0xd1, 0x46, 0x3e,
+	0xe5, 0x54, 0xda, 0xb6, 0x29, 0x1b, 0x4e, 0x10, 0x1b, 0x6a, 0xe1, 0x9f, 0x2f, 0x5b, 0x16, 0xe6,
+	0xa8, 0xa5, 0xdc, 0x19, 0xf4, 0x2d, 0xdd, 0x42, 0x0c, 0xeb, 0xf1, 0x27, 0xba, 0x4d, 0x3d, 0x12,
+	0xe5, 0x28, 0x5b, 0x2e, 0x75, 0x69, 0xf8, 0xa8, 0x07, 0x4f, 0xf1, 0xa7, 0x55, 0x7e, 0x3a, 0xc2,
+	0x2c, 0x0a, 0xd4, 0x7f, 0x04, 0xa8, 0x19, 0xcc, 0x3d, 0xe6, 0xd4, 0xc7, 0x5d, 0xea, 0x60, 0xa9,
+	0x0e, 0x45, 0x86, 0x89, 0x83, 0x7d, 0x59, 0xd8, 0x15, 0xf6, 0x2b, 0x66, 0x1c, 0x49, 0x0f, 0x60,
+	0x33, 0x20, 0xee, 0x59, 0xa7, 0x1c, 0xf7, 0x6c, 0xea, 0x60, 0x79, 0x6d, 0x57, 0xd8, 0xaf, 0x75,
+	0x5e, 0x98, 0x9e, 0x37, 0x6b, 0x1f, 0xb5, 0x8f, 0x8d, 0xce, 0x29, 0x0f, 0x2b, 0x98, 0xb5, 0x00,
+	0x37, 0x8b, 0xc2, 0x7a, 0x74, 0xec, 0xdb, 0x58, 0x16, 0xe3, 0x7a, 0x61, 0x24, 0xc9, 0x50, 0xb2,
+	0xc6, 0xde, 0x20, 0x20, 0x5a, 0x0f, 0xbf, 0x98, 0x85, 0xd2, 0x63, 0xa8, 0x7b, 0x84, 0x71, 0x44,
+	0xb8, 0x87, 0x38, 0xee, 0x8d, 0xb0, 0x3f, 0xf4, 0x18, 0xf3, 0x28, 0x91, 0x37, 0x76, 0x85, 0xfd,
+	0xea, 0xe1, 0x9e, 0xb6, 0x54, 0x0a, 0xad, 0x6d, 0xdb, 0x98, 0xb1, 0x2e, 0x25, 0x7d, 0xcf, 0x35,
+	0xb7, 0x53, 0x25, 0x3e, 0x48, 0x2a, 0xa8, 0x6f, 0xc3, 0x56, 0x7a, 0x5a, 0x13, 0xb3, 0x11, 0x25,
+	0x0c, 0x4b, 0x7b, 0x50, 0x0a, 0x66, 0xea, 0x79, 0x4e, 0x38, 0xf6, 0x7a, 0x07, 0xa6, 0xe7, 0xcd,
+	0x62, 0x00, 0x39, 0x7a, 0xc7, 0x2c, 0x06, 0x5f, 0x1d, 0x39, 0xea, 0x0f, 0x6b, 0x50, 0x37, 0x98,
+	0x7b, 0x74, 0x59, 0xb9, 0x4b, 0x09, 0xf7, 0x91, 0xcd, 0x33, 0x55, 0xdb, 0x82, 0x0d, 0xe4, 0x0c,
+	0x3d, 0x12, 0x8a, 0x55, 0x31, 0xa3, 0x20, 0xcd, 0x26, 0x66, 0xb1, 0x05, 0xa9, 0x03, 0x64, 0xe1,
+	0x41, 0x2c, 0x4f, 0x14, 0x48, 0x6f, 0x41, 0xd9, 0x23, 0x1e, 0xef, 0x0d, 0x99, 0x1b, 0xca, 0x51,
+	0xeb, 0xbc, 0xf4, 0xef, 0x79, 0x53, 0xc6, 0xc4, 0xa6, 0x8e, 0x47, 0x5c, 0xfd, 0x73, 0x46, 0x89,
+	0x66, 0xa2, 0x89, 0x81, 0x19, 0x43, 0x2e, 0x36, 0x4b, 0x01, 0xda, 0x60, 0xae, 0xf4, 0x31, 0x6c,
+	0xf4, 0xc7, 0xc4, 0x61, 0x72, 0x71, 0x57, 0xdc, 0xaf, 0x1e, 0xd6, 0xb5, 0x41, 0xdf, 0xd2, 0x02,
+	0xe3, 0x24, 0xfa, 0x75, 0xa9, 0x47, 0x3a, 0xf7, 0xcf, 0xce, 0x9b, 0x85, 0x9f, 0xfe, 0x6c, 0xee,
+	0xb9, 0x1e, 0xff, 0x6c, 0x6c, 0x69, 0x36, 0x1d, 0xea, 0x03, 0x8f, 0x60, 0x7d, 0xd0, 0xb7, 0x0e,
+	0x98, 0xf3, 0x85, 0x1e, 0x99, 0x27, 0xc0, 0x32, 0x33, 0xaa, 0xa8, 0xbe, 0x0f, 0x8d, 0xe5, 0xb2,
+	0x24, 0xf2, 0xca, 0x50, 0x42, 0x8e, 0xe3, 0x63, 0xc6, 0x62, 0x7d, 0x66, 0xa1, 0x24, 0xc1, 0xba,
+	0x83, 0x38, 0x8a, 0xcc, 0x64, 0x86, 0xcf, 0xea, 0x6f, 0x22, 0xa8, 0xe9, 0x53, 0x6a, 0x13, 0x67,
+	0x15, 0xcd, 0x9f, 0x09, 0xa7, 0x5e, 0x3a, 0xa7, 0x98, 0x76, 0x4e, 0x62, 0x8a, 0x52, 0x96, 0x29,
+	0xca, 0x37, 0x32, 0x45, 0xe5, 0xd6, 0x4d, 0xf1, 0x0d, 0xbc, 0x7a, 0xfd, 0x19, 0xae, 0xf4, 0xfe,
+	0xa5, 0x5d, 0xb4, 0xb6, 0xdc, 0x45, 0x62, 0xca, 0x45, 0xbf, 0x0b, 0x20, 0x19, 0xcc, 0x7d, 0xf7,
+	0x04, 0xdb, 0xe3, 0x1c, 0xae, 0x51, 0xa0, 0x6c, 0xc7, 0x98, 0xb8, 0x7a, 0x12, 0x4b, 0x1a, 0x88,
+	0x81, 0xb4, 0x62, 0x0e, 0x69, 0xc5, 0x61, 0x5a, 0xd6, 0x8d, 0x5b, 0x97, 0xf5, 0x75, 0x50, 0x9e,
+	0x1e, 0x2a, 0x91, 0x71, 0xa6, 0x83, 0x90, 0xd2, 0xe1, 0xe7, 0x48, 0x07, 0xc3, 0x73, 0x7d, 0xf4,
+	0x3f, 0x75, 0xc8, 0xb5, 0xb7, 0x1e, 0x41, 0x75, 0x18, 0x71, 0x85, 0x7e, 0x5c, 0xcf, 0x21, 0x1a,
+	0xc4, 0x09, 0x06, 0x73, 0xe3, 0x01, 0x17, 0xba, 0xbd, 0x72, 0x40, 0x04, 0x9b, 0x06, 0x73, 0x3f,
+	0x1c, 0x39, 0x88, 0xe3, 0x76, 0xf8, 0x96, 0x64, 0xcd, 0x76, 0x07, 0x2a, 0x04, 0x4f, 0x7a, 0xe9,
+	0x8d, 0x5c, 0x26, 0x78, 0x12, 0x25, 0xa5, 0x07, 0x17, 0xe7, 0x07, 0x57, 0x65, 0xa8, 0xcf, 0x53,
+	0xcc, 0x1a, 0x52, 0xbb, 0xf0, 0x9c, 0xc1, 0xdc, 0xee, 0x00, 0x23, 0xff, 0x6a, 0xee, 0xab, 0xca,
+	0xef, 0xc0, 0xf6, 0x5c, 0x91, 0xa4, 0xfa, 0x77, 0x02, 0xec, 0x24, 0xc4, 0x33, 0x31, 0x8e, 0x39,
+	0xe2, 0x63, 0x76, 0xa3, 0x03, 0x7c, 0x04, 0x45, 0x16, 0x66, 0x87, 0x2d, 0x6c, 0x1e, 0xde, 0xcb,
+	0x58, 0x50, 0xf3, 0x54, 0x66, 0x9c, 0xa4, 0xbe, 0x0c, 0xcd, 0x8c, 0x6e, 0x92, 0x8e, 0x7f, 0x15,
+	0x40, 0x49, 0x30, 0xf3, 0x6f, 0x7c, 0xdf, 0x73, 0x33, 0x9b, 0x4e, 0x39, 0x6b, 0x2d, 0xd3, 0x59,
+	0x08, 0x94, 0xe0, 0xf8, 0x32, 0x56, 0xae, 0x98, 0x7f, 0xe5, 0xca, 0x04, 0x4f, 0x8e, 0x96, 0xde,
+	0x0f, 0xee, 0x82, 0x9a, 0xdd, 0xfd, 0x6c, 0xc8, 0xc3, 0x5f, 0xca, 0x20, 0x06, 0xeb, 0xf3, 0x13,
+	0xa8, 0x5c, 0x5e, 0x9c, 0xb2, 0x98, 0xd3, 0x5b, 0x50, 0xb9, 0x9f, 0x03, 0x94, 0x98, 0xfd, 0x2b,
+	0x78, 0x71, 0xd9, 0xef, 0xde, 0x41, 0x76, 0x8d, 0x25, 0x70, 0xe5, 0xcd, 0x95, 0xe0, 0x09, 0xf9,
+	0x8f, 0x02, 0x34, 0xaf, 0xfb, 0x05, 0x7e, 0x98, 0x63, 0x9a, 0xe5, 0xa9, 0x4a, 0xfb, 0xc6, 0xa9,
+	0x49, 0x87, 0x14, 0x9e, 0x5f, 0x5c, 0xee, 0xaf, 0x64, 0x57, 0x5d, 0x80, 0x2a, 0xad, 0xdc, 0xd0,
+	0x34, 0xe1, 0xe2, 0x16, 0xbd, 0x82, 0x70, 0x01, 0xaa, 0xb4, 0x72, 0x43, 0x13, 0x42, 0x1b, 0xaa,
+	0xe9, 0xb5, 0x76, 0x2f, 0xbb, 0x42, 0x0a, 0xa6, 0x1c, 0xe4, 0x82, 0x25, 0x24, 0x9f, 0x02, 0xa4,
+	0xd6, 0xd7, 0xdd, 0xec, 0xe4, 0x4b, 0x94, 0xf2, 0x5a, 0x1e, 0x54, 0xc2, 0xf0, 0x35, 0x6c, 0x2d,
+	0xdd, 0x60, 0xda, 0x75, 0x8d, 0xce, 0xe3, 0x95, 0x07, 0xab, 0xe1, 0x13, 0xfe, 0x6f, 0x05, 0xd8,
+	0xc9, 0x5a, 0x48, 0xad, 0xeb, 0x6a, 0x3e, 0x95, 0xa2, 0x3c, 0x5c, 0x39, 0x65, 0xd6, 0x49, 0xe7,
+	0xbd, 0xb3, 0xbf, 0x1b, 0x85, 0xb3, 0x69, 0x43, 0x78, 0x32, 0x6d, 0x08, 0x7f, 0x4d, 0x1b, 0xc2,
+	0xf7, 0x17, 0x8d, 0xc2, 0x93, 0x8b, 0x46, 0xe1, 0x8f, 0x8b, 0x46, 0xe1, 0xf1, 0x41, 0xd6, 0x3d,
+	0xe0, 0x44, 0x0f, 0x88, 0x74, 0x8f, 0x70, 0xec, 0x13, 0x34, 0x88, 0xee, 0x05, 0x56, 0x31, 0xfc,
+	0x0f, 0xee, 0x8d, 0xff, 0x06, 0x00, 0xa0, 0x8a, 0x8a, 0x3d, 0x24, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -828,6 +913,8 @@ type MsgClient interface {
 	ClearAdmin(ctx context.Context, in *MsgClearAdmin, opts ...grpc.CallOption) (*MsgClearAdminResponse, error)
 	// UpdateContractStatus sets a new status for a smart contract
 	UpdateContractStatus(ctx context.Context, in *MsgUpdateContractStatus, opts ...grpc.CallOption) (*MsgUpdateContractStatusResponse, error)
+	// UpdateInstantiateConfig updates the instantiate permission of a stored code
+	UpdateInstantiateConfig(ctx context.Context, in *MsgUpdateInstantiateConfig, opts ...grpc.CallOption) (*MsgUpdateInstantiateConfigResponse, error)
 }
 
 type msgClient struct {
@@ -910,6 +997,15 @@ func (c *msgClient) UpdateContractStatus(ctx context.Context, in *MsgUpdateContr
 	return out, nil
 }
 
+func (c *msgClient) UpdateInstantiateConfig(ctx context.Context, in *MsgUpdateInstantiateConfig, opts ...grpc.CallOption) (*MsgUpdateInstantiateConfigResponse, error) {
+	out := new(MsgUpdateInstantiateConfigResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.wasm.v1beta1.Msg/UpdateInstantiateConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// StoreCode to submit Wasm code to the system
@@ -928,6 +1024,8 @@ type MsgServer interface {
 	ClearAdmin(context.Context, *MsgClearAdmin) (*MsgClearAdminResponse, error)
 	// UpdateContractStatus sets a new status for a smart contract
 	UpdateContractStatus(context.Context, *MsgUpdateContractStatus) (*MsgUpdateContractStatusResponse, error)
+	// UpdateInstantiateConfig updates the instantiate permission of a stored code
+	UpdateInstantiateConfig(context.Context, *MsgUpdateInstantiateConfig) (*MsgUpdateInstantiateConfigResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -958,6 +1056,9 @@ func (*UnimplementedMsgServer) ClearAdmin(ctx context.Context, req *MsgClearAdmi
 func (*UnimplementedMsgServer) UpdateContractStatus(ctx context.Context, req *MsgUpdateContractStatus) (*MsgUpdateContractStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateContractStatus not implemented")
 }
+func (*UnimplementedMsgServer) UpdateInstantiateConfig(ctx context.Context, req *MsgUpdateInstantiateConfig) (*MsgUpdateInstantiateConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInstantiateConfig not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1107,6 +1208,24 @@ func _Msg_UpdateContractStatus_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateInstantiateConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateInstantiateConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateInstantiateConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.wasm.v1beta1.Msg/UpdateInstantiateConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateInstantiateConfig(ctx, req.(*MsgUpdateInstantiateConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmwasm.wasm.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1143,6 +1262,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateContractStatus",
 			Handler:    _Msg_UpdateContractStatus_Handler,
 		},
+		{
+			MethodName: "UpdateInstantiateConfig",
+			Handler:    _Msg_UpdateInstantiateConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "tx.proto",
@@ -1845,6 +1968,76 @@ func (m *MsgUpdateContractStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateInstantiateConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateInstantiateConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateInstantiateConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewInstantiatePermission != nil {
+		{
+			size, err := m.NewInstantiatePermission.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateInstantiateConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateInstantiateConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateInstantiateConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2177,6 +2370,35 @@ func (m *MsgUpdateContractStatusResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateInstantiateConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovTx(uint64(m.CodeID))
+	}
+	if m.NewInstantiatePermission != nil {
+		l = m.NewInstantiatePermission.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateInstantiateConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4360,6 +4582,193 @@ func (m *MsgUpdateContractStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateInstantiateConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateInstantiateConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateInstantiateConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewInstantiatePermission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewInstantiatePermission == nil {
+				m.NewInstantiatePermission = &AccessConfig{}
+			}
+			if err := m.NewInstantiatePermission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateInstantiateConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateInstantiateConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateInstantiateConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
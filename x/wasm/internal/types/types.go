@@ -166,6 +166,15 @@ func (c *ContractInfo) ResetFromGenesis(ctx sdk.Context) ContractCodeHistoryEntr
 	}
 }
 
+// CreatorAddr convert into sdk.AccAddress
+func (c *ContractInfo) CreatorAddr() sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(c.Creator)
+	if err != nil { // should never happen
+		panic(err.Error())
+	}
+	return creator
+}
+
 // AdminAddr convert into sdk.AccAddress or nil when not set
 func (c *ContractInfo) AdminAddr() sdk.AccAddress {
 	if c.Admin == "" {
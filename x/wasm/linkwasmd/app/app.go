@@ -1,6 +1,7 @@
 package app
 
 import (
+	"fmt"
 	"io"
 	stdlog "log"
 	"net/http"
@@ -28,6 +29,7 @@ import (
 	"github.com/line/lfb-sdk/server/config"
 	servertypes "github.com/line/lfb-sdk/server/types"
 	"github.com/line/lfb-sdk/simapp"
+	"github.com/line/lfb-sdk/streaming"
 	sdk "github.com/line/lfb-sdk/types"
 	"github.com/line/lfb-sdk/types/module"
 	"github.com/line/lfb-sdk/version"
@@ -39,6 +41,9 @@ import (
 	authtx "github.com/line/lfb-sdk/x/auth/tx"
 	authtypes "github.com/line/lfb-sdk/x/auth/types"
 	"github.com/line/lfb-sdk/x/auth/vesting"
+	"github.com/line/lfb-sdk/x/authz"
+	authzkeeper "github.com/line/lfb-sdk/x/authz/keeper"
+	authztypes "github.com/line/lfb-sdk/x/authz/types"
 	"github.com/line/lfb-sdk/x/bank"
 	bankkeeper "github.com/line/lfb-sdk/x/bank/keeper"
 	banktypes "github.com/line/lfb-sdk/x/bank/types"
@@ -55,6 +60,9 @@ import (
 	"github.com/line/lfb-sdk/x/evidence"
 	evidencekeeper "github.com/line/lfb-sdk/x/evidence/keeper"
 	evidencetypes "github.com/line/lfb-sdk/x/evidence/types"
+	"github.com/line/lfb-sdk/x/feegrant"
+	feegrantkeeper "github.com/line/lfb-sdk/x/feegrant/keeper"
+	feegranttypes "github.com/line/lfb-sdk/x/feegrant/types"
 	"github.com/line/lfb-sdk/x/genutil"
 	genutiltypes "github.com/line/lfb-sdk/x/genutil/types"
 	"github.com/line/lfb-sdk/x/gov"
@@ -155,6 +163,8 @@ var (
 		evidence.AppModuleBasic{},
 		transfer.AppModuleBasic{},
 		vesting.AppModuleBasic{},
+		feegrant.AppModuleBasic{},
+		authz.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -192,6 +202,7 @@ type LinkApp struct { // nolint: golint
 
 	// keys to access the substores
 	keys    map[string]*sdk.KVStoreKey
+	tkeys   map[string]*sdk.TransientStoreKey
 	memKeys map[string]*sdk.MemoryStoreKey
 
 	// keepers
@@ -209,6 +220,8 @@ type LinkApp struct { // nolint: golint
 	IBCKeeper        *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
 	EvidenceKeeper   evidencekeeper.Keeper
 	TransferKeeper   ibctransferkeeper.Keeper
+	FeeGrantKeeper   feegrantkeeper.Keeper
+	AuthzKeeper      authzkeeper.Keeper
 	wasmKeeper       wasm.Keeper
 
 	// make scoped keepers public for test purposes
@@ -252,10 +265,16 @@ func NewLinkApp(
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey,
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, capabilitytypes.StoreKey,
-		wasm.StoreKey,
+		wasm.StoreKey, feegranttypes.StoreKey, authztypes.StoreKey,
 	)
+	tkeys := sdk.NewTransientStoreKeys(authtypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
+	// configure state listening capabilities using AppOptions
+	if _, err := streaming.LoadStreamingServices(bApp, appOpts, appCodec, keys); err != nil {
+		ostos.Exit(err.Error())
+	}
+
 	app := &LinkApp{
 		BaseApp:           bApp,
 		legacyAmino:       legacyAmino,
@@ -263,6 +282,7 @@ func NewLinkApp(
 		interfaceRegistry: interfaceRegistry,
 		invCheckPeriod:    invCheckPeriod,
 		keys:              keys,
+		tkeys:             tkeys,
 		memKeys:           memKeys,
 	}
 
@@ -301,6 +321,8 @@ func NewLinkApp(
 		app.GetSubspace(crisistypes.ModuleName), invCheckPeriod, app.BankKeeper, authtypes.FeeCollectorName,
 	)
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath)
+	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, keys[feegranttypes.StoreKey], app.AccountKeeper)
+	app.AuthzKeeper = authzkeeper.NewKeeper(keys[authztypes.StoreKey], appCodec, app.BaseApp.MsgServiceRouter())
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
@@ -419,6 +441,8 @@ func NewLinkApp(
 		evidence.NewAppModule(app.EvidenceKeeper),
 		ibc.NewAppModule(app.IBCKeeper),
 		params.NewAppModule(app.ParamsKeeper),
+		feegrant.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
+		authz.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.AuthzKeeper, app.interfaceRegistry),
 		transferModule,
 	)
 
@@ -441,12 +465,12 @@ func NewLinkApp(
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, stakingtypes.ModuleName,
 		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName, crisistypes.ModuleName,
 		ibchost.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, ibctransfertypes.ModuleName,
-		wasm.ModuleName,
+		wasm.ModuleName, feegranttypes.ModuleName, authztypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
-	app.mm.RegisterServices(module.NewConfigurator(app.MsgServiceRouter(), app.GRPCQueryRouter()))
+	app.mm.RegisterServices(module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter()))
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
 	//
@@ -465,6 +489,8 @@ func NewLinkApp(
 		wasm.NewAppModule(appCodec, &app.wasmKeeper, app.StakingKeeper),
 		evidence.NewAppModule(app.EvidenceKeeper),
 		ibc.NewAppModule(app.IBCKeeper),
+		feegrant.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
+		authz.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.AuthzKeeper, app.interfaceRegistry),
 		transferModule,
 	)
 
@@ -472,6 +498,7 @@ func NewLinkApp(
 
 	// initialize stores
 	app.MountKVStores(keys)
+	app.MountTransientStores(tkeys)
 	app.MountMemoryStores(memKeys)
 
 	// initialize BaseApp
@@ -479,11 +506,30 @@ func NewLinkApp(
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetAnteHandler(
 		ante.NewAnteHandler(
-			app.AccountKeeper, app.BankKeeper, ante.DefaultSigVerificationGasConsumer,
+			app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, tkeys[authtypes.TStoreKey],
+			ante.DefaultSigVerificationGasConsumer,
 			encodingConfig.TxConfig.SignModeHandler(),
 		),
 	)
 	app.SetEndBlocker(app.EndBlocker)
+	// the txs paying fees of a block executed in parallel all credit the fee collector
+	app.SetAccumulator(
+		keys[banktypes.StoreKey],
+		banktypes.CreateAccountBalancesPrefix(app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)),
+		bankkeeper.NewBalanceAccumulator(appCodec),
+	)
+	// and add to the gas used by the Msg types with a gas limit
+	app.SetAccumulator(tkeys[authtypes.TStoreKey], authtypes.MsgGasUsedKeyPrefix, ante.NewMsgGasAccumulator(app.AccountKeeper))
+
+	// must be before loading version: the wasm code is restored from snapshots by an extension
+	if manager := app.SnapshotManager(); manager != nil {
+		err := manager.RegisterExtensions(
+			wasm.NewWasmSnapshotter(app.CommitMultiStore(), &app.wasmKeeper),
+		)
+		if err != nil {
+			panic(fmt.Errorf("failed to register snapshot extension: %s", err))
+		}
+	}
 
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
@@ -539,6 +585,7 @@ func (app *LinkApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci
 	if err := ostjson.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
 		panic(err)
 	}
+	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
 	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
 }
 
@@ -596,6 +643,13 @@ func (app *LinkApp) GetKey(storeKey string) *sdk.KVStoreKey {
 	return app.keys[storeKey]
 }
 
+// GetTKey returns the TransientStoreKey for the provided store key.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *LinkApp) GetTKey(storeKey string) *sdk.TransientStoreKey {
+	return app.tkeys[storeKey]
+}
+
 // GetMemKey returns the MemStoreKey for the provided mem key.
 //
 // NOTE: This is solely used for testing purposes.
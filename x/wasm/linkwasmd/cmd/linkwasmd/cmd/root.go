@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"io"
 	"os"
-	"path/filepath"
 
 	"github.com/line/lfb-sdk/baseapp"
 	"github.com/line/lfb-sdk/client"
@@ -15,7 +14,6 @@ import (
 	"github.com/line/lfb-sdk/client/rpc"
 	"github.com/line/lfb-sdk/server"
 	servertypes "github.com/line/lfb-sdk/server/types"
-	"github.com/line/lfb-sdk/snapshots"
 	"github.com/line/lfb-sdk/store"
 	sdk "github.com/line/lfb-sdk/types"
 	authclient "github.com/line/lfb-sdk/x/auth/client"
@@ -77,6 +75,9 @@ func NewRootCmd() (*cobra.Command, params.EncodingConfig) {
 func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 	authclient.Codec = encodingConfig.Marshaler
 
+	debugCmd := debug.Cmd()
+	debugCmd.AddCommand(server.DebugStoreCmd(storeDecoders, app.DefaultNodeHome))
+
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(app.ModuleBasics, app.DefaultNodeHome),
 		genutilcli.CollectGenTxsCmd(banktypes.GenesisBalancesIterator{}, app.DefaultNodeHome),
@@ -85,7 +86,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		AddGenesisAccountCmd(app.DefaultNodeHome),
 		ostcli.NewCompletionCmd(rootCmd, true),
 		testnetCmd(app.ModuleBasics, banktypes.GenesisBalancesIterator{}),
-		debug.Cmd(),
+		debugCmd,
 	)
 
 	server.AddCommands(rootCmd, app.DefaultNodeHome, newApp, createLinkAppAndExport, addModuleInitFlags)
@@ -175,15 +176,12 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts serverty
 		panic(err)
 	}
 
-	snapshotDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", "snapshots")
-	if err := os.MkdirAll(snapshotDir, 0755); err != nil {
-		panic(err)
-	}
-	snapshotDB, err := sdk.NewLevelDB("metadata", snapshotDir)
+	dynamicMinGasPrices, err := server.GetDynamicMinGasPricesFromFlags(appOpts)
 	if err != nil {
 		panic(err)
 	}
-	snapshotStore, err := snapshots.NewStore(snapshotDB, snapshotDir)
+
+	snapshotStore, err := server.GetSnapshotStore(cast.ToString(appOpts.Get(flags.FlagHome)))
 	if err != nil {
 		panic(err)
 	}
@@ -198,9 +196,12 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts serverty
 		emptyWasmOpts,
 		baseapp.SetPruning(pruningOpts),
 		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(server.FlagMinGasPrices))),
+		baseapp.SetDynamicMinGasPrices(dynamicMinGasPrices),
+		baseapp.SetMsgPriorityWeights(cast.ToStringSlice(appOpts.Get(server.FlagMsgPriorityWeights))),
 		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(server.FlagHaltHeight))),
 		baseapp.SetHaltTime(cast.ToUint64(appOpts.Get(server.FlagHaltTime))),
 		baseapp.SetMinRetainBlocks(cast.ToUint64(appOpts.Get(server.FlagMinRetainBlocks))),
+		baseapp.SetParallelDeliverTx(cast.ToInt(appOpts.Get(server.FlagDeliverTxWorkers))),
 		baseapp.SetInterBlockCache(cache),
 		baseapp.SetIAVLCacheManager(cast.ToInt(appOpts.Get(server.FlagIAVLCacheSize)), iavlCacheMetricsProvider),
 		baseapp.SetTrace(cast.ToBool(appOpts.Get(server.FlagTrace))),
@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+
+	sdk "github.com/line/lfb-sdk/types"
+	upgradesim "github.com/line/lfb-sdk/x/upgrade/simulation"
+	upgradetypes "github.com/line/lfb-sdk/x/upgrade/types"
+	"github.com/line/lfb-sdk/x/wasm"
+	"github.com/line/ostracon/libs/log"
+	"github.com/line/tm-db/v2/memdb"
+
+	"github.com/line/lfb-sdk/x/wasm/linkwasmd/app"
+)
+
+// storeDecoders is a server.StoreDecodersFn returning the store decoders registered
+// by the modules of the simulation manager of the app, which the debug store commands
+// decode values with. The app is created on an in-memory DB and a temporary home
+// directory, so that nothing of the node is opened.
+func storeDecoders() (sdk.StoreDecoderRegistry, error) {
+	homePath, err := ioutil.TempDir("", "linkwasmd-store-decoders")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(homePath)
+
+	encodingConfig := app.MakeEncodingConfig()
+	var emptyWasmOpts []wasm.Option
+	linkApp := app.NewLinkApp(log.NewNopLogger(), memdb.NewDB(), nil, false, map[int64]bool{}, homePath, 0,
+		encodingConfig, wasm.EnableAllProposals, app.EmptyBaseAppOptions{}, emptyWasmOpts)
+	decoders := linkApp.SimulationManager().StoreDecoders
+	// the upgrade module isn't simulated
+	decoders[upgradetypes.StoreKey] = upgradesim.NewDecodeStore(encodingConfig.Marshaler)
+
+	return decoders, nil
+}
@@ -0,0 +1,46 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/line/lfb-sdk/codec"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/types/kv"
+	"github.com/line/lfb-sdk/x/wasm/internal/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding wasm type.
+func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.CodeKeyPrefix):
+			var codeInfoA, codeInfoB types.CodeInfo
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &codeInfoA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &codeInfoB)
+			return fmt.Sprintf("%v\n%v", codeInfoA, codeInfoB)
+
+		case bytes.Equal(kvA.Key[:1], types.ContractKeyPrefix):
+			var contractInfoA, contractInfoB types.ContractInfo
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &contractInfoA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &contractInfoB)
+			return fmt.Sprintf("%v\n%v", contractInfoA, contractInfoB)
+
+		case bytes.Equal(kvA.Key[:1], types.ContractStorePrefix):
+			return fmt.Sprintf("%q\n%q", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.SequenceKeyPrefix):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.ContractCodeHistoryElementPrefix):
+			var entryA, entryB types.ContractCodeHistoryEntry
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &entryA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &entryB)
+			return fmt.Sprintf("%v\n%v", entryA, entryB)
+
+		default:
+			panic(fmt.Sprintf("invalid wasm key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/types/kv"
+	"github.com/line/lfb-sdk/x/wasm/internal/keeper"
+	"github.com/line/lfb-sdk/x/wasm/internal/types"
+	"github.com/line/lfb-sdk/x/wasm/simulation"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := keeper.MakeEncodingConfig(t).Marshaler
+	dec := simulation.NewDecodeStore(cdc)
+
+	contractAddr := sdk.AccAddress("contract")
+	codeInfo := types.CodeInfoFixture()
+	contractInfo := types.ContractInfoFixture()
+	entry := types.ContractCodeHistoryEntry{Operation: types.ContractCodeHistoryOperationTypeInit, CodeID: 1}
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.GetCodeKey(1), Value: cdc.MustMarshalBinaryBare(&codeInfo)},
+			{Key: types.GetContractAddressKey(contractAddr), Value: cdc.MustMarshalBinaryBare(&contractInfo)},
+			{Key: append(types.GetContractStorePrefix(contractAddr), "config"...), Value: []byte(`{"count":1}`)},
+			{Key: types.KeyLastCodeID, Value: sdk.Uint64ToBigEndian(2)},
+			{Key: types.GetContractCodeHistoryElementKey(contractAddr, 1), Value: cdc.MustMarshalBinaryBare(&entry)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"CodeInfo", fmt.Sprintf("%v\n%v", codeInfo, codeInfo)},
+		{"ContractInfo", fmt.Sprintf("%v\n%v", contractInfo, contractInfo)},
+		{"ContractStore", `"{\"count\":1}"` + "\n" + `"{\"count\":1}"`},
+		{"Sequence", "2\n2"},
+		{"ContractCodeHistory", fmt.Sprintf("%v\n%v", entry, entry)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}